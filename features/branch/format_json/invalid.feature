@@ -0,0 +1,10 @@
+Feature: invalid output format
+
+  Scenario:
+    Given a Git repo with origin
+    When I run "git-town branch --format=zonk"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      invalid output format defined in --format flag: "zonk"
+      """
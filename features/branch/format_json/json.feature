@@ -0,0 +1,83 @@
+Feature: display the branch hierarchy as JSON
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the branches
+      | NAME      | TYPE      | PARENT | LOCATIONS     |
+      | alpha     | feature   | main   | local, origin |
+      | beta      | feature   | alpha  | local         |
+      | perennial | perennial |        | local, origin |
+    And the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | TITLE          | BODY       | URL                      |
+      | 1  | alpha         | main          | alpha proposal | alpha body | https://example.com/pr/1 |
+    And the current branch is "beta"
+    When I run "git-town branch --format=json"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints something like:
+      """
+      \{
+        "branches": \[
+          \{
+            "children": \[
+              "alpha"
+            \],
+            "localSHA": "[0-9a-f]{40}",
+            "name": "main",
+            "otherWorktree": false,
+            "parent": null,
+            "proposal": null,
+            "remoteSHA": "[0-9a-f]{40}",
+            "syncStatus": "up to date",
+            "trackingBranch": "origin/main",
+            "type": "main"
+          \},
+          \{
+            "children": \[
+              "beta"
+            \],
+            "localSHA": "[0-9a-f]{40}",
+            "name": "alpha",
+            "otherWorktree": false,
+            "parent": "main",
+            "proposal": \{
+              "number": 1,
+              "url": "https://example\.com/pr/1"
+            \},
+            "remoteSHA": "[0-9a-f]{40}",
+            "syncStatus": "up to date",
+            "trackingBranch": "origin/alpha",
+            "type": "feature"
+          \},
+          \{
+            "children": \[\],
+            "localSHA": "[0-9a-f]{40}",
+            "name": "beta",
+            "otherWorktree": false,
+            "parent": "alpha",
+            "proposal": null,
+            "remoteSHA": null,
+            "syncStatus": "local only",
+            "trackingBranch": null,
+            "type": "feature"
+          \},
+          \{
+            "children": \[\],
+            "localSHA": "[0-9a-f]{40}",
+            "name": "perennial",
+            "otherWorktree": false,
+            "parent": null,
+            "proposal": null,
+            "remoteSHA": "[0-9a-f]{40}",
+            "syncStatus": "up to date",
+            "trackingBranch": "origin/perennial",
+            "type": "perennial"
+          \}
+        \],
+        "currentBranch": "beta",
+        "version": 1
+      \}
+      """
+    And the initial proposals exist now
@@ -61,12 +61,14 @@ Feature: show the configuration
         new branch type: (not set)
         share new branches: no
         stash uncommitted changes: no
+        worktree root: (not set)
 
       Hosting:
         browser: firefox
         development remote: origin
         forge type: (not set)
         origin hostname: (not set)
+        Azure DevOps token: (not set)
         Bitbucket username: (not set)
         Bitbucket app password: (not set)
         Forgejo token: (not set)
@@ -79,8 +81,14 @@ Feature: show the configuration
       Propose:
         breadcrumb: stacks
         breadcrumb direction: down
+        create drafts: no
+        assignees: (none)
+        labels: (none)
+        reviewers: (none)
+        template: (not set)
 
       Ship:
+        api merge method: squash
         delete tracking branch: yes
         ignore uncommitted changes: no
         ship strategy: squash-merge
@@ -167,12 +175,14 @@ Feature: show the configuration
         new branch type: (not set)
         share new branches: push
         stash uncommitted changes: no
+        worktree root: (not set)
 
       Hosting:
         browser: firefox
         development remote: origin
         forge type: github
         origin hostname: github.com
+        Azure DevOps token: (not set)
         Bitbucket username: (not set)
         Bitbucket app password: (not set)
         Forgejo token: (not set)
@@ -185,8 +195,14 @@ Feature: show the configuration
       Propose:
         breadcrumb: stacks
         breadcrumb direction: down
+        create drafts: no
+        assignees: (none)
+        labels: (none)
+        reviewers: (none)
+        template: (not set)
 
       Ship:
+        api merge method: squash
         delete tracking branch: yes
         ignore uncommitted changes: yes
         ship strategy: squash-merge
@@ -298,12 +314,14 @@ Feature: show the configuration
         new branch type: (not set)
         share new branches: no
         stash uncommitted changes: no
+        worktree root: (not set)
 
       Hosting:
         browser: firefox
         development remote: origin
         forge type: github
         origin hostname: github.com
+        Azure DevOps token: (not set)
         Bitbucket username: (not set)
         Bitbucket app password: (not set)
         Forgejo token: (not set)
@@ -316,8 +334,14 @@ Feature: show the configuration
       Propose:
         breadcrumb: branches
         breadcrumb direction: down
+        create drafts: no
+        assignees: (none)
+        labels: (none)
+        reviewers: (none)
+        template: (not set)
 
       Ship:
+        api merge method: squash
         delete tracking branch: no
         ignore uncommitted changes: no
         ship strategy: squash-merge
@@ -373,12 +397,14 @@ Feature: show the configuration
         new branch type: (not set)
         share new branches: no
         stash uncommitted changes: yes
+        worktree root: (not set)
 
       Hosting:
         browser: (not set)
         development remote: origin
         forge type: (not set)
         origin hostname: (not set)
+        Azure DevOps token: (not set)
         Bitbucket username: (not set)
         Bitbucket app password: (not set)
         Forgejo token: (not set)
@@ -391,8 +417,14 @@ Feature: show the configuration
       Propose:
         breadcrumb: none
         breadcrumb direction: down
+        create drafts: no
+        assignees: (none)
+        labels: (none)
+        reviewers: (none)
+        template: (not set)
 
       Ship:
+        api merge method: squash
         delete tracking branch: yes
         ignore uncommitted changes: no
         ship strategy: api
@@ -454,12 +486,14 @@ Feature: show the configuration
         new branch type: (not set)
         share new branches: no
         stash uncommitted changes: yes
+        worktree root: (not set)
 
       Hosting:
         browser: (not set)
         development remote: origin
         forge type: (not set)
         origin hostname: (not set)
+        Azure DevOps token: (not set)
         Bitbucket username: (not set)
         Bitbucket app password: (not set)
         Forgejo token: (not set)
@@ -472,8 +506,14 @@ Feature: show the configuration
       Propose:
         breadcrumb: none
         breadcrumb direction: down
+        create drafts: no
+        assignees: (none)
+        labels: (none)
+        reviewers: (none)
+        template: (not set)
 
       Ship:
+        api merge method: squash
         delete tracking branch: yes
         ignore uncommitted changes: no
         ship strategy: api
@@ -0,0 +1,17 @@
+Feature: display a setting stored in the configuration file
+
+  Background:
+    Given a Git repo with origin
+    And the configuration file:
+      """
+      [branches]
+      perennials = ["qa", "staging"]
+      """
+    When I run "git-town config get perennial-branches --show-origin"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints:
+      """
+      file	qa staging
+      """
@@ -0,0 +1,20 @@
+Feature: display the default value of a setting
+
+  Background:
+    Given a Git repo with origin
+
+  Scenario: without origin
+    When I run "git-town config get sync-feature-strategy"
+    Then Git Town runs no commands
+    And Git Town prints:
+      """
+      merge
+      """
+
+  Scenario: with origin
+    When I run "git-town config get git-town.sync-feature-strategy --show-origin"
+    Then Git Town runs no commands
+    And Git Town prints:
+      """
+      default	merge
+      """
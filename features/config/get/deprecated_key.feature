@@ -0,0 +1,12 @@
+Feature: display a deprecated setting
+
+  Background:
+    Given a Git repo with origin
+    When I run "git-town config get sync-strategy"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      the configuration key "git-town.sync-strategy" is deprecated
+      """
@@ -0,0 +1,14 @@
+Feature: display a setting provided by an environment variable
+
+  Background:
+    Given a Git repo with origin
+    And local Git setting "git-town.sync-feature-strategy" is "rebase"
+    When I run "git-town config get sync-feature-strategy --show-origin" with these environment variables
+      | GIT_TOWN_SYNC_FEATURE_STRATEGY | compress |
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints:
+      """
+      env	compress
+      """
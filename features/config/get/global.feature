@@ -0,0 +1,18 @@
+Feature: display a setting stored in the global Git metadata
+
+  Background:
+    Given a Git repo with origin
+    And global Git setting "git-town.sync-feature-strategy" is "compress"
+    And the configuration file:
+      """
+      [sync]
+      feature-strategy = "merge"
+      """
+    When I run "git-town config get sync-feature-strategy --show-origin"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints:
+      """
+      global	compress
+      """
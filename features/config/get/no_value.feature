@@ -0,0 +1,9 @@
+Feature: display a setting that has no value
+
+  Background:
+    Given a Git repo with origin
+    When I run "git-town config get github-token --show-origin"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints no output
@@ -0,0 +1,12 @@
+Feature: display an unknown setting
+
+  Background:
+    Given a Git repo with origin
+    When I run "git-town config get zonk"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      unknown configuration key: "zonk"
+      """
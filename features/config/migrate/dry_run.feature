@@ -0,0 +1,15 @@
+Feature: dry-run migrating the configuration
+
+  Background:
+    Given a Git repo with origin
+    And local Git setting "git-town.sync-feature-strategy" is "rebase"
+    When I run "git-town config migrate file --dry-run"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                           |
+      |        | git config --unset git-town.main-branch           |
+      |        | git config --unset git-town.sync-feature-strategy |
+    And the configuration file now doesn't exist
+    And local Git setting "git-town.sync-feature-strategy" is still "rebase"
+    And local Git setting "git-town.main-branch" is still "main"
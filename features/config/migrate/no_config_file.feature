@@ -0,0 +1,10 @@
+Feature: migrate to Git metadata without a configuration file
+
+  Scenario:
+    Given a Git repo with origin
+    When I run "git-town config migrate git"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      this repository has no configuration file to migrate
+      """
@@ -0,0 +1,65 @@
+Feature: move the local Git settings into the configuration file
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS |
+      | feature | feature | main   | local     |
+    And local Git setting "git-town.perennial-branches" is "qa staging"
+    And local Git setting "git-town.sync-feature-strategy" is "rebase"
+    And local Git setting "git-town.push-new-branches" is "true"
+    And local Git setting "git-town.github-token" is "secret"
+    When I run "git-town config migrate file"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                           |
+      |        | git config --unset git-town.main-branch           |
+      |        | git config --unset git-town.perennial-branches    |
+      |        | git config --unset git-town.share-new-branches    |
+      |        | git config --unset git-town.sync-feature-strategy |
+    And Git Town prints:
+      """
+      Upgrading deprecated local setting git-town.push-new-branches to git-town.share-new-branches.
+      """
+    And Git Town prints:
+      """
+      moved the settings from the local Git metadata into git-town.toml
+      """
+    And the configuration file is now:
+      """
+      #:schema https://raw.githubusercontent.com/git-town/git-town/refs/heads/main/docs/git-town.schema.json
+
+      # See https://www.git-town.com/configuration-file for details
+
+      [branches]
+      main = "main"
+      perennials = ["qa", "staging"]
+
+      [create]
+      share-new-branches = "push"
+
+      [sync]
+      feature-strategy = "rebase"
+      """
+    And local Git setting "git-town.perennial-branches" now doesn't exist
+    And local Git setting "git-town.sync-feature-strategy" now doesn't exist
+    And local Git setting "git-town.push-new-branches" now doesn't exist
+    And local Git setting "git-town.share-new-branches" now doesn't exist
+    And local Git setting "git-town.main-branch" now doesn't exist
+    And local Git setting "git-town.github-token" is still "secret"
+    And local Git setting "git-town-branch.feature.parent" is still "main"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                     |
+      | feature | git add -A                  |
+      |         | git stash -m "Git Town WIP" |
+      |         | git stash pop               |
+      |         | git restore --staged .      |
+    And the configuration file now doesn't exist
+    And local Git setting "git-town.perennial-branches" is now "qa staging"
+    And local Git setting "git-town.sync-feature-strategy" is now "rebase"
+    And local Git setting "git-town.share-new-branches" is now "push"
+    And local Git setting "git-town.main-branch" is now "main"
@@ -0,0 +1,52 @@
+Feature: move the settings in the configuration file into the local Git metadata
+
+  Background:
+    Given a Git repo with origin
+    And the configuration file:
+      """
+      push-new-branches = true
+
+      [branches]
+      main = "main"
+      perennials = ["qa"]
+
+      [sync]
+      feature-strategy = "rebase"
+      """
+    When I run "git-town config migrate git"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                          |
+      |        | git config git-town.perennial-branches qa        |
+      |        | git config git-town.share-new-branches push      |
+      |        | git config git-town.sync-feature-strategy rebase |
+    And Git Town prints:
+      """
+      moved the settings from git-town.toml into the local Git metadata
+      """
+    And the configuration file now doesn't exist
+    And local Git setting "git-town.main-branch" is now "main"
+    And local Git setting "git-town.perennial-branches" is now "qa"
+    And local Git setting "git-town.share-new-branches" is now "push"
+    And local Git setting "git-town.sync-feature-strategy" is now "rebase"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND |
+    And the configuration file is now:
+      """
+      push-new-branches = true
+
+      [branches]
+      main = "main"
+      perennials = ["qa"]
+
+      [sync]
+      feature-strategy = "rebase"
+      """
+    And local Git setting "git-town.main-branch" is still "main"
+    And local Git setting "git-town.perennial-branches" is now ""
+    And local Git setting "git-town.share-new-branches" now doesn't exist
+    And local Git setting "git-town.sync-feature-strategy" now doesn't exist
@@ -0,0 +1,39 @@
+Feature: move the settings in a committed configuration file into the local Git metadata
+
+  Background:
+    Given a Git repo with origin
+    And the committed configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [sync]
+      feature-strategy = "rebase"
+      """
+    When I run "git-town config migrate git"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                          |
+      |        | git config git-town.sync-feature-strategy rebase |
+    And the configuration file now doesn't exist
+    And local Git setting "git-town.sync-feature-strategy" is now "rebase"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                     |
+      | main   | git add -A                  |
+      |        | git stash -m "Git Town WIP" |
+      |        | git stash pop               |
+      |        | git restore --staged .      |
+    And the configuration file is now:
+      """
+      [branches]
+      main = "main"
+
+      [sync]
+      feature-strategy = "rebase"
+      """
+    And no uncommitted files exist now
+    And local Git setting "git-town.sync-feature-strategy" now doesn't exist
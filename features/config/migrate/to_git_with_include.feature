@@ -0,0 +1,32 @@
+Feature: refuse to migrate a configuration file that includes other configuration files into Git metadata
+
+  Background:
+    Given a Git repo with origin
+    And the home directory contains file "org/git-town-base.toml" with content
+      """
+      [ship]
+      strategy = "squash-merge"
+      """
+    And the configuration file:
+      """
+      include = ["~/org/git-town-base.toml"]
+
+      [branches]
+      main = "main"
+      """
+    When I run "git-town config migrate git"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      cannot migrate git-town.toml into the local Git metadata because it includes other configuration files, please remove its "include" entry first
+      """
+    And the configuration file is still:
+      """
+      include = ["~/org/git-town-base.toml"]
+
+      [branches]
+      main = "main"
+      """
+    And local Git setting "git-town.ship-strategy" still doesn't exist
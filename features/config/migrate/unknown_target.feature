@@ -0,0 +1,10 @@
+Feature: migrate to an unknown location
+
+  Scenario:
+    Given a Git repo with origin
+    When I run "git-town config migrate zonk"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      unknown migration target "zonk", please use "file" or "git"
+      """
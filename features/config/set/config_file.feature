@@ -0,0 +1,22 @@
+Feature: store a setting in the configuration file
+
+  Background:
+    Given a Git repo with origin
+    And the configuration file:
+      """
+      # shared settings for this repo
+      [branches]
+      main = "main"
+      perennials = ["qa"]
+      """
+    When I run "git-town config set perennial-branches 'qa staging' --file"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And the configuration file is now:
+      """
+      # shared settings for this repo
+      [branches]
+      main = "main"
+      perennials = ["qa", "staging"]
+      """
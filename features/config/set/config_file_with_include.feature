@@ -0,0 +1,28 @@
+Feature: store a setting in a configuration file that includes other configuration files
+
+  Background:
+    Given a Git repo with origin
+    And the home directory contains file "org/git-town-base.toml" with content
+      """
+      [ship]
+      strategy = "squash-merge"
+      """
+    And the configuration file:
+      """
+      include = ["~/org/git-town-base.toml"]
+
+      [branches]
+      main = "main"
+      """
+    When I run "git-town config set perennial-branches qa --file"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And the configuration file is now:
+      """
+      include = ["~/org/git-town-base.toml"]
+
+      [branches]
+      main = "main"
+      perennials = ["qa"]
+      """
@@ -0,0 +1,13 @@
+Feature: provide conflicting storage locations
+
+  Background:
+    Given a Git repo with origin
+    When I run "git-town config set sync-feature-strategy rebase --global --local"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      if any flags in the group [file global local] are set none of the others can be; [global local] were all set
+      """
+    And local Git setting "git-town.sync-feature-strategy" still doesn't exist
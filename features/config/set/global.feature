@@ -0,0 +1,12 @@
+Feature: store a setting in the global Git metadata
+
+  Background:
+    Given a Git repo with origin
+    When I run "git-town config set git-town.ship-strategy squash-merge --global"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | COMMAND                                                 |
+      | git config --global git-town.ship-strategy squash-merge |
+    And global Git setting "git-town.ship-strategy" is now "squash-merge"
+    And local Git setting "git-town.ship-strategy" still doesn't exist
@@ -0,0 +1,23 @@
+Feature: store a setting in the existing hidden configuration file in the repository root
+
+  Background:
+    Given a Git repo with origin
+    And a folder "frontend"
+    And an uncommitted file ".git-town.toml" with content:
+      """
+      [branches]
+      main = "main"
+      """
+    When I run "git-town config set sync-tags false --file" in the "frontend" folder
+
+  Scenario: result
+    Then Git Town runs no commands
+    And file ".git-town.toml" now has content:
+      """
+      [branches]
+      main = "main"
+
+      [sync]
+      tags = false
+      """
+    And the configuration file now doesn't exist
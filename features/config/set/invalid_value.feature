@@ -0,0 +1,13 @@
+Feature: store an invalid value
+
+  Background:
+    Given a Git repo with origin
+    When I run "git-town config set sync-feature-strategy zonk"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      cannot parse git-town.sync-feature-strategy: unknown sync strategy: "zonk"
+      """
+    And local Git setting "git-town.sync-feature-strategy" still doesn't exist
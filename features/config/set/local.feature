@@ -0,0 +1,12 @@
+Feature: store a setting in the local Git metadata
+
+  Background:
+    Given a Git repo with origin
+    When I run "git-town config set sync-feature-strategy rebase"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | COMMAND                                          |
+      | git config git-town.sync-feature-strategy rebase |
+    And local Git setting "git-town.sync-feature-strategy" is now "rebase"
+    And global Git setting "git-town.sync-feature-strategy" still doesn't exist
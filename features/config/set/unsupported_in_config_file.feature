@@ -0,0 +1,12 @@
+Feature: store a setting that the configuration file cannot contain
+
+  Background:
+    Given a Git repo with origin
+    When I run "git-town config set github-token 123456 --file"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      the configuration file cannot store "git-town.github-token", please store it in the Git metadata
+      """
@@ -0,0 +1,20 @@
+Feature: validate a configuration file that contains forge credentials
+
+  Scenario: result
+    Given a Git repo with origin
+    And the configuration file:
+      """
+      [hosting]
+      forge-type = "github"
+      github-token = "secret"
+      """
+    When I run "git-town config validate"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      forge credentials in hosting.github-token, please store them in the user configuration file or in the Git metadata
+      """
+    And Git Town prints the error:
+      """
+      the configuration file git-town.toml contains problems
+      """
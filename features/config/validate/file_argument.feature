@@ -0,0 +1,19 @@
+Feature: validate the given configuration file
+
+  Scenario: result
+    Given a Git repo with origin
+    And an uncommitted file "shared.toml" with content:
+      """
+      [hosting]
+      forge-type = "zonk"
+      """
+    When I run "git-town config validate shared.toml"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      unknown forge type defined in hosting.forge-type: "zonk"
+      """
+    And Git Town prints the error:
+      """
+      the configuration file shared.toml contains problems
+      """
@@ -0,0 +1,10 @@
+Feature: validate without a configuration file
+
+  Scenario: result
+    Given a Git repo with origin
+    When I run "git-town config validate"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      this repository has no configuration file, please provide the file to validate
+      """
@@ -0,0 +1,27 @@
+Feature: validate a configuration file that contains problems
+
+  Scenario: result
+    Given a Git repo with origin
+    And the configuration file:
+      """
+      push-hook = true
+
+      [branches]
+      main = "main"
+
+      [sync]
+      feature-stratgy = "merge"
+      perennial-strategy = "zonk"
+      """
+    When I run "git-town config validate"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      unknown key sync.feature-stratgy, did you mean sync.feature-strategy?
+      deprecated key push-hook, please use sync.push-hook instead
+      cannot parse sync.perennial-strategy: unknown sync strategy: "zonk"
+      """
+    And Git Town prints the error:
+      """
+      the configuration file git-town.toml contains problems
+      """
@@ -0,0 +1,10 @@
+Feature: print the JSON Schema of the configuration file
+
+  Scenario: result
+    Given a Git repo with origin
+    When I run "git-town config validate --schema"
+    Then Git Town runs no commands
+    And Git Town prints:
+      """
+      "$id": "https://www.git-town.com/git-town.toml"
+      """
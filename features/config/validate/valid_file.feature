@@ -0,0 +1,21 @@
+Feature: validate a valid configuration file
+
+  Scenario: result
+    Given a Git repo with origin
+    And the configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [branches."feature/*"]
+      sync-feature-strategy = "rebase"
+
+      [sync]
+      feature-strategy = "merge"
+      """
+    When I run "git-town config validate"
+    Then Git Town runs no commands
+    And Git Town prints:
+      """
+      the configuration file git-town.toml is valid
+      """
@@ -36,12 +36,14 @@ Feature: show the configuration when using an alternative config file
         new branch type: (not set)
         share new branches: no
         stash uncommitted changes: yes
+        worktree root: (not set)
 
       Hosting:
         browser: (not set)
         development remote: origin
         forge type: (not set)
         origin hostname: (not set)
+        Azure DevOps token: (not set)
         Bitbucket username: (not set)
         Bitbucket app password: (not set)
         Forgejo token: (not set)
@@ -54,8 +56,14 @@ Feature: show the configuration when using an alternative config file
       Propose:
         breadcrumb: none
         breadcrumb direction: down
+        create drafts: no
+        assignees: (none)
+        labels: (none)
+        reviewers: (none)
+        template: (not set)
 
       Ship:
+        api merge method: squash
         delete tracking branch: yes
         ignore uncommitted changes: no
         ship strategy: api
@@ -41,9 +41,15 @@ Feature: display information from config file
       origin-hostname = "github.com"
 
       [propose]
+      assignees = ["alice"]
       breadcrumb = "stacks"
+      draft = true
+      labels = ["team-a", "backend"]
+      reviewers = ["bob", "carol"]
+      template = ".github/proposal.tmpl"
 
       [ship]
+      api-merge-method = "rebase"
       delete-tracking-branch = true
       ignore-uncommitted = true
       strategy = "squash-merge"
@@ -87,12 +93,14 @@ Feature: display information from config file
         new branch type: (not set)
         share new branches: push
         stash uncommitted changes: no
+        worktree root: (not set)
 
       Hosting:
         browser: chrome
         development remote: origin
         forge type: github
         origin hostname: github.com
+        Azure DevOps token: (not set)
         Bitbucket username: (not set)
         Bitbucket app password: (not set)
         Forgejo token: (not set)
@@ -105,8 +113,14 @@ Feature: display information from config file
       Propose:
         breadcrumb: stacks
         breadcrumb direction: down
+        create drafts: yes
+        assignees: alice
+        labels: team-a, backend
+        reviewers: bob, carol
+        template: .github/proposal.tmpl
 
       Ship:
+        api merge method: rebase
         delete tracking branch: yes
         ignore uncommitted changes: yes
         ship strategy: squash-merge
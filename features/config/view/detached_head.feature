@@ -60,12 +60,14 @@ Feature: display configuration from Git metadata in detached head state
         new branch type: (not set)
         share new branches: no
         stash uncommitted changes: no
+        worktree root: (not set)
 
       Hosting:
         browser: firefox
         development remote: origin
         forge type: (not set)
         origin hostname: (not set)
+        Azure DevOps token: (not set)
         Bitbucket username: (not set)
         Bitbucket app password: (not set)
         Forgejo token: (not set)
@@ -78,8 +80,14 @@ Feature: display configuration from Git metadata in detached head state
       Propose:
         breadcrumb: branches
         breadcrumb direction: down
+        create drafts: no
+        assignees: (none)
+        labels: (none)
+        reviewers: (none)
+        template: (not set)
 
       Ship:
+        api merge method: squash
         delete tracking branch: yes
         ignore uncommitted changes: yes
         ship strategy: squash-merge
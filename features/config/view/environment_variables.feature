@@ -14,6 +14,7 @@ Feature: display configuration defined in environment variables
     When I run "git-town config" with these environment variables
       | GIT_TOWN_AUTO_RESOLVE                  | false              |
       | GIT_TOWN_AUTO_SYNC                     | false              |
+      | GIT_TOWN_AZUREDEVOPS_TOKEN             | azuredevops-token  |
       | GIT_TOWN_BITBUCKET_APP_PASSWORD        | bitbucket-password |
       | GIT_TOWN_BITBUCKET_USERNAME            | bitbucket-user     |
       | GIT_TOWN_BRANCH_PREFIX                 | acme-              |
@@ -44,6 +45,7 @@ Feature: display configuration defined in environment variables
       | GIT_TOWN_PUSH_BRANCHES                 | no                 |
       | GIT_TOWN_PUSH_HOOK                     | no                 |
       | GIT_TOWN_SHARE_NEW_BRANCHES            | push               |
+      | GIT_TOWN_SHIP_API_MERGE_METHOD         | merge              |
       | GIT_TOWN_SHIP_DELETE_TRACKING_BRANCH   | 0                  |
       | GIT_TOWN_SHIP_STRATEGY                 | fast-forward       |
       | GIT_TOWN_STASH                         | false              |
@@ -80,12 +82,14 @@ Feature: display configuration defined in environment variables
         new branch type: prototype
         share new branches: push
         stash uncommitted changes: no
+        worktree root: (not set)
 
       Hosting:
         browser: firefox
         development remote: my-fork
         forge type: gitlab
         origin hostname: codeforge
+        Azure DevOps token: azuredevops-token
         Bitbucket username: bitbucket-user
         Bitbucket app password: bitbucket-password
         Forgejo token: forgejo-token
@@ -98,8 +102,14 @@ Feature: display configuration defined in environment variables
       Propose:
         breadcrumb: stacks
         breadcrumb direction: up
+        create drafts: no
+        assignees: (none)
+        labels: (none)
+        reviewers: (none)
+        template: (not set)
 
       Ship:
+        api merge method: merge
         delete tracking branch: no
         ignore uncommitted changes: yes
         ship strategy: fast-forward
@@ -29,6 +29,7 @@ Feature: display configuration from Git metadata
     And Git setting "git-town.perennial-branches" is "qa staging"
     And Git setting "git-town.perennial-regex" is "^release-"
     And Git setting "git-town.proposal-breadcrumb" is "stacks"
+    And Git setting "git-town.ship-api-merge-method" is "rebase"
     And Git setting "git-town.ship-strategy" is "squash-merge"
     And Git setting "git-town.stash" is "false"
     And Git setting "git-town.unknown-branch-type" is "observed"
@@ -60,12 +61,14 @@ Feature: display configuration from Git metadata
         new branch type: (not set)
         share new branches: no
         stash uncommitted changes: no
+        worktree root: (not set)
 
       Hosting:
         browser: firefox
         development remote: origin
         forge type: (not set)
         origin hostname: (not set)
+        Azure DevOps token: (not set)
         Bitbucket username: (not set)
         Bitbucket app password: (not set)
         Forgejo token: (not set)
@@ -78,8 +81,14 @@ Feature: display configuration from Git metadata
       Propose:
         breadcrumb: stacks
         breadcrumb direction: down
+        create drafts: no
+        assignees: (none)
+        labels: (none)
+        reviewers: (none)
+        template: (not set)
 
       Ship:
+        api merge method: rebase
         delete tracking branch: yes
         ignore uncommitted changes: yes
         ship strategy: squash-merge
@@ -134,12 +143,14 @@ Feature: display configuration from Git metadata
         new branch type: (not set)
         share new branches: no
         stash uncommitted changes: yes
+        worktree root: (not set)
 
       Hosting:
         browser: (not set)
         development remote: origin
         forge type: (not set)
         origin hostname: (not set)
+        Azure DevOps token: (not set)
         Bitbucket username: (not set)
         Bitbucket app password: (not set)
         Forgejo token: (not set)
@@ -152,8 +163,14 @@ Feature: display configuration from Git metadata
       Propose:
         breadcrumb: none
         breadcrumb direction: down
+        create drafts: no
+        assignees: (none)
+        labels: (none)
+        reviewers: (none)
+        template: (not set)
 
       Ship:
+        api merge method: squash
         delete tracking branch: yes
         ignore uncommitted changes: no
         ship strategy: api
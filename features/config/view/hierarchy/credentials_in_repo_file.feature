@@ -0,0 +1,25 @@
+Feature: ignore forge credentials in the repo configuration file
+
+  Background:
+    Given a Git repo with origin
+    And the committed configuration file:
+      """
+      [hosting]
+      github-token = "secret"
+      """
+    When I run "git-town hack new"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git fetch --prune --tags |
+      |        | git checkout -b new      |
+    And Git Town prints:
+      """
+      Ignoring the forge credentials in the configuration file git-town.toml. Please store them in the user configuration file ~/.config/git-town/config.toml or in the Git metadata.
+      """
+    And this lineage exists now
+      """
+      main
+        new
+      """
@@ -0,0 +1,52 @@
+Feature: show the configuration of a configuration file that includes other configuration files
+
+  Background:
+    Given a Git repo with origin
+    And the home directory contains file "org/git-town-base.toml" with content
+      """
+      [branches]
+      order = "desc"
+
+      [ship]
+      strategy = "squash-merge"
+      """
+    And the configuration file:
+      """
+      include = ["~/org/git-town-base.toml"]
+
+      [branches]
+      main = "main"
+      order = "asc"
+      """
+
+  Scenario: result
+    When I run "git-town config"
+    Then Git Town prints:
+      """
+        order: asc
+      """
+    And Git Town prints:
+      """
+        ship strategy: squash-merge
+      """
+    And Git Town prints:
+      """
+      Configuration sources (later sources override earlier ones):
+        1. default values
+        2. included config file: ~/org/git-town-base.toml
+        3. config file: git-town.toml
+        4. Git metadata (global, then local)
+        5. environment variables
+        6. CLI flags
+      """
+
+  Scenario: the included file does not exist
+    Given the configuration file:
+      """
+      include = ["~/org/missing.toml"]
+      """
+    When I run "git-town config"
+    Then Git Town prints the error:
+      """
+      cannot read the configuration file ~/org/missing.toml
+      """
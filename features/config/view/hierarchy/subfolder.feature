@@ -0,0 +1,55 @@
+Feature: show the configuration from a subfolder that contains its own configuration file
+
+  Scenario: running in a subfolder with a configuration file
+    Given a Git repo with origin
+    And the configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [sync]
+      feature-strategy = "merge"
+      """
+    And a folder "frontend"
+    And an uncommitted file "frontend/git-town.toml" with content:
+      """
+      [sync]
+      feature-strategy = "rebase"
+      """
+    When I run "git-town config" in the "frontend" folder
+    Then Git Town prints:
+      """
+        feature sync strategy: rebase
+      """
+    And Git Town prints:
+      """
+      Configuration sources (later sources override earlier ones):
+        1. default values
+        2. config file: git-town.toml
+        3. subfolder config file: frontend/git-town.toml
+        4. Git metadata (global, then local)
+        5. environment variables
+        6. CLI flags
+      """
+
+  Scenario: running in the repo root
+    Given a Git repo with origin
+    And the configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [sync]
+      feature-strategy = "merge"
+      """
+    And a folder "frontend"
+    And an uncommitted file "frontend/git-town.toml" with content:
+      """
+      [sync]
+      feature-strategy = "rebase"
+      """
+    When I run "git-town config"
+    Then Git Town prints:
+      """
+        feature sync strategy: merge
+      """
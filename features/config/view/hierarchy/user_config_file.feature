@@ -0,0 +1,63 @@
+Feature: show the configuration from the user-wide configuration file
+
+  Background:
+    Given a Git repo with origin
+    And the home directory contains file ".config/git-town/config.toml" with content
+      """
+      [branches]
+      order = "desc"
+
+      [hosting]
+      browser = "firefox"
+      github-token = "user-token"
+      """
+
+  Scenario: only the user-wide configuration file
+    When I run "git-town config"
+    Then Git Town prints:
+      """
+        order: desc
+      """
+    And Git Town prints:
+      """
+        browser: firefox
+      """
+    And Git Town prints:
+      """
+        GitHub token: user-token
+      """
+    And Git Town prints:
+      """
+      Configuration sources (later sources override earlier ones):
+        1. default values
+        2. user config file: ~/.config/git-town/config.toml
+        3. Git metadata (global, then local)
+        4. environment variables
+        5. CLI flags
+      """
+
+  Scenario: the repo configuration file overrides the user-wide configuration file
+    Given the configuration file:
+      """
+      [branches]
+      order = "asc"
+      """
+    When I run "git-town config"
+    Then Git Town prints:
+      """
+        order: asc
+      """
+    And Git Town prints:
+      """
+        browser: firefox
+      """
+    And Git Town prints:
+      """
+      Configuration sources (later sources override earlier ones):
+        1. default values
+        2. user config file: ~/.config/git-town/config.toml
+        3. config file: git-town.toml
+        4. Git metadata (global, then local)
+        5. environment variables
+        6. CLI flags
+      """
@@ -37,12 +37,14 @@ Feature: show the configuration from a subfolder
         new branch type: (not set)
         share new branches: no
         stash uncommitted changes: yes
+        worktree root: (not set)
 
       Hosting:
         browser: (not set)
         development remote: origin
         forge type: (not set)
         origin hostname: (not set)
+        Azure DevOps token: (not set)
         Bitbucket username: (not set)
         Bitbucket app password: (not set)
         Forgejo token: (not set)
@@ -55,8 +57,14 @@ Feature: show the configuration from a subfolder
       Propose:
         breadcrumb: none
         breadcrumb direction: down
+        create drafts: no
+        assignees: (none)
+        labels: (none)
+        reviewers: (none)
+        template: (not set)
 
       Ship:
+        api merge method: squash
         delete tracking branch: yes
         ignore uncommitted changes: no
         ship strategy: api
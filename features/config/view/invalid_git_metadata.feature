@@ -76,12 +76,14 @@ Feature: display invalid Git metadata
         new branch type: (not set)
         share new branches: no
         stash uncommitted changes: yes
+        worktree root: (not set)
 
       Hosting:
         browser: (not set)
         development remote: origin
         forge type: (not set)
         origin hostname: (not set)
+        Azure DevOps token: (not set)
         Bitbucket username: (not set)
         Bitbucket app password: (not set)
         Forgejo token: (not set)
@@ -94,8 +96,14 @@ Feature: display invalid Git metadata
       Propose:
         breadcrumb: none
         breadcrumb direction: down
+        create drafts: no
+        assignees: (none)
+        labels: (none)
+        reviewers: (none)
+        template: (not set)
 
       Ship:
+        api merge method: squash
         delete tracking branch: yes
         ignore uncommitted changes: no
         ship strategy: api
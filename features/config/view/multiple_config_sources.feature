@@ -111,12 +111,14 @@ Feature: show the configuration
         new branch type: (not set)
         share new branches: no
         stash uncommitted changes: no
+        worktree root: (not set)
 
       Hosting:
         browser: firefox
         development remote: origin
         forge type: github
         origin hostname: github.com
+        Azure DevOps token: (not set)
         Bitbucket username: (not set)
         Bitbucket app password: (not set)
         Forgejo token: (not set)
@@ -129,8 +131,14 @@ Feature: show the configuration
       Propose:
         breadcrumb: stacks
         breadcrumb direction: down
+        create drafts: no
+        assignees: (none)
+        labels: (none)
+        reviewers: (none)
+        template: (not set)
 
       Ship:
+        api merge method: squash
         delete tracking branch: no
         ignore uncommitted changes: no
         ship strategy: squash-merge
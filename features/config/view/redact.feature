@@ -2,6 +2,7 @@ Feature: redact API tokens in config output
 
   Background:
     Given a Git repo with origin
+    And Git setting "git-town.azuredevops-token" is "azuredevops-token"
     And Git setting "git-town.bitbucket-app-password" is "bitbucket-password"
     And Git setting "git-town.forgejo-token" is "forgejo-token"
     And Git setting "git-town.gitea-token" is "gitea-token"
@@ -17,6 +18,7 @@ Feature: redact API tokens in config output
         development remote: origin
         forge type: (not set)
         origin hostname: (not set)
+        Azure DevOps token: (configured)
         Bitbucket username: (not set)
         Bitbucket app password: (configured)
         Forgejo token: (configured)
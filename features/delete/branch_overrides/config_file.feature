@@ -0,0 +1,47 @@
+Feature: delete a branch whose settings the configuration file overrides
+
+  Background:
+    Given a Git repo with origin
+    And the committed configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [branches."feature"]
+      sync-feature-strategy = "rebase"
+      """
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+      | other   | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+      | other   | local, origin | other commit   |
+    And local Git setting "git-town-branch.feature.ship-strategy" is "fast-forward"
+    And the current branch is "feature"
+    When I run "git-town delete"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+      |         | git push origin :feature |
+      |         | git checkout other       |
+      | other   | git branch -D feature    |
+    And local Git setting "git-town-branch.feature.ship-strategy" now doesn't exist
+    And local Git setting "git-town-branch.feature.sync-feature-strategy" still doesn't exist
+    And the branches are now
+      | REPOSITORY    | BRANCHES    |
+      | local, origin | main, other |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                       |
+      | other  | git branch feature {{ sha 'feature commit' }} |
+      |        | git push -u origin feature                    |
+      |        | git checkout feature                          |
+    And local Git setting "git-town-branch.feature.ship-strategy" is now "fast-forward"
+    And the initial branches and lineage exist now
+    And the initial commits exist now
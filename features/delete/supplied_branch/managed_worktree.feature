@@ -0,0 +1,35 @@
+Feature: delete a branch that has a worktree created by Git Town
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME | TYPE    | PARENT | LOCATIONS     |
+      | good | feature | main   | local, origin |
+    And the current branch is "good"
+    And Git setting "git-town.share-new-branches" is "push"
+    And I ran "git-town worktree add dead"
+    When I run "git-town delete dead"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                         |
+      | good   | git fetch --prune --tags                        |
+      |        | git push origin :dead                           |
+      |        | git worktree remove ../developer.worktrees/dead |
+      |        | git branch -D dead                              |
+    And the current branch is still "good"
+    And this lineage exists now
+      """
+      main
+        good
+      """
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                           |
+      | good   | git branch dead {{ sha 'initial commit' }}        |
+      |        | git push -u origin dead                           |
+      |        | git worktree add ../developer.worktrees/dead dead |
+    And the current branch is still "good"
+    And the initial branches and lineage exist now
@@ -0,0 +1,24 @@
+Feature: does not delete a branch whose worktree contains uncommitted changes
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME | TYPE    | PARENT | LOCATIONS     |
+      | good | feature | main   | local, origin |
+    And the current branch is "good"
+    And I ran "git-town worktree add dead"
+    And I ran "touch ../developer.worktrees/dead/uncommitted"
+    When I run "git-town delete dead"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                  |
+      | good   | git fetch --prune --tags |
+    And Git Town prints the error:
+      """
+      cannot remove worktree ../developer.worktrees/dead of branch dead because it has uncommitted changes
+      """
+  #
+  # NOTE: Cannot test undo here.
+  # The Git Town command under test has not created an undoable runstate.
+  # Executing "git town undo" would undo the Git Town command executed during setup.
@@ -0,0 +1,79 @@
+Feature: propose uncommitted changes via a separate top-level branch as a draft
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the branches
+      | NAME     | TYPE    | PARENT | LOCATIONS     |
+      | existing | feature | main   | local, origin |
+    And the commits
+      | BRANCH   | LOCATION | MESSAGE         |
+      | existing | local    | existing commit |
+    And the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | TITLE           | URL                      |
+      | 1  | existing      | main          | existing branch | https://example.com/pr/1 |
+    And the current branch is "existing"
+    And tool "open" is installed
+    And an uncommitted file "new_file" with content "new content"
+    And I ran "git add new_file"
+    When I run "git-town hack new --propose --draft -m unrelated"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                                          |
+      | existing | git checkout -b new main                         |
+      | new      | git commit -m unrelated                          |
+      |          | git push -u origin new                           |
+      |          | Finding proposal from new into main ... none     |
+      |          | Creating proposal from new into main ... #2      |
+      |          | open https://github.com/git-town/git-town/pull/2 |
+      |          | git checkout existing                            |
+    And this lineage exists now
+      """
+      main
+        existing
+        new
+      """
+    And these commits exist now
+      | BRANCH   | LOCATION      | MESSAGE         |
+      | existing | local         | existing commit |
+      | new      | local, origin | unrelated       |
+    And the proposals are now
+      """
+      url: https://example.com/pr/1
+      number: 1
+      source: existing
+      target: main
+      body:
+
+      url: https://github.com/git-town/git-town/pull/2
+      number: 2
+      source: new
+      target: main
+      draft: true
+      body:
+      """
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND              |
+      | existing | git branch -D new    |
+      |          | git push origin :new |
+    And the initial branches and lineage exist now
+    And the initial commits exist now
+    And the proposals are now
+      """
+      url: https://example.com/pr/1
+      number: 1
+      source: existing
+      target: main
+      body:
+
+      url: https://github.com/git-town/git-town/pull/2
+      number: 2
+      source: new
+      target: main
+      draft: true
+      body:
+      """
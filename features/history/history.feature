@@ -0,0 +1,21 @@
+Feature: display the history of Git Town commands
+
+  Scenario: commands ran
+    Given a Git repo with origin
+    And I ran "git-town hack alpha"
+    And I ran "git-town hack beta"
+    When I run "git-town history"
+    Then Git Town prints something like:
+      """
+      Git Town command history, most recent first:
+       1  \d{4}-\d\d-\d\d \d\d:\d\d:\d\d  hack  beta, main
+       2  \d{4}-\d\d-\d\d \d\d:\d\d:\d\d  hack  alpha, main
+      """
+
+  Scenario: no commands ran
+    Given a Git repo with origin
+    When I run "git-town history"
+    Then Git Town prints:
+      """
+      Git Town hasn't recorded any commands yet.
+      """
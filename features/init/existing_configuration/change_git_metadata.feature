@@ -48,7 +48,7 @@ Feature: change existing information in Git metadata
       | share-new-branches            | down enter             |
       | push branches                 | down enter             |
       | push hook                     | down enter             |
-      | ship strategy                 | down down enter        |
+      | ship strategy                 | down down down enter   |
       | ship delete tracking branch   | down enter             |
       | ignore-uncommitted            | up enter               |
       | order                         | up enter               |
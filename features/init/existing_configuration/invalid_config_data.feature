@@ -55,7 +55,7 @@ Feature: Fix invalid configuration data
       | share-new-branches            | down enter                    |
       | push-branches                 | down enter                    |
       | push-hook                     | down enter                    |
-      | ship-strategy                 | down enter                    |
+      | ship-strategy                 | down down enter               |
       | ship-delete-tracking branch   | down enter                    |
       | ignore-uncommitted            | down enter                    |
       | order                         | down enter                    |
@@ -14,6 +14,7 @@ Feature: enter Azure DevOps
       | perennial branches |       | no input here since the dialog doesn't show |
       | origin hostname    | enter |                                             |
       | forge type         | enter | auto-detect                                 |
+      | azuredevops token  | enter |                                             |
       | enter all          | enter |                                             |
       | config storage     | enter | git metadata                                |
     Then Git Town runs no commands
@@ -28,6 +29,7 @@ Feature: enter Azure DevOps
       | perennial branches |            | no input here since the dialog doesn't show |
       | origin hostname    | enter      |                                             |
       | forge type         | down enter |                                             |
+      | azuredevops token  | enter      |                                             |
       | enter all          | enter      |                                             |
       | config storage     | enter      | git metadata                                |
     Then Git Town runs the commands
@@ -0,0 +1,64 @@
+@messyoutput
+Feature: enter the Azure DevOps API token
+
+  Background:
+    Given a Git repo with origin
+
+  Scenario: auto-detected Azure DevOps platform
+    And my repo's "origin" remote is "git@ssh.dev.azure.com:v3/kevingoslar/tikibase/tikibase"
+    When I run "git-town init" and enter into the dialog:
+      | DIALOG             | KEYS              | DESCRIPTION                                 |
+      | welcome            | enter             |                                             |
+      | aliases            | enter             |                                             |
+      | main branch        | enter             |                                             |
+      | perennial branches |                   | no input here since the dialog doesn't show |
+      | origin hostname    | enter             |                                             |
+      | forge type         | enter             | auto-detect                                 |
+      | azuredevops token  | a z - t o k enter |                                             |
+      | token scope        | enter             |                                             |
+      | enter all          | enter             |                                             |
+      | config storage     | enter             | git metadata                                |
+    Then Git Town runs the commands
+      | COMMAND                                      |
+      | git config git-town.azuredevops-token az-tok |
+    And local Git setting "git-town.forge-type" still doesn't exist
+    And local Git setting "git-town.azuredevops-token" is now "az-tok"
+
+  Scenario: select Azure DevOps manually
+    When I run "git-town init" and enter into the dialog:
+      | DIALOG             | KEYS              | DESCRIPTION                                 |
+      | welcome            | enter             |                                             |
+      | aliases            | enter             |                                             |
+      | main branch        | enter             |                                             |
+      | perennial branches |                   | no input here since the dialog doesn't show |
+      | origin hostname    | enter             |                                             |
+      | forge type         | down enter        |                                             |
+      | azuredevops token  | a z - t o k enter |                                             |
+      | token scope        | enter             |                                             |
+      | enter all          | enter             |                                             |
+      | config storage     | enter             | git metadata                                |
+    Then Git Town runs the commands
+      | COMMAND                                      |
+      | git config git-town.azuredevops-token az-tok |
+      | git config git-town.forge-type azuredevops   |
+    And local Git setting "git-town.forge-type" is now "azuredevops"
+    And local Git setting "git-town.azuredevops-token" is now "az-tok"
+
+  Scenario: store Azure DevOps API token globally
+    And my repo's "origin" remote is "git@ssh.dev.azure.com:v3/kevingoslar/tikibase/tikibase"
+    When I run "git-town init" and enter into the dialog:
+      | DIALOG             | KEYS              | DESCRIPTION                                 |
+      | welcome            | enter             |                                             |
+      | aliases            | enter             |                                             |
+      | main branch        | enter             |                                             |
+      | perennial branches |                   | no input here since the dialog doesn't show |
+      | origin hostname    | enter             |                                             |
+      | forge type         | enter             |                                             |
+      | azuredevops token  | a z - t o k enter |                                             |
+      | token scope        | down enter        |                                             |
+      | enter all          | enter             |                                             |
+      | config storage     | enter             | git metadata                                |
+    Then Git Town runs the commands
+      | COMMAND                                               |
+      | git config --global git-town.azuredevops-token az-tok |
+    And global Git setting "git-town.azuredevops-token" is now "az-tok"
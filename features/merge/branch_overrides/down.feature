@@ -0,0 +1,47 @@
+Feature: merge a branch that overrides settings into its child branch
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+    And the branches
+      | NAME | TYPE    | PARENT | LOCATIONS     |
+      | beta | feature | alpha  | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE     |
+      | beta   | local, origin | beta commit |
+    And local Git setting "git-town-branch.alpha.sync-feature-strategy" is "rebase"
+    And local Git setting "git-town-branch.alpha.ship-strategy" is "fast-forward"
+    And the current branch is "alpha"
+    When I run "git-town merge --down"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                  |
+      | alpha  | git fetch --prune --tags |
+      |        | git checkout beta        |
+      | beta   | git push origin :alpha   |
+      |        | git branch -D alpha      |
+    And local Git setting "git-town-branch.alpha.sync-feature-strategy" now doesn't exist
+    And local Git setting "git-town-branch.alpha.ship-strategy" now doesn't exist
+    And this lineage exists now
+      """
+      main
+        beta
+      """
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                   |
+      | beta   | git branch alpha {{ sha 'alpha commit' }} |
+      |        | git push -u origin alpha                  |
+      |        | git checkout alpha                        |
+    And local Git setting "git-town-branch.alpha.sync-feature-strategy" is now "rebase"
+    And local Git setting "git-town-branch.alpha.ship-strategy" is now "fast-forward"
+    And the initial lineage exists now
+    And the initial commits exist now
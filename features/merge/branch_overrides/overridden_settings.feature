@@ -0,0 +1,51 @@
+Feature: merge a branch that overrides settings
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+    And the branches
+      | NAME | TYPE    | PARENT | LOCATIONS     |
+      | beta | feature | alpha  | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE     |
+      | beta   | local, origin | beta commit |
+    And local Git setting "git-town-branch.beta.sync-feature-strategy" is "rebase"
+    And local Git setting "git-town-branch.beta.ship-strategy" is "fast-forward"
+    And the current branch is "beta"
+    When I run "git-town merge"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                         |
+      | beta   | git fetch --prune --tags                        |
+      |        | git checkout alpha                              |
+      | alpha  | git reset --hard {{ sha 'beta commit' }}        |
+      |        | git push origin :beta                           |
+      |        | git branch -D beta                              |
+      |        | git push --force-with-lease --force-if-includes |
+    And local Git setting "git-town-branch.beta.sync-feature-strategy" now doesn't exist
+    And local Git setting "git-town-branch.beta.ship-strategy" now doesn't exist
+    And this lineage exists now
+      """
+      main
+        alpha
+      """
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                         |
+      | alpha  | git reset --hard {{ sha 'alpha commit' }}       |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git branch beta {{ sha 'beta commit' }}         |
+      |        | git push -u origin beta                         |
+      |        | git checkout beta                               |
+    And local Git setting "git-town-branch.beta.sync-feature-strategy" is now "rebase"
+    And local Git setting "git-town-branch.beta.ship-strategy" is now "fast-forward"
+    And the initial lineage exists now
+    And the initial commits exist now
@@ -0,0 +1,84 @@
+Feature: merging a branch into its child branch
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+    And the branches
+      | NAME | TYPE    | PARENT | LOCATIONS     |
+      | beta | feature | alpha  | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE     |
+      | beta   | local, origin | beta commit |
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | gamma | feature | beta   | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | gamma  | local, origin | gamma commit |
+    And the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | TITLE          | BODY       | URL                      |
+      | 1  | alpha         | main          | alpha proposal | alpha body | https://example.com/pr/1 |
+      | 2  | beta          | alpha         | beta proposal  | beta body  | https://example.com/pr/2 |
+      | 3  | gamma         | beta          | gamma proposal | gamma body | https://example.com/pr/3 |
+    And the current branch is "alpha"
+    When I run "git-town merge --down"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                      |
+      | alpha  | git fetch --prune --tags                                     |
+      |        | Finding proposal from beta into alpha ... #2 (beta proposal) |
+      |        | Updating target branch of proposal #2 to main ... ok         |
+      |        | git checkout beta                                            |
+      | beta   | git push origin :alpha                                       |
+      |        | git branch -D alpha                                          |
+    And this lineage exists now
+      """
+      main
+        beta
+          gamma
+      """
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE      |
+      | beta   | local, origin | alpha commit |
+      |        |               | beta commit  |
+      | gamma  | local, origin | gamma commit |
+    And the proposals are now
+      """
+      url: https://example.com/pr/1
+      number: 1
+      source: alpha
+      target: main
+      body:
+        alpha body
+      url: https://example.com/pr/2
+      number: 2
+      source: beta
+      target: main
+      body:
+        beta body
+      url: https://example.com/pr/3
+      number: 3
+      source: gamma
+      target: beta
+      body:
+        gamma body
+      """
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                               |
+      | beta   | git branch alpha {{ sha 'alpha commit' }}             |
+      |        | git push -u origin alpha                              |
+      |        | Updating target branch of proposal #2 to alpha ... ok |
+      |        | git checkout alpha                                    |
+    And the initial lineage exists now
+    And the initial commits exist now
+    And the initial proposals exist now
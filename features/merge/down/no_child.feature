@@ -0,0 +1,24 @@
+Feature: merging a branch without children into its child branch
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+    And the current branch is "alpha"
+    When I run "git-town merge --down"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                  |
+      | alpha  | git fetch --prune --tags |
+    And Git Town prints the error:
+      """
+      cannot merge branch alpha down because it has no child branch
+      """
+    And the initial lineage exists now
+    And the initial commits exist now
+    #
+    # NOTE: Cannot test undo here.
+    # The Git Town command under test has not created an undoable runstate.
+    # Executing "git town undo" would undo the Git Town command executed during setup.
@@ -0,0 +1,29 @@
+Feature: merging a branch into a child branch that doesn't contain all of its commits
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+      | beta  | feature | alpha  | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+      | beta   | local, origin | beta commit  |
+    And the current branch is "alpha"
+    When I run "git-town merge --down"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                  |
+      | alpha  | git fetch --prune --tags |
+    And Git Town prints the error:
+      """
+      branch beta is not in sync with its parent, please run "git town sync" and try again
+      """
+    And the initial lineage exists now
+    And the initial commits exist now
+    #
+    # NOTE: Cannot test undo here.
+    # The Git Town command under test has not created an undoable runstate.
+    # Executing "git town undo" would undo the Git Town command executed during setup.
@@ -23,27 +23,86 @@ Feature: propose a stack with "stacks" setting
 
   Scenario: result
     Then Git Town runs the commands
-      | BRANCH   | COMMAND                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                       |
-      | branch-2 | git fetch --prune --tags                                                                                                                                                                                                                                                                                                                                                                                                                                                                                      |
-      |          | Finding proposal from branch-1 into main ... #1 (branch-1 proposal)                                                                                                                                                                                                                                                                                                                                                                                                                                           |
-      |          | Finding proposal from branch-2 into branch-1 ... none                                                                                                                                                                                                                                                                                                                                                                                                                                                         |
-      |          | Finding proposal from branch-3 into branch-2 ... none                                                                                                                                                                                                                                                                                                                                                                                                                                                         |
-      |          | git checkout branch-1                                                                                                                                                                                                                                                                                                                                                                                                                                                                                         |
-      | branch-1 | git checkout branch-2                                                                                                                                                                                                                                                                                                                                                                                                                                                                                         |
-      | branch-2 | git merge --no-edit --ff branch-1                                                                                                                                                                                                                                                                                                                                                                                                                                                                             |
-      |          | git push                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                      |
-      |          | git checkout branch-3                                                                                                                                                                                                                                                                                                                                                                                                                                                                                         |
-      | branch-3 | git merge --no-edit --ff branch-2                                                                                                                                                                                                                                                                                                                                                                                                                                                                             |
-      |          | git push                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                      |
-      |          | git checkout branch-1                                                                                                                                                                                                                                                                                                                                                                                                                                                                                         |
-      | branch-1 | open https://example.com/pr/1                                                                                                                                                                                                                                                                                                                                                                                                                                                                                 |
-      |          | git checkout branch-2                                                                                                                                                                                                                                                                                                                                                                                                                                                                                         |
-      | branch-2 | open https://github.com/git-town/git-town/compare/branch-1...branch-2?expand=1&body=%3C%21--+branch-stack-start+--%3E%0A%0A-------------------------%0A-+main%0A++-+https%3A%2F%2Fexample.com%2Fpr%2F1%0A++++-+%2A%2Abranch-2%2A%2A+%3Apoint_left%3A%0A%0A%3Csup%3E%5BStack%5D%28https%3A%2F%2Fwww.git-town.com%2Fhow-to%2Fproposal-breadcrumb.html%29+generated+by+%5BGit+Town%5D%28https%3A%2F%2Fgithub.com%2Fgit-town%2Fgit-town%29%3C%2Fsup%3E%0A%0A%3C%21--+branch-stack-end+--%3E%0A                    |
-      |          | Finding all proposals for branch-2 ... none                                                                                                                                                                                                                                                                                                                                                                                                                                                                   |
-      |          | git checkout branch-3                                                                                                                                                                                                                                                                                                                                                                                                                                                                                         |
-      | branch-3 | open https://github.com/git-town/git-town/compare/branch-2...branch-3?expand=1&body=%3C%21--+branch-stack-start+--%3E%0A%0A-------------------------%0A-+main%0A++-+https%3A%2F%2Fexample.com%2Fpr%2F1%0A++++-+branch-2%0A++++++-+%2A%2Abranch-3%2A%2A+%3Apoint_left%3A%0A%0A%3Csup%3E%5BStack%5D%28https%3A%2F%2Fwww.git-town.com%2Fhow-to%2Fproposal-breadcrumb.html%29+generated+by+%5BGit+Town%5D%28https%3A%2F%2Fgithub.com%2Fgit-town%2Fgit-town%29%3C%2Fsup%3E%0A%0A%3C%21--+branch-stack-end+--%3E%0A |
-      |          | Finding all proposals for branch-3 ... none                                                                                                                                                                                                                                                                                                                                                                                                                                                                   |
-    And the initial proposals exist now
+      | BRANCH   | COMMAND                                                             |
+      | branch-2 | git fetch --prune --tags                                            |
+      |          | git checkout branch-1                                               |
+      | branch-1 | git checkout branch-2                                               |
+      | branch-2 | git merge --no-edit --ff branch-1                                   |
+      |          | git push                                                            |
+      |          | git checkout branch-3                                               |
+      | branch-3 | git merge --no-edit --ff branch-2                                   |
+      |          | git push                                                            |
+      |          | git checkout branch-1                                               |
+      |          | Finding proposal from branch-1 into main ... #1 (branch-1 proposal) |
+      | branch-1 | git checkout branch-2                                               |
+      |          | Finding proposal from branch-2 into branch-1 ... none               |
+      |          | Creating proposal from branch-2 into branch-1 ... #2                |
+      | branch-2 | git checkout branch-3                                               |
+      |          | Finding proposal from branch-3 into branch-2 ... none               |
+      |          | Creating proposal from branch-3 into branch-2 ... #3                |
+      |          | Finding all proposals for branch-1 ... main                         |
+      |          | Update body for #1 ... ok                                           |
+      |          | Finding all proposals for branch-2 ... branch-1                     |
+      |          | Finding proposal from branch-1 into main ... #1 (branch-1 proposal) |
+      |          | Update body for #2 ... ok                                           |
+      |          | Finding all proposals for branch-3 ... branch-2                     |
+      |          | Finding proposal from branch-2 into branch-1 ... #2 (commit 2)      |
+      |          | Update body for #3 ... ok                                           |
+    And the proposals are now
+      """
+      url: https://example.com/pr/1
+      number: 1
+      source: branch-1
+      target: main
+      body:
+        branch-1 body
+
+        <!-- branch-stack-start -->
+
+        -------------------------
+        - main
+          - **branch-1** :point_left:
+            - https://github.com/git-town/git-town/pull/2
+              - https://github.com/git-town/git-town/pull/3
+
+        <sup>[Stack](https://www.git-town.com/how-to/proposal-breadcrumb.html) generated by [Git Town](https://github.com/git-town/git-town)</sup>
+
+        <!-- branch-stack-end -->
+
+      url: https://github.com/git-town/git-town/pull/2
+      number: 2
+      source: branch-2
+      target: branch-1
+      body:
+        <!-- branch-stack-start -->
+
+        -------------------------
+        - main
+          - https://example.com/pr/1
+            - **branch-2** :point_left:
+              - https://github.com/git-town/git-town/pull/3
+
+        <sup>[Stack](https://www.git-town.com/how-to/proposal-breadcrumb.html) generated by [Git Town](https://github.com/git-town/git-town)</sup>
+
+        <!-- branch-stack-end -->
+
+      url: https://github.com/git-town/git-town/pull/3
+      number: 3
+      source: branch-3
+      target: branch-2
+      body:
+        <!-- branch-stack-start -->
+
+        -------------------------
+        - main
+          - https://example.com/pr/1
+            - https://github.com/git-town/git-town/pull/2
+              - **branch-3** :point_left:
+
+        <sup>[Stack](https://www.git-town.com/how-to/proposal-breadcrumb.html) generated by [Git Town](https://github.com/git-town/git-town)</sup>
+
+        <!-- branch-stack-end -->
+      """
 
   Scenario: undo
     When I run "git-town undo"
@@ -58,11 +117,10 @@ Feature: propose a stack with "stacks" setting
       |          | git checkout branch-2                                               |
       |          | Finding all proposals for branch-1 ... main                         |
       |          | Finding proposal from branch-1 into main ... #1 (branch-1 proposal) |
-      |          | Finding proposal from branch-2 into branch-1 ... none               |
-      |          | Finding proposal from branch-3 into branch-2 ... none               |
-      |          | Update body for #1 ... ok                                           |
-      |          | Finding all proposals for branch-2 ... none                         |
-      |          | Finding all proposals for branch-3 ... none                         |
+      |          | Finding proposal from branch-2 into branch-1 ... #2 (commit 2)      |
+      |          | Finding proposal from branch-3 into branch-2 ... #3 (commit 3)      |
+      |          | Finding all proposals for branch-2 ... branch-1                     |
+      |          | Finding all proposals for branch-3 ... branch-2                     |
     And the initial lineage exists now
     And the initial branches exist now
     And the proposals are now
@@ -79,6 +137,42 @@ Feature: propose a stack with "stacks" setting
         -------------------------
         - main
           - **branch-1** :point_left:
+            - https://github.com/git-town/git-town/pull/2
+              - https://github.com/git-town/git-town/pull/3
+
+        <sup>[Stack](https://www.git-town.com/how-to/proposal-breadcrumb.html) generated by [Git Town](https://github.com/git-town/git-town)</sup>
+
+        <!-- branch-stack-end -->
+
+      url: https://github.com/git-town/git-town/pull/2
+      number: 2
+      source: branch-2
+      target: branch-1
+      body:
+        <!-- branch-stack-start -->
+
+        -------------------------
+        - main
+          - https://example.com/pr/1
+            - **branch-2** :point_left:
+              - https://github.com/git-town/git-town/pull/3
+
+        <sup>[Stack](https://www.git-town.com/how-to/proposal-breadcrumb.html) generated by [Git Town](https://github.com/git-town/git-town)</sup>
+
+        <!-- branch-stack-end -->
+
+      url: https://github.com/git-town/git-town/pull/3
+      number: 3
+      source: branch-3
+      target: branch-2
+      body:
+        <!-- branch-stack-start -->
+
+        -------------------------
+        - main
+          - https://example.com/pr/1
+            - https://github.com/git-town/git-town/pull/2
+              - **branch-3** :point_left:
 
         <sup>[Stack](https://www.git-town.com/how-to/proposal-breadcrumb.html) generated by [Git Town](https://github.com/git-town/git-town)</sup>
 
@@ -0,0 +1,50 @@
+Feature: propose branches as drafts via configuration
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | TITLE          | URL                      |
+      | 1  | other         | main          | other proposal | https://example.com/pr/1 |
+    And Git setting "git-town.propose-draft" is "true"
+    And the current branch is "feature"
+    And tool "open" is installed
+
+  Scenario: configured to create drafts
+    When I run "git-town propose"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                          |
+      | feature | git fetch --prune --tags                         |
+      |         | Finding proposal from feature into main ... none |
+      |         | Creating proposal from feature into main ... #2  |
+      |         | open https://github.com/git-town/git-town/pull/2 |
+    And the proposals are now
+      """
+      url: https://example.com/pr/1
+      number: 1
+      source: other
+      target: main
+      body:
+
+      url: https://github.com/git-town/git-town/pull/2
+      number: 2
+      source: feature
+      target: main
+      draft: true
+      body:
+      """
+
+  Scenario: override the configuration via CLI flag
+    When I run "git-town propose --no-draft"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                            |
+      | feature | git fetch --prune --tags                                           |
+      |         | Finding proposal from feature into main ... none                   |
+      |         | open https://github.com/git-town/git-town/compare/feature?expand=1 |
+    And the initial proposals exist now
@@ -0,0 +1,66 @@
+Feature: mark a draft proposal as ready for review
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+
+  Scenario: draft proposal
+    Given the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | TITLE            | DRAFT | URL                      |
+      | 1  | feature       | main          | feature proposal | true  | https://example.com/pr/1 |
+    When I run "git-town propose --ready"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                           |
+      | feature | git fetch --prune --tags                                          |
+      |         | Finding proposal from feature into main ... #1 (feature proposal) |
+      |         | Marking proposal #1 as ready for review ... ok                    |
+    And the proposals are now
+      """
+      url: https://example.com/pr/1
+      number: 1
+      source: feature
+      target: main
+      body:
+      """
+
+  Scenario: proposal is not a draft
+    Given the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | TITLE            | URL                      |
+      | 1  | feature       | main          | feature proposal | https://example.com/pr/1 |
+    When I run "git-town propose --ready"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                           |
+      | feature | git fetch --prune --tags                                          |
+      |         | Finding proposal from feature into main ... #1 (feature proposal) |
+    And Git Town prints:
+      """
+      proposal #1 is not a draft
+      """
+    And the initial proposals exist now
+
+  Scenario: no proposal
+    Given the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | TITLE          | URL                      |
+      | 1  | other         | main          | other proposal | https://example.com/pr/1 |
+    When I run "git-town propose --ready"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                          |
+      | feature | git fetch --prune --tags                         |
+      |         | Finding proposal from feature into main ... none |
+    And Git Town prints the error:
+      """
+      branch "feature" has no proposal into "main"
+      """
+    And the initial proposals exist now
+
+  Scenario: ready and draft flags
+    When I run "git-town propose --ready --draft"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      if any flags in the group [draft ready] are set none of the others can be; [draft ready] were all set
+      """
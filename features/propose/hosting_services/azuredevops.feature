@@ -0,0 +1,41 @@
+@skipWindows
+Feature: Azure DevOps support
+
+  Background:
+    Given a Git repo with origin
+    And tool "open" is installed
+
+  Scenario Outline: creating proposals
+    Given the origin is "<ORIGIN>"
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    When I run "git-town propose"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                                                                   |
+      | feature | git fetch --prune --tags                                                                                  |
+      |         | Finding proposal from feature into main ... none                                                          |
+      |         | open https://dev.azure.com/git-town/docs/_git/git-town/pullrequestcreate?sourceRef=feature&targetRef=main |
+
+    Examples:
+      | ORIGIN                                            |
+      | git@ssh.dev.azure.com:v3/git-town/docs/git-town   |
+      | https://dev.azure.com/git-town/docs/_git/git-town |
+
+  Scenario: proposal already exists
+    Given the origin is "git@ssh.dev.azure.com:v3/git-town/docs/git-town"
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | TITLE            | BODY         | URL                                                             |
+      | 1  | feature       | main          | feature proposal | feature body | https://dev.azure.com/git-town/docs/_git/git-town/pullrequest/1 |
+    And the current branch is "feature"
+    When I run "git-town propose"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                              |
+      | feature | git fetch --prune --tags                                             |
+      |         | Finding proposal from feature into main ... #1 (feature proposal)    |
+      |         | open https://dev.azure.com/git-town/docs/_git/git-town/pullrequest/1 |
+    And the initial proposals exist now
@@ -0,0 +1,61 @@
+Feature: propose with reviewers, labels, and assignees provided via CLI flags
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | TITLE          | URL                      |
+      | 1  | other         | main          | other proposal | https://example.com/pr/1 |
+    And the current branch is "feature"
+    And tool "open" is installed
+    When I run "git-town propose --reviewer alice --reviewer bob --label team-a --assignee carol"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                          |
+      | feature | git fetch --prune --tags                         |
+      |         | Finding proposal from feature into main ... none |
+      |         | Creating proposal from feature into main ... #2  |
+      |         | Adding labels team-a to #2 ... ok                |
+      |         | Assigning carol to #2 ... ok                     |
+      |         | Requesting reviews from alice, bob for #2 ... ok |
+      |         | open https://github.com/git-town/git-town/pull/2 |
+    And the proposals are now
+      """
+      url: https://example.com/pr/1
+      number: 1
+      source: other
+      target: main
+      body:
+
+      url: https://github.com/git-town/git-town/pull/2
+      number: 2
+      source: feature
+      target: main
+      body:
+      """
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs no commands
+    And the initial branches and lineage exist now
+    And the proposals are now
+      """
+      url: https://example.com/pr/1
+      number: 1
+      source: other
+      target: main
+      body:
+
+      url: https://github.com/git-town/git-town/pull/2
+      number: 2
+      source: feature
+      target: main
+      body:
+      """
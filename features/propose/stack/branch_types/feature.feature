@@ -19,24 +19,44 @@ Feature: propose an entire stack
 
   Scenario: result
     Then Git Town runs the commands
-      | BRANCH   | COMMAND                                                                        |
-      | branch-2 | git fetch --prune --tags                                                       |
-      |          | git checkout branch-1                                                          |
-      | branch-1 | git checkout branch-2                                                          |
-      | branch-2 | git merge --no-edit --ff branch-1                                              |
-      |          | git push                                                                       |
-      |          | git checkout branch-3                                                          |
-      | branch-3 | git merge --no-edit --ff branch-2                                              |
-      |          | git push                                                                       |
-      |          | git checkout branch-1                                                          |
-      |          | Finding proposal from branch-1 into main ... none                              |
-      | branch-1 | open https://github.com/git-town/git-town/compare/branch-1?expand=1            |
-      |          | git checkout branch-2                                                          |
-      |          | Finding proposal from branch-2 into branch-1 ... none                          |
-      | branch-2 | open https://github.com/git-town/git-town/compare/branch-1...branch-2?expand=1 |
-      |          | git checkout branch-3                                                          |
-      |          | Finding proposal from branch-3 into branch-2 ... none                          |
-      | branch-3 | open https://github.com/git-town/git-town/compare/branch-2...branch-3?expand=1 |
+      | BRANCH   | COMMAND                                               |
+      | branch-2 | git fetch --prune --tags                              |
+      |          | git checkout branch-1                                 |
+      | branch-1 | git checkout branch-2                                 |
+      | branch-2 | git merge --no-edit --ff branch-1                     |
+      |          | git push                                              |
+      |          | git checkout branch-3                                 |
+      | branch-3 | git merge --no-edit --ff branch-2                     |
+      |          | git push                                              |
+      |          | git checkout branch-1                                 |
+      |          | Finding proposal from branch-1 into main ... none     |
+      |          | Creating proposal from branch-1 into main ... #1      |
+      | branch-1 | git checkout branch-2                                 |
+      |          | Finding proposal from branch-2 into branch-1 ... none |
+      |          | Creating proposal from branch-2 into branch-1 ... #2  |
+      | branch-2 | git checkout branch-3                                 |
+      |          | Finding proposal from branch-3 into branch-2 ... none |
+      |          | Creating proposal from branch-3 into branch-2 ... #3  |
+    And the proposals are now
+      """
+      url: https://github.com/git-town/git-town/pull/1
+      number: 1
+      source: branch-1
+      target: main
+      body:
+
+      url: https://github.com/git-town/git-town/pull/2
+      number: 2
+      source: branch-2
+      target: branch-1
+      body:
+
+      url: https://github.com/git-town/git-town/pull/3
+      number: 3
+      source: branch-3
+      target: branch-2
+      body:
+      """
 
   Scenario: undo
     When I run "git-town undo"
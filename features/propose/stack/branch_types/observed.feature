@@ -17,12 +17,12 @@ Feature: proposing a stack containing an observed branch
 
   Scenario: result
     Then Git Town runs the commands
-      | BRANCH | COMMAND                                                                   |
-      | child  | git fetch --prune --tags                                                  |
-      |        | git merge --no-edit --ff parent                                           |
-      |        | git push                                                                  |
-      |        | Finding proposal from child into parent ... none                          |
-      |        | open https://github.com/git-town/git-town/compare/parent...child?expand=1 |
+      | BRANCH | COMMAND                                          |
+      | child  | git fetch --prune --tags                         |
+      |        | git merge --no-edit --ff parent                  |
+      |        | git push                                         |
+      |        | Finding proposal from child into parent ... none |
+      |        | Creating proposal from child into parent ... #1  |
     And the initial lineage exists now
     And the initial branches exist now
 
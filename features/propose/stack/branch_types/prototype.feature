@@ -17,19 +17,19 @@ Feature: proposing a stack containing a prototype branch
 
   Scenario: result
     Then Git Town runs the commands
-      | BRANCH | COMMAND                                                                   |
-      | child  | git fetch --prune --tags                                                  |
-      |        | git checkout parent                                                       |
-      | parent | git checkout child                                                        |
-      | child  | git merge --no-edit --ff parent                                           |
-      |        | git push                                                                  |
-      |        | git checkout parent                                                       |
-      |        | Finding proposal from parent into main ... none                           |
-      | parent | open https://github.com/git-town/git-town/compare/parent?expand=1         |
-      |        | git push -u origin child                                                  |
-      |        | git checkout child                                                        |
-      |        | Finding proposal from child into parent ... none                          |
-      | child  | open https://github.com/git-town/git-town/compare/parent...child?expand=1 |
+      | BRANCH | COMMAND                                          |
+      | child  | git fetch --prune --tags                         |
+      |        | git checkout parent                              |
+      | parent | git checkout child                               |
+      | child  | git merge --no-edit --ff parent                  |
+      |        | git push                                         |
+      |        | git checkout parent                              |
+      |        | Finding proposal from parent into main ... none  |
+      |        | Creating proposal from parent into main ... #1   |
+      | parent | git push -u origin child                         |
+      |        | git checkout child                               |
+      |        | Finding proposal from child into parent ... none |
+      |        | Creating proposal from child into parent ... #2  |
     And the initial lineage exists now
     And the initial branches exist now
 
@@ -0,0 +1,71 @@
+Feature: propose an entire stack on GitLab
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "git@gitlab.com:git-town/git-town.git"
+    And the branches
+      | NAME     | TYPE    | PARENT   | LOCATIONS     |
+      | branch-1 | feature | main     | local, origin |
+      | branch-2 | feature | branch-1 | local, origin |
+    And the commits
+      | BRANCH   | LOCATION      | MESSAGE  |
+      | branch-1 | local, origin | commit 1 |
+      | branch-2 | local, origin | commit 2 |
+    And the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | TITLE             | BODY          | URL                      |
+      | 1  | branch-1      | main          | branch-1 proposal | branch-1 body | https://example.com/pr/1 |
+    And the current branch is "branch-1"
+    When I run "git-town propose --stack --body 'stack body'"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                                                             |
+      | branch-1 | git fetch --prune --tags                                            |
+      |          | git checkout branch-2                                               |
+      | branch-2 | git merge --no-edit --ff branch-1                                   |
+      |          | git push                                                            |
+      |          | git checkout branch-1                                               |
+      |          | Finding proposal from branch-1 into main ... #1 (branch-1 proposal) |
+      | branch-1 | git checkout branch-2                                               |
+      |          | Finding proposal from branch-2 into branch-1 ... none               |
+      |          | Creating proposal from branch-2 into branch-1 ... #2                |
+    And the proposals are now
+      """
+      url: https://example.com/pr/1
+      number: 1
+      source: branch-1
+      target: main
+      body:
+        branch-1 body
+      url: https://gitlab.com/git-town/git-town/-/merge_requests/2
+      number: 2
+      source: branch-2
+      target: branch-1
+      body:
+        stack body
+      """
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                                         |
+      | branch-2 | git reset --hard {{ sha 'commit 2' }}           |
+      |          | git push --force-with-lease --force-if-includes |
+      |          | git checkout branch-1                           |
+    And the initial branches exist now
+    And the initial lineage exists now
+    And the proposals are now
+      """
+      url: https://example.com/pr/1
+      number: 1
+      source: branch-1
+      target: main
+      body:
+        branch-1 body
+      url: https://gitlab.com/git-town/git-town/-/merge_requests/2
+      number: 2
+      source: branch-2
+      target: branch-1
+      body:
+        stack body
+      """
@@ -0,0 +1,102 @@
+Feature: render the proposal template into the title and body of proposals created through the forge API
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the committed file "proposal.tmpl":
+      """
+      {{ (index .Commits 0).Title }}
+
+      {{ range .Commits -}}
+      {{ .Body }}
+      {{ end }}
+      {{ .Lineage }}
+      """
+    And the committed configuration file:
+      """
+      [propose]
+      template = "proposal.tmpl"
+      """
+    And the branches
+      | NAME     | TYPE    | PARENT   | LOCATIONS     |
+      | branch-1 | feature | main     | local, origin |
+      | branch-2 | feature | branch-1 | local, origin |
+    And the commits
+      | BRANCH   | LOCATION      | MESSAGE                          |
+      | branch-2 | local, origin | commit 2\n\nexplains the changes |
+    And the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | TITLE             | BODY          | URL                      |
+      | 1  | branch-1      | main          | branch-1 proposal | branch-1 body | https://example.com/pr/1 |
+    And the current branch is "branch-2"
+    And tool "open" is installed
+    When I run "git-town propose --draft"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                                                             |
+      | branch-2 | git fetch --prune --tags                                            |
+      |          | git checkout branch-1                                               |
+      | branch-1 | git checkout branch-2                                               |
+      |          | Finding proposal from branch-2 into branch-1 ... none               |
+      |          | Finding proposal from branch-1 into main ... #1 (branch-1 proposal) |
+      |          | Creating proposal from branch-2 into branch-1 ... #2                |
+      | branch-2 | open https://github.com/git-town/git-town/pull/2                    |
+    And the proposals are now
+      """
+      url: https://example.com/pr/1
+      number: 1
+      source: branch-1
+      target: main
+      body:
+        branch-1 body
+      url: https://github.com/git-town/git-town/pull/2
+      number: 2
+      source: branch-2
+      target: branch-1
+      draft: true
+      body:
+        explains the changes
+
+        <!-- branch-stack-start -->
+
+        -------------------------
+        - main
+          - https://example.com/pr/1
+            - **branch-2** :point_left:
+
+        <sup>[Stack](https://www.git-town.com/how-to/proposal-breadcrumb.html) generated by [Git Town](https://github.com/git-town/git-town)</sup>
+
+        <!-- branch-stack-end -->
+      """
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs no commands
+    And the initial branches and lineage exist now
+    And the proposals are now
+      """
+      url: https://example.com/pr/1
+      number: 1
+      source: branch-1
+      target: main
+      body:
+        branch-1 body
+      url: https://github.com/git-town/git-town/pull/2
+      number: 2
+      source: branch-2
+      target: branch-1
+      draft: true
+      body:
+        explains the changes
+
+        <!-- branch-stack-start -->
+
+        -------------------------
+        - main
+          - https://example.com/pr/1
+            - **branch-2** :point_left:
+
+        <sup>[Stack](https://www.git-town.com/how-to/proposal-breadcrumb.html) generated by [Git Town](https://github.com/git-town/git-town)</sup>
+
+        <!-- branch-stack-end -->
+      """
@@ -0,0 +1,46 @@
+Feature: problems with the proposal template
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "ssh://git@github.com/git-town/git-town.git"
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And tool "open" is installed
+
+  Scenario: template file does not exist
+    Given the committed configuration file:
+      """
+      [propose]
+      template = "zonk.tmpl"
+      """
+    When I run "git-town propose"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                          |
+      | feature | git fetch --prune --tags                         |
+      |         | Finding proposal from feature into main ... none |
+    And Git Town prints the error:
+      """
+      cannot read the proposal template "zonk.tmpl"
+      """
+
+  Scenario: template contains an error
+    Given the committed file "proposal.tmpl":
+      """
+      {{ .Zonk }}
+      """
+    And the committed configuration file:
+      """
+      [propose]
+      template = "proposal.tmpl"
+      """
+    When I run "git-town propose"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                          |
+      | feature | git fetch --prune --tags                         |
+      |         | Finding proposal from feature into main ... none |
+    And Git Town prints the error:
+      """
+      cannot render the proposal template "proposal.tmpl"
+      """
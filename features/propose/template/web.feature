@@ -0,0 +1,45 @@
+@skipWindows
+Feature: render the proposal template into the title and body of proposals created through the browser
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "ssh://git@github.com/git-town/git-town.git"
+    And the committed file ".github/proposal.tmpl":
+      """
+      {{ range .IssueKeys }}[{{ . }}] {{ end }}{{ (index .Commits 0).Title }}
+
+      Merges {{ .Branch }} into {{ .Parent }}.
+      {{ range .Commits }}
+      - {{ .Title }}
+      {{- end }}
+      """
+    And the committed configuration file:
+      """
+      [propose]
+      template = ".github/proposal.tmpl"
+      """
+    And the branches
+      | NAME          | TYPE    | PARENT | LOCATIONS     |
+      | ABC-123-login | feature | main   | local, origin |
+    And the commits
+      | BRANCH        | LOCATION      | MESSAGE        |
+      | ABC-123-login | local, origin | add login form |
+      | ABC-123-login | local, origin | validate input |
+    And the current branch is "ABC-123-login"
+    And tool "open" is installed
+
+  Scenario: render the template
+    When I run "git-town propose"
+    Then Git Town runs the commands
+      | BRANCH        | COMMAND                                                                                                                                                                                   |
+      | ABC-123-login | git fetch --prune --tags                                                                                                                                                                  |
+      |               | Finding proposal from ABC-123-login into main ... none                                                                                                                                    |
+      |               | open https://github.com/git-town/git-town/compare/ABC-123-login?expand=1&title=%5BABC-123%5D+add+login+form&body=Merges+ABC-123-login+into+main.%0A%0A-+add+login+form%0A-+validate+input |
+
+  Scenario: the title given via CLI wins over the template
+    When I run "git-town propose --title=my_title"
+    Then Git Town runs the commands
+      | BRANCH        | COMMAND                                                                                                                                                               |
+      | ABC-123-login | git fetch --prune --tags                                                                                                                                              |
+      |               | Finding proposal from ABC-123-login into main ... none                                                                                                                |
+      |               | open https://github.com/git-town/git-town/compare/ABC-123-login?expand=1&title=my_title&body=Merges+ABC-123-login+into+main.%0A%0A-+add+login+form%0A-+validate+input |
@@ -0,0 +1,42 @@
+Feature: rename a branch that overrides settings
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME | TYPE    | PARENT | LOCATIONS     |
+      | old  | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE    |
+      | old    | local, origin | old commit |
+    And local Git setting "git-town-branch.old.push-branches" is "false"
+    And local Git setting "git-town-branch.old.sync-feature-strategy" is "rebase"
+    And the current branch is "old"
+    When I run "git-town rename new"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                   |
+      | old    | git fetch --prune --tags  |
+      |        | git branch --move old new |
+      |        | git checkout new          |
+      | new    | git push -u origin new    |
+      |        | git push origin :old      |
+    And local Git setting "git-town-branch.new.push-branches" is now "false"
+    And local Git setting "git-town-branch.new.sync-feature-strategy" is now "rebase"
+    And local Git setting "git-town-branch.old.push-branches" now doesn't exist
+    And local Git setting "git-town-branch.old.sync-feature-strategy" now doesn't exist
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                               |
+      | new    | git branch old {{ sha 'old commit' }} |
+      |        | git push -u origin old                |
+      |        | git checkout old                      |
+      | old    | git branch -D new                     |
+      |        | git push origin :new                  |
+    And local Git setting "git-town-branch.new.push-branches" now doesn't exist
+    And local Git setting "git-town-branch.new.sync-feature-strategy" now doesn't exist
+    And local Git setting "git-town-branch.old.push-branches" is now "false"
+    And local Git setting "git-town-branch.old.sync-feature-strategy" is now "rebase"
+    And the initial branches and lineage exist now
@@ -0,0 +1,18 @@
+Feature: reordering from the main branch
+
+  Background:
+    Given a Git repo with origin
+    When I run "git-town reorder"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git fetch --prune --tags |
+    And Git Town prints the error:
+      """
+      please check out a feature branch of the stack to reorder
+      """
+    #
+    # NOTE: Cannot test undo here.
+    # The Git Town command under test has not created an undoable runstate.
+    # Executing "git town undo" would undo the Git Town command executed during setup.
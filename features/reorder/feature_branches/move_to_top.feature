@@ -0,0 +1,58 @@
+@messyoutput
+Feature: move the last branch of a stack to the top
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME     | TYPE    | PARENT   | LOCATIONS     |
+      | branch-1 | feature | main     | local, origin |
+      | branch-2 | feature | branch-1 | local, origin |
+      | branch-3 | feature | branch-2 | local, origin |
+    And the commits
+      | BRANCH   | LOCATION      | MESSAGE  | FILE NAME | FILE CONTENT |
+      | branch-1 | local, origin | commit 1 | file_1    | content 1    |
+      | branch-2 | local, origin | commit 2 | file_2    | content 2    |
+      | branch-3 | local, origin | commit 3 | file_3    | content 3    |
+    And the current branch is "branch-2"
+    When I run "git-town reorder" and enter into the dialogs:
+      | DIALOG         | KEYS            |
+      | stack position | down down enter |
+      | stack position | enter           |
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                                                                            |
+      | branch-2 | git fetch --prune --tags                                                           |
+      |          | git checkout branch-3                                                              |
+      | branch-3 | git -c rebase.updateRefs=false rebase --onto main {{ sha-initial 'commit 2' }}     |
+      |          | git checkout branch-1                                                              |
+      | branch-1 | git -c rebase.updateRefs=false rebase --onto branch-3 main                         |
+      |          | git push --force-with-lease --force-if-includes                                    |
+      |          | git checkout branch-2                                                              |
+      | branch-2 | git -c rebase.updateRefs=false rebase --onto branch-1 {{ sha-initial 'commit 1' }} |
+      |          | git push --force-with-lease --force-if-includes                                    |
+    And this lineage exists now
+      """
+      main
+        branch-3
+          branch-1
+            branch-2
+      """
+    And these commits exist now
+      | BRANCH   | LOCATION      | MESSAGE  |
+      | branch-3 | local, origin | commit 3 |
+      | branch-1 | local, origin | commit 1 |
+      | branch-2 | local, origin | commit 2 |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                                         |
+      | branch-2 | git checkout branch-1                           |
+      | branch-1 | git reset --hard {{ sha 'commit 1' }}           |
+      |          | git push --force-with-lease --force-if-includes |
+      |          | git checkout branch-2                           |
+      | branch-2 | git reset --hard {{ sha 'commit 2' }}           |
+      |          | git push --force-with-lease --force-if-includes |
+    And the initial lineage exists now
+    And the initial commits exist now
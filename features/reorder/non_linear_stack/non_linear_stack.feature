@@ -0,0 +1,26 @@
+Feature: reordering a stack in which a branch has several children
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME     | TYPE    | PARENT   | LOCATIONS     |
+      | branch-1 | feature | main     | local, origin |
+      | branch-2 | feature | branch-1 | local, origin |
+      | branch-3 | feature | branch-1 | local, origin |
+    And the current branch is "branch-2"
+    When I run "git-town reorder"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                  |
+      | branch-2 | git fetch --prune --tags |
+    And Git Town prints the error:
+      """
+      cannot reorder because branch branch-1 has several child branches
+      """
+    And the initial lineage exists now
+    And the initial commits exist now
+    #
+    # NOTE: Cannot test undo here.
+    # The Git Town command under test has not created an undoable runstate.
+    # Executing "git town undo" would undo the Git Town command executed during setup.
@@ -0,0 +1,28 @@
+@messyoutput
+Feature: keep the order of the stack
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME     | TYPE    | PARENT   | LOCATIONS     |
+      | branch-1 | feature | main     | local, origin |
+      | branch-2 | feature | branch-1 | local, origin |
+    And the commits
+      | BRANCH   | LOCATION      | MESSAGE  |
+      | branch-1 | local, origin | commit 1 |
+      | branch-2 | local, origin | commit 2 |
+    And the current branch is "branch-2"
+    When I run "git-town reorder" and enter into the dialogs:
+      | DIALOG         | KEYS  |
+      | stack position | enter |
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                  |
+      | branch-2 | git fetch --prune --tags |
+    And Git Town prints:
+      """
+      The order of the stack is unchanged.
+      """
+    And the initial lineage exists now
+    And the initial commits exist now
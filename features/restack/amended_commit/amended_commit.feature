@@ -0,0 +1,58 @@
+Feature: restack the descendants of a branch with an amended commit
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME     | TYPE    | PARENT | LOCATIONS     |
+      | branch-1 | feature | main   | local, origin |
+    And the commits
+      | BRANCH   | LOCATION      | MESSAGE  | FILE NAME | FILE CONTENT |
+      | branch-1 | local, origin | commit 1 | file_1    | content 1    |
+    And the branches
+      | NAME     | TYPE    | PARENT   | LOCATIONS     |
+      | branch-2 | feature | branch-1 | local, origin |
+    And the commits
+      | BRANCH   | LOCATION      | MESSAGE  | FILE NAME | FILE CONTENT |
+      | branch-2 | local, origin | commit 2 | file_2    | content 2    |
+    And the branches
+      | NAME     | TYPE    | PARENT   | LOCATIONS     |
+      | branch-3 | feature | branch-2 | local, origin |
+    And the commits
+      | BRANCH   | LOCATION      | MESSAGE  | FILE NAME | FILE CONTENT |
+      | branch-3 | local, origin | commit 3 | file_3    | content 3    |
+    And the current branch is "branch-1"
+    And I ran "git-town sync"
+    And I amend this commit
+      | BRANCH   | LOCATION | MESSAGE   | FILE NAME | FILE CONTENT    |
+      | branch-1 | local    | commit 1b | file_1    | amended content |
+    When I run "git-town restack"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                                                                            |
+      | branch-1 | git checkout branch-2                                                              |
+      | branch-2 | git -c rebase.updateRefs=false rebase --onto branch-1 {{ sha-initial 'commit 1' }} |
+      |          | git checkout branch-3                                                              |
+      | branch-3 | git -c rebase.updateRefs=false rebase --onto branch-2 {{ sha-initial 'commit 2' }} |
+      |          | git checkout branch-1                                                              |
+    And these commits exist now
+      | BRANCH   | LOCATION | MESSAGE   |
+      | branch-1 | local    | commit 1b |
+      |          | origin   | commit 1  |
+      | branch-2 | local    | commit 2  |
+      |          | origin   | commit 2  |
+      | branch-3 | local    | commit 3  |
+      |          | origin   | commit 3  |
+    And the initial branches and lineage exist now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                                       |
+      | branch-1 | git checkout branch-2                         |
+      | branch-2 | git reset --hard {{ sha-initial 'commit 2' }} |
+      |          | git checkout branch-3                         |
+      | branch-3 | git reset --hard {{ sha-initial 'commit 3' }} |
+      |          | git checkout branch-1                         |
+    And the initial branches and lineage exist now
+    And the initial commits exist now
@@ -0,0 +1,32 @@
+Feature: restack without a record of the previous location of the parent branch
+
+  Background:
+    Given a Git repo with origin
+    And I ran "git checkout -b branch-1"
+    And the commits
+      | BRANCH   | LOCATION | MESSAGE  | FILE NAME | FILE CONTENT |
+      | branch-1 | local    | commit 1 | file_1    | content 1    |
+    And I ran "git checkout -b branch-2"
+    And the commits
+      | BRANCH   | LOCATION | MESSAGE  | FILE NAME | FILE CONTENT |
+      | branch-2 | local    | commit 2 | file_2    | content 2    |
+    And Git setting "git-town-branch.branch-1.parent" is "main"
+    And Git setting "git-town-branch.branch-2.parent" is "branch-1"
+    And I amend this commit
+      | BRANCH   | LOCATION | MESSAGE   | FILE NAME | FILE CONTENT    |
+      | branch-1 | local    | commit 1b | file_1    | amended content |
+    And the current branch is "branch-1"
+    When I run "git-town restack"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      cannot restack branch branch-2 because the previous location of its parent branch branch-1 is unknown, please run "git town sync --stack"
+      """
+    And the initial lineage exists now
+    And the initial commits exist now
+    #
+    # NOTE: Cannot test undo here.
+    # The Git Town command under test has not created an undoable runstate.
+    # Executing "git town undo" would undo the Git Town command executed during setup.
@@ -0,0 +1,27 @@
+Feature: restack descendants that are already up to date
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME     | TYPE    | PARENT | LOCATIONS     |
+      | branch-1 | feature | main   | local, origin |
+    And the commits
+      | BRANCH   | LOCATION      | MESSAGE  |
+      | branch-1 | local, origin | commit 1 |
+    And the branches
+      | NAME     | TYPE    | PARENT   | LOCATIONS     |
+      | branch-2 | feature | branch-1 | local, origin |
+    And the commits
+      | BRANCH   | LOCATION      | MESSAGE  |
+      | branch-2 | local, origin | commit 2 |
+    And the current branch is "branch-1"
+    When I run "git-town restack"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints:
+      """
+      All descendants of branch branch-1 are up to date.
+      """
+    And the initial branches and lineage exist now
+    And the initial commits exist now
@@ -0,0 +1,42 @@
+Feature: restore a deleted branch from the runlog
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the current branch is "feature"
+    And I ran "git-town delete"
+    When I run "git-town runlog restore 2"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                       |
+      | main   | git branch feature {{ sha 'feature commit' }} |
+      |        | git push -u origin feature                    |
+    And Git Town prints something like:
+      """
+      Restoring the branches to runlog entry 2 \(start of "git-town delete"\):
+        feature: re-create at \w{7}
+        origin/feature: re-create at \w{7}
+      """
+    And the current branch is still "main"
+    And the branches are now
+      | REPOSITORY    | BRANCHES      |
+      | local, origin | main, feature |
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git branch -D feature    |
+      |        | git push origin :feature |
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
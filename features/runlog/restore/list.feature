@@ -0,0 +1,32 @@
+Feature: list the runlog entries
+
+  Scenario: runlog entries exist
+    Given a Git repo with origin
+    And I ran "git-town hack new"
+    When I run "git-town runlog restore"
+    Then Git Town runs no commands
+    And Git Town prints something like:
+      """
+      Runlog entries, most recent first:
+       1  \d{4}-\d\d-\d\d \d\d:\d\d:\d\d  end    git-town hack new
+       2  \d{4}-\d\d-\d\d \d\d:\d\d:\d\d  start  git-town hack new
+      """
+
+  Scenario: no runlog
+    Given a Git repo with origin
+    When I run "git-town runlog restore"
+    Then Git Town runs no commands
+    And Git Town prints:
+      """
+      Runlog doesn't exist.
+      """
+
+  Scenario: invalid index
+    Given a Git repo with origin
+    And I ran "git-town hack new"
+    When I run "git-town runlog restore 3"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      runlog entry "3" doesn't exist, please provide a number between 1 and 2
+      """
@@ -0,0 +1,47 @@
+Feature: reset moved branches to a runlog entry
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE      |
+      | main    | origin   | main commit  |
+      | feature | local    | local commit |
+    And the current branch is "feature"
+    And I ran "git-town sync"
+
+  Scenario: result
+    When I run "git-town runlog restore 2"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                               |
+      | feature | git reset --hard {{ sha 'local commit' }}                             |
+      |         | git checkout main                                                     |
+      | main    | git reset --hard {{ sha 'initial commit' }}                           |
+      |         | git push --force-with-lease origin {{ sha 'initial commit' }}:feature |
+      |         | git checkout feature                                                  |
+    And Git Town prints something like:
+      """
+      Restoring the branches to runlog entry 2 \(start of "git-town sync"\):
+        feature: \w{7} -> \w{7}
+        main: \w{7} -> \w{7}
+        origin/feature: \w{7} -> \w{7}
+      """
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION | MESSAGE      |
+      | main    | origin   | main commit  |
+      | feature | local    | local commit |
+
+  Scenario: dry run
+    When I run "git-town runlog restore 2 --dry-run"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                               |
+      | feature | git reset --hard {{ sha 'local commit' }}                             |
+      |         | git checkout main                                                     |
+      | main    | git reset --hard {{ sha 'initial commit' }}                           |
+      |         | git push --force-with-lease origin {{ sha 'initial commit' }}:feature |
+      |         | git checkout feature                                                  |
+    And the current branch is still "feature"
+    And the initial commits exist now
@@ -0,0 +1,38 @@
+Feature: let the forge merge the proposal once it is ready
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | TITLE            | BODY         | URL                      |
+      | 1  | feature       | main          | feature proposal | feature body | https://example.com/pr/1 |
+    And Git setting "git-town.ship-strategy" is "api-auto-merge"
+    And the current branch is "feature"
+    When I run "git-town ship"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                           |
+      | feature | git fetch --prune --tags                                          |
+      |         | Finding proposal from feature into main ... #1 (feature proposal) |
+      |         | Enabling auto-merge for proposal #1 ... ok                        |
+    And Git Town prints:
+      """
+      Your forge will merge branch feature once its proposal is ready. Afterwards, run "git town sync" to remove the branch locally.
+      """
+    And the initial branches and lineage exist now
+    And the initial commits exist now
+    And the initial proposals exist now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs no commands
+    And the initial branches and lineage exist now
+    And the initial commits exist now
+    And the initial proposals exist now
@@ -0,0 +1,22 @@
+Feature: cannot auto-merge a stack
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the branches
+      | NAME     | TYPE    | PARENT   | LOCATIONS     |
+      | branch-1 | feature | main     | local, origin |
+      | branch-2 | feature | branch-1 | local, origin |
+    And Git setting "git-town.ship-strategy" is "api-auto-merge"
+    And the current branch is "branch-2"
+    When I run "git-town ship --stack"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                  |
+      | branch-2 | git fetch --prune --tags |
+    And Git Town prints the error:
+      """
+      cannot ship a stack with the api-auto-merge ship strategy because the forge merges each proposal later, please ship the branches of the stack one at a time
+      """
+    And the initial branches and lineage exist now
@@ -0,0 +1,40 @@
+Feature: ship a branch that overrides the ship-strategy
+
+  Background:
+    Given a local Git repo
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS |
+      | feature | feature | main   | local     |
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE        |
+      | feature | local    | feature commit |
+    And Git setting "git-town.ship-strategy" is "api"
+    And local Git setting "git-town-branch.feature.ship-strategy" is "fast-forward"
+    And the current branch is "feature"
+    When I run "git-town ship"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                     |
+      | feature | git checkout main           |
+      | main    | git merge --ff-only feature |
+      |         | git branch -D feature       |
+    And no lineage exists now
+    And local Git setting "git-town-branch.feature.ship-strategy" now doesn't exist
+    And the branches are now
+      | REPOSITORY | BRANCHES |
+      | local      | main     |
+    And these commits exist now
+      | BRANCH | LOCATION | MESSAGE        |
+      | main   | local    | feature commit |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                       |
+      | main   | git reset --hard {{ sha 'initial commit' }}   |
+      |        | git branch feature {{ sha 'feature commit' }} |
+      |        | git checkout feature                          |
+    And the initial branches and lineage exist now
+    And local Git setting "git-town-branch.feature.ship-strategy" is now "fast-forward"
+    And the initial commits exist now
@@ -0,0 +1,53 @@
+Feature: ship a feature branch that has a worktree created by Git Town
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | other | feature | main   | local, origin |
+    And Git setting "git-town.ship-strategy" is "squash-merge"
+    And Git setting "git-town.share-new-branches" is "push"
+    And the current branch is "main"
+    And I ran "git-town worktree add feature"
+    And I ran "touch ../developer.worktrees/feature/feature_file"
+    And I ran "git -C ../developer.worktrees/feature add feature_file"
+    And I ran "git -C ../developer.worktrees/feature commit -m 'feature commit'"
+    And I ran "git -C ../developer.worktrees/feature push"
+    And the current branch is "other"
+    When I run "git-town ship feature -m done"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                            |
+      | other  | git fetch --prune --tags                           |
+      |        | git checkout main                                  |
+      | main   | git merge --squash --ff feature                    |
+      |        | git commit -m done                                 |
+      |        | git push                                           |
+      |        | git push origin :feature                           |
+      |        | git checkout other                                 |
+      | other  | git worktree remove ../developer.worktrees/feature |
+      |        | git branch -D feature                              |
+    And the current branch is still "other"
+    And the branches are now
+      | REPOSITORY    | BRANCHES    |
+      | local, origin | main, other |
+    And this lineage exists now
+      """
+      main
+        other
+      """
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                 |
+      | other  | git checkout main                                       |
+      | main   | git revert {{ sha 'done' }}                             |
+      |        | git push                                                |
+      |        | git branch feature {{ sha-initial 'feature commit' }}   |
+      |        | git push -u origin feature                              |
+      |        | git worktree add ../developer.worktrees/feature feature |
+      |        | git checkout other                                      |
+    And the current branch is still "other"
+    And the initial branches and lineage exist now
@@ -0,0 +1,69 @@
+Feature: ship the current stack using the fast-forward strategy
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME     | TYPE    | PARENT | LOCATIONS     |
+      | branch-1 | feature | main   | local, origin |
+    And the commits
+      | BRANCH   | LOCATION      | MESSAGE  |
+      | branch-1 | local, origin | commit 1 |
+    And the branches
+      | NAME     | TYPE    | PARENT   | LOCATIONS     |
+      | branch-2 | feature | branch-1 | local, origin |
+    And the commits
+      | BRANCH   | LOCATION      | MESSAGE  |
+      | branch-2 | local, origin | commit 2 |
+    And the branches
+      | NAME     | TYPE    | PARENT   | LOCATIONS     |
+      | branch-3 | feature | branch-2 | local, origin |
+    And the commits
+      | BRANCH   | LOCATION      | MESSAGE  |
+      | branch-3 | local, origin | commit 3 |
+    And Git setting "git-town.ship-strategy" is "fast-forward"
+    And the current branch is "branch-2"
+    When I run "git-town ship --stack"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                      |
+      | branch-2 | git fetch --prune --tags     |
+      |          | git checkout main            |
+      | main     | git merge --ff-only branch-1 |
+      |          | git push                     |
+      |          | git push origin :branch-1    |
+      |          | git branch -D branch-1       |
+      |          | git merge --ff-only branch-2 |
+      |          | git push                     |
+      |          | git push origin :branch-2    |
+      |          | git branch -D branch-2       |
+    And this lineage exists now
+      """
+      main
+        branch-3
+      """
+    And the branches are now
+      | REPOSITORY    | BRANCHES       |
+      | local, origin | main, branch-3 |
+    And these commits exist now
+      | BRANCH   | LOCATION      | MESSAGE  |
+      | main     | local, origin | commit 1 |
+      |          |               | commit 2 |
+      | branch-3 | local, origin | commit 3 |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                          |
+      | main   | git branch branch-1 {{ sha-initial 'commit 1' }} |
+      |        | git push -u origin branch-1                      |
+      |        | git branch branch-2 {{ sha-initial 'commit 2' }} |
+      |        | git push -u origin branch-2                      |
+      |        | git checkout branch-2                            |
+    And the initial branches and lineage exist now
+    And these commits exist now
+      | BRANCH   | LOCATION      | MESSAGE  |
+      | main     | local, origin | commit 1 |
+      |          |               | commit 2 |
+      | branch-2 | local, origin | commit 2 |
+      | branch-3 | local, origin | commit 3 |
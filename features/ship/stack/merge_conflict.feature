@@ -0,0 +1,106 @@
+@skipWindows
+Feature: handle conflicts while shipping a stack
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME     | TYPE    | PARENT | LOCATIONS     |
+      | branch-1 | feature | main   | local, origin |
+    And the commits
+      | BRANCH   | LOCATION      | MESSAGE  | FILE NAME | FILE CONTENT |
+      | branch-1 | local, origin | commit 1 | file_1    | content 1    |
+    And the branches
+      | NAME     | TYPE    | PARENT   | LOCATIONS     |
+      | branch-2 | feature | branch-1 | local, origin |
+    And the commits
+      | BRANCH   | LOCATION      | MESSAGE              | FILE NAME        | FILE CONTENT     |
+      | branch-2 | local, origin | conflicting commit 2 | conflicting_file | branch-2 content |
+    And the commits
+      | BRANCH | LOCATION | MESSAGE                 | FILE NAME        | FILE CONTENT |
+      | main   | local    | conflicting main commit | conflicting_file | main content |
+    And Git setting "git-town.ship-strategy" is "squash-merge"
+    And the current branch is "branch-2"
+    When I run "git-town ship --stack" and enter "branch-1 shipped" for the commit message
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                          |
+      | branch-2 | git fetch --prune --tags         |
+      |          | git checkout main                |
+      | main     | git merge --squash --ff branch-1 |
+      |          | git commit                       |
+      |          | git push                         |
+      |          | git push origin :branch-1        |
+      |          | git branch -D branch-1           |
+      |          | git merge --squash --ff branch-2 |
+    And Git Town prints the error:
+      """
+      CONFLICT (add/add): Merge conflict in conflicting_file
+      """
+    And Git Town prints the error:
+      """
+      To continue after having resolved conflicts, run "git town continue".
+      To go back to where you started, run "git town undo".
+      To continue by skipping the current branch, run "git town skip".
+      """
+    And file "conflicting_file" now has content:
+      """
+      <<<<<<< HEAD
+      main content
+      =======
+      branch-2 content
+      >>>>>>> branch-2
+      """
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                          |
+      | main   | git reset --hard                                 |
+      |        | git branch branch-1 {{ sha-initial 'commit 1' }} |
+      |        | git push -u origin branch-1                      |
+      |        | git revert {{ sha 'branch-1 shipped' }}          |
+      |        | git push                                         |
+      |        | git checkout branch-2                            |
+    And the initial branches and lineage exist now
+    And these commits exist now
+      | BRANCH   | LOCATION      | MESSAGE                   |
+      | main     | local, origin | conflicting main commit   |
+      |          |               | branch-1 shipped          |
+      |          |               | Revert "branch-1 shipped" |
+      | branch-1 | local, origin | commit 1                  |
+      | branch-2 | local, origin | conflicting commit 2      |
+
+  Scenario: skip
+    When I run "git-town skip"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND               |
+      | main   | git reset --hard      |
+      |        | git checkout branch-2 |
+    And this lineage exists now
+      """
+      main
+        branch-2
+      """
+    And these commits exist now
+      | BRANCH   | LOCATION      | MESSAGE                 |
+      | main     | local, origin | conflicting main commit |
+      |          |               | branch-1 shipped        |
+      | branch-2 | local, origin | commit 1                |
+      |          |               | conflicting commit 2    |
+
+  Scenario: resolve and continue
+    When I resolve the conflict in "conflicting_file"
+    And I run "git-town continue" and enter "branch-2 shipped" for the commit message
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                   |
+      | main   | git commit                |
+      |        | git push                  |
+      |        | git push origin :branch-2 |
+      |        | git branch -D branch-2    |
+    And no lineage exists now
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                 |
+      | main   | local, origin | conflicting main commit |
+      |        |               | branch-1 shipped        |
+      |        |               | branch-2 shipped        |
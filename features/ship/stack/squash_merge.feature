@@ -0,0 +1,95 @@
+@skipWindows
+Feature: ship the current stack using the squash-merge strategy and update proposals
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the branches
+      | NAME     | TYPE    | PARENT   | LOCATIONS     |
+      | branch-1 | feature | main     | local, origin |
+      | branch-2 | feature | branch-1 | local, origin |
+      | branch-3 | feature | branch-2 | local, origin |
+    And the commits
+      | BRANCH   | LOCATION      | MESSAGE  | FILE NAME | FILE CONTENT |
+      | branch-1 | local, origin | commit 1 | file_1    | content 1    |
+      | branch-2 | local, origin | commit 2 | file_2    | content 2    |
+      | branch-3 | local, origin | commit 3 | file_3    | content 3    |
+    And the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | TITLE      | BODY   | URL                      |
+      | 1  | branch-1      | main          | proposal 1 | body 1 | https://example.com/pr/1 |
+      | 2  | branch-2      | branch-1      | proposal 2 | body 2 | https://example.com/pr/2 |
+      | 3  | branch-3      | branch-2      | proposal 3 | body 3 | https://example.com/pr/3 |
+    And Git setting "git-town.ship-strategy" is "squash-merge"
+    And the current branch is "branch-2"
+    When I run "git-town ship --stack" and enter "shipped" for the commit message
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                                                          |
+      | branch-2 | git fetch --prune --tags                                         |
+      |          | Finding proposal from branch-3 into branch-2 ... #3 (proposal 3) |
+      |          | Finding proposal from branch-2 into branch-1 ... #2 (proposal 2) |
+      |          | git checkout main                                                |
+      |          | Updating target branch of proposal #2 to main ... ok             |
+      | main     | git merge --squash --ff branch-1                                 |
+      |          | git commit                                                       |
+      |          | git push                                                         |
+      |          | git push origin :branch-1                                        |
+      |          | git branch -D branch-1                                           |
+      |          | Updating target branch of proposal #3 to main ... ok             |
+      |          | git merge --squash --ff branch-2                                 |
+      |          | git commit                                                       |
+      |          | git push                                                         |
+      |          | git push origin :branch-2                                        |
+      |          | git branch -D branch-2                                           |
+    And this lineage exists now
+      """
+      main
+        branch-3
+      """
+    And the branches are now
+      | REPOSITORY    | BRANCHES       |
+      | local, origin | main, branch-3 |
+    And these commits exist now
+      | BRANCH   | LOCATION      | MESSAGE  |
+      | main     | local, origin | shipped  |
+      |          |               | shipped  |
+      | branch-3 | local, origin | commit 3 |
+    And the proposals are now
+      """
+      url: https://example.com/pr/1
+      number: 1
+      source: branch-1
+      target: main
+      body:
+        body 1
+      url: https://example.com/pr/2
+      number: 2
+      source: branch-2
+      target: main
+      body:
+        body 2
+      url: https://example.com/pr/3
+      number: 3
+      source: branch-3
+      target: main
+      body:
+        body 3
+      """
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then the initial branches and lineage exist now
+    And these commits exist now
+      | BRANCH   | LOCATION      | MESSAGE          |
+      | main     | local, origin | shipped          |
+      |          |               | shipped          |
+      |          |               | Revert "shipped" |
+      |          |               | Revert "shipped" |
+      | branch-1 | local, origin | commit 1         |
+      | branch-2 | local, origin | commit 2         |
+      | branch-3 | local, origin | commit 3         |
+    And the initial proposals exist now
+  #
+  # NOTE: Cannot verify the commands that undo runs here
+  # because they revert two commits that have the same message.
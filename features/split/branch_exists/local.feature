@@ -0,0 +1,24 @@
+Feature: does not split into a branch that already exists
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME     | TYPE    | PARENT | LOCATIONS |
+      | existing | feature | main   | local     |
+      | feature  | feature | main   | local     |
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE  |
+      | feature | local    | commit 1 |
+    And the current branch is "feature"
+    When I run "git-town split existing"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      there is already a branch existing
+      """
+  #
+  # NOTE: Cannot test undo here.
+  # The Git Town command under test has not created an undoable runstate.
+  # Executing "git town undo" would undo the Git Town command executed during setup.
@@ -0,0 +1,17 @@
+Feature: does not split the main branch
+
+  Background:
+    Given a Git repo with origin
+    And the current branch is "main"
+    When I run "git-town split branch-1"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      cannot split main branches since you don't own them
+      """
+  #
+  # NOTE: Cannot test undo here.
+  # The Git Town command under test has not created an undoable runstate.
+  # Executing "git town undo" would undo the Git Town command executed during setup.
@@ -0,0 +1,52 @@
+@messyoutput
+Feature: split a branch by assigning its commits in a different order
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE  | FILE NAME | FILE CONTENT |
+      | feature | local, origin | commit 1 | file_1    | content 1    |
+      | feature | local, origin | commit 2 | file_2    | content 2    |
+      | feature | local, origin | commit 3 | file_3    | content 3    |
+    And the current branch is "feature"
+    When I run "git-town split branch-1" and enter into the dialog:
+      | DIALOG          | KEYS                  |
+      | commits to beam | down down space enter |
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                                         |
+      | feature  | git branch branch-1 main                        |
+      |          | git checkout branch-1                           |
+      | branch-1 | git cherry-pick {{ sha-initial 'commit 3' }}    |
+      |          | git checkout feature                            |
+      | feature  | git reset --hard branch-1                       |
+      |          | git cherry-pick {{ sha-initial 'commit 1' }}    |
+      |          | git cherry-pick {{ sha-initial 'commit 2' }}    |
+      |          | git push --force-with-lease --force-if-includes |
+    And this lineage exists now
+      """
+      main
+        branch-1
+          feature
+      """
+    And these commits exist now
+      | BRANCH   | LOCATION      | MESSAGE  |
+      | branch-1 | local         | commit 3 |
+      | feature  | local, origin | commit 1 |
+      |          |               | commit 2 |
+      |          | origin        | commit 3 |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                         |
+      | feature | git reset --hard {{ sha-initial 'commit 3' }}   |
+      |         | git push --force-with-lease --force-if-includes |
+      |         | git branch -D branch-1                          |
+    And the initial lineage exists now
+    And the initial commits exist now
+    And the initial branches exist now
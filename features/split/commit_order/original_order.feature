@@ -0,0 +1,51 @@
+@messyoutput
+Feature: split a branch by assigning its commits in their original order
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE  | FILE NAME | FILE CONTENT |
+      | feature | local, origin | commit 1 | file_1    | content 1    |
+      | feature | local, origin | commit 2 | file_2    | content 2    |
+      | feature | local, origin | commit 3 | file_3    | content 3    |
+      | feature | local, origin | commit 4 | file_4    | content 4    |
+    And the current branch is "feature"
+    When I run "git-town split branch-1 branch-2" and enter into the dialogs:
+      | DIALOG          | KEYS                   |
+      | commits to beam | space down space enter |
+      | commits to beam | space enter            |
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                          |
+      | feature | git branch branch-1 {{ sha-initial 'commit 2' }} |
+      |         | git branch branch-2 {{ sha-initial 'commit 3' }} |
+    And this lineage exists now
+      """
+      main
+        branch-1
+          branch-2
+            feature
+      """
+    And these commits exist now
+      | BRANCH   | LOCATION      | MESSAGE  |
+      | branch-1 | local         | commit 1 |
+      |          |               | commit 2 |
+      | branch-2 | local         | commit 3 |
+      | feature  | local, origin | commit 4 |
+      |          | origin        | commit 1 |
+      |          |               | commit 2 |
+      |          |               | commit 3 |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                |
+      | feature | git branch -D branch-1 |
+      |         | git branch -D branch-2 |
+    And the initial lineage exists now
+    And the initial commits exist now
+    And the initial branches exist now
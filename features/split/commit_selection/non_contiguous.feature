@@ -0,0 +1,26 @@
+@messyoutput
+Feature: select commits that aren't a contiguous range
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS |
+      | feature | feature | main   | local     |
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE  | FILE NAME | FILE CONTENT |
+      | feature | local    | commit 1 | file_1    | content 1    |
+      | feature | local    | commit 2 | file_2    | content 2    |
+      | feature | local    | commit 3 | file_3    | content 3    |
+    And the current branch is "feature"
+    When I run "git-town split branch-1" and enter into the dialog:
+      | DIALOG          | KEYS                        |
+      | commits to beam | space down down space enter |
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      the commits for branch branch-1 must be a contiguous range
+      """
+    And the initial branches and lineage exist now
+    And the initial commits exist now
@@ -0,0 +1,23 @@
+Feature: does not create the same branch twice
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS |
+      | feature | feature | main   | local     |
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE  |
+      | feature | local    | commit 1 |
+    And the current branch is "feature"
+    When I run "git-town split branch-1 branch-1"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      branch branch-1 is listed more than once
+      """
+  #
+  # NOTE: Cannot test undo here.
+  # The Git Town command under test has not created an undoable runstate.
+  # Executing "git town undo" would undo the Git Town command executed during setup.
@@ -0,0 +1,50 @@
+Feature: update proposal targets to match the lineage
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the branches
+      | NAME     | TYPE    | PARENT   | LOCATIONS     |
+      | branch-1 | feature | main     | local, origin |
+      | branch-2 | feature | branch-1 | local, origin |
+    And the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | URL                      |
+      | 1  | branch-1      | main          | https://example.com/pr/1 |
+      | 2  | branch-2      | main          | https://example.com/pr/2 |
+    And the current branch is "branch-2"
+    When I run "git-town stack status --fix"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                          |
+      |        | Finding open, merged, and closed proposals for branch-1 ... main |
+      |        | Finding open, merged, and closed proposals for branch-2 ... main |
+      |        | Updating target branch of proposal #2 to branch-1 ... ok         |
+    And Git Town prints:
+      """
+      main
+        branch-1: #1 (open) into main https://example.com/pr/1
+          branch-2: #2 (open) into main https://example.com/pr/2
+            ! proposal targets main but the parent branch is branch-1
+      """
+    And the proposals are now
+      """
+      url: https://example.com/pr/1
+      number: 1
+      source: branch-1
+      target: main
+      body:
+
+      url: https://example.com/pr/2
+      number: 2
+      source: branch-2
+      target: branch-1
+      body:
+      """
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                              |
+      |        | Updating target branch of proposal #2 to main ... ok |
+    And the initial proposals exist now
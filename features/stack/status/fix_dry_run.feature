@@ -0,0 +1,24 @@
+Feature: preview updating proposal targets
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the branches
+      | NAME     | TYPE    | PARENT   | LOCATIONS     |
+      | branch-1 | feature | main     | local, origin |
+      | branch-2 | feature | branch-1 | local, origin |
+    And the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | URL                      |
+      | 1  | branch-1      | main          | https://example.com/pr/1 |
+      | 2  | branch-2      | main          | https://example.com/pr/2 |
+    And the current branch is "branch-2"
+    When I run "git-town stack status --fix --dry-run"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                          |
+      |        | Finding open, merged, and closed proposals for branch-1 ... main |
+      |        | Finding open, merged, and closed proposals for branch-2 ... main |
+    And the initial proposals exist now
+  #
+  # Cannot test undo because dry-run doesn't create a runstate.
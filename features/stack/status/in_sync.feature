@@ -0,0 +1,37 @@
+Feature: display the proposals of a stack that matches the lineage
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the branches
+      | NAME     | TYPE    | PARENT   | LOCATIONS     |
+      | branch-1 | feature | main     | local, origin |
+      | branch-2 | feature | branch-1 | local, origin |
+    And the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | URL                      |
+      | 1  | branch-1      | main          | https://example.com/pr/1 |
+      | 2  | branch-2      | branch-1      | https://example.com/pr/2 |
+    And the current branch is "branch-1"
+
+  Scenario: display
+    When I run "git-town stack status"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                              |
+      |        | Finding open, merged, and closed proposals for branch-1 ... main     |
+      |        | Finding open, merged, and closed proposals for branch-2 ... branch-1 |
+    And Git Town prints:
+      """
+      main
+        branch-1: #1 (open) into main https://example.com/pr/1
+          branch-2: #2 (open) into branch-1 https://example.com/pr/2
+      """
+    And Git Town does not print "git town stack status --fix"
+    And the initial proposals exist now
+
+  Scenario: fix
+    When I run "git-town stack status --fix"
+    Then Git Town prints:
+      """
+      The proposal targets already match the branch lineage.
+      """
+    And the initial proposals exist now
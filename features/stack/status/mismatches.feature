@@ -0,0 +1,39 @@
+Feature: display the proposals of a stack with mismatches
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the branches
+      | NAME     | TYPE    | PARENT   | LOCATIONS     |
+      | branch-1 | feature | main     | local, origin |
+      | branch-2 | feature | branch-1 | local, origin |
+      | branch-3 | feature | branch-2 | local, origin |
+      | branch-4 | feature | branch-3 | local, origin |
+    And the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | STATE  | DRAFT | URL                      |
+      | 1  | branch-1      | main          | open   | no    | https://example.com/pr/1 |
+      | 2  | branch-2      | main          | open   | yes   | https://example.com/pr/2 |
+      | 3  | branch-3      | branch-2      | merged | no    | https://example.com/pr/3 |
+    And the current branch is "branch-2"
+    When I run "git-town stack status"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                              |
+      |        | Finding open, merged, and closed proposals for branch-1 ... main     |
+      |        | Finding open, merged, and closed proposals for branch-2 ... main     |
+      |        | Finding open, merged, and closed proposals for branch-3 ... branch-2 |
+      |        | Finding open, merged, and closed proposals for branch-4 ... none     |
+    And Git Town prints:
+      """
+      main
+        branch-1: #1 (open) into main https://example.com/pr/1
+          branch-2: #2 (open, draft) into main https://example.com/pr/2
+            ! proposal targets main but the parent branch is branch-1
+            branch-3: #3 (merged) into branch-2 https://example.com/pr/3
+              ! proposal is merged but the branch still exists locally, run "git town sync" to delete it
+              branch-4: no proposal
+
+      To update the proposal targets to match the branch lineage, run "git town stack status --fix".
+      """
+    And the initial proposals exist now
@@ -0,0 +1,64 @@
+Feature: sync all feature branches and push them together
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME       | TYPE      | PARENT | LOCATIONS     |
+      | alpha      | feature   | main   | local, origin |
+      | beta       | feature   | main   | local, origin |
+      | production | perennial |        | local, origin |
+    And the commits
+      | BRANCH     | LOCATION      | MESSAGE                  |
+      | main       | origin        | main commit              |
+      | alpha      | local, origin | alpha commit             |
+      | beta       | local, origin | beta commit              |
+      | production | local         | local production commit  |
+      |            | origin        | origin production commit |
+    And the current branch is "alpha"
+    When I run "git-town sync --all --batch-push"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH     | COMMAND                                                 |
+      | alpha      | git fetch --prune --tags                                |
+      |            | git checkout main                                       |
+      | main       | git -c rebase.updateRefs=false rebase origin/main       |
+      |            | git checkout alpha                                      |
+      | alpha      | git merge --no-edit --ff main                           |
+      |            | git checkout beta                                       |
+      | beta       | git merge --no-edit --ff main                           |
+      |            | git checkout production                                 |
+      | production | git -c rebase.updateRefs=false rebase origin/production |
+      |            | git push origin alpha beta production                   |
+      |            | git checkout alpha                                      |
+      | alpha      | git push --tags                                         |
+    And these commits exist now
+      | BRANCH     | LOCATION      | MESSAGE                        |
+      | main       | local, origin | main commit                    |
+      | alpha      | local, origin | alpha commit                   |
+      |            |               | Merge branch 'main' into alpha |
+      | beta       | local, origin | beta commit                    |
+      |            |               | Merge branch 'main' into beta  |
+      | production | local, origin | origin production commit       |
+      |            |               | local production commit        |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                         |
+      | alpha  | git reset --hard {{ sha 'alpha commit' }}       |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout beta                               |
+      | beta   | git reset --hard {{ sha 'beta commit' }}        |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout main                               |
+      | main   | git reset --hard {{ sha 'initial commit' }}     |
+      |        | git checkout alpha                              |
+    And the initial branches and lineage exist now
+    And these commits exist now
+      | BRANCH     | LOCATION      | MESSAGE                  |
+      | main       | origin        | main commit              |
+      | alpha      | local, origin | alpha commit             |
+      | beta       | local, origin | beta commit              |
+      | production | local, origin | origin production commit |
+      |            |               | local production commit  |
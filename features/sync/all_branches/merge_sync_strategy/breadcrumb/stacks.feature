@@ -27,31 +27,35 @@ Feature: sync all branches and update proposals
 
   Scenario: result
     Then Git Town runs the commands
-      | BRANCH   | COMMAND                                                       |
-      | main     | git fetch --prune --tags                                      |
-      |          | git checkout alpha                                            |
-      | alpha    | git checkout beta                                             |
-      | beta     | git merge --no-edit --ff alpha                                |
-      |          | git push                                                      |
-      |          | git checkout gamma                                            |
-      | gamma    | git merge --no-edit --ff beta                                 |
-      |          | git push                                                      |
-      |          | git checkout branch-1                                         |
-      | branch-1 | git checkout main                                             |
-      | main     | git push --tags                                               |
-      |          | Finding all proposals for alpha ... main                      |
-      |          | Finding proposal from alpha into main ... #1 (alpha proposal) |
-      |          | Finding proposal from beta into alpha ... #2 (beta proposal)  |
-      |          | Finding proposal from gamma into beta ... #3 (gamma proposal) |
-      |          | Update body for #1 ... ok                                     |
-      |          | Finding all proposals for beta ... alpha                      |
-      |          | Finding proposal from alpha into main ... #1 (alpha proposal) |
-      |          | Update body for #2 ... ok                                     |
-      |          | Finding all proposals for branch-1 ... main                   |
-      |          | Update body for #4 ... ok                                     |
-      |          | Finding all proposals for gamma ... beta                      |
-      |          | Finding proposal from beta into alpha ... #2 (beta proposal)  |
-      |          | Update body for #3 ... ok                                     |
+      | BRANCH   | COMMAND                                                          |
+      | main     | git fetch --prune --tags                                         |
+      |          | Finding open, merged, and closed proposals for alpha ... main    |
+      |          | Finding open, merged, and closed proposals for beta ... alpha    |
+      |          | Finding open, merged, and closed proposals for gamma ... beta    |
+      |          | Finding open, merged, and closed proposals for branch-1 ... main |
+      |          | git checkout alpha                                               |
+      | alpha    | git checkout beta                                                |
+      | beta     | git merge --no-edit --ff alpha                                   |
+      |          | git push                                                         |
+      |          | git checkout gamma                                               |
+      | gamma    | git merge --no-edit --ff beta                                    |
+      |          | git push                                                         |
+      |          | git checkout branch-1                                            |
+      | branch-1 | git checkout main                                                |
+      | main     | git push --tags                                                  |
+      |          | Finding all proposals for alpha ... main                         |
+      |          | Finding proposal from alpha into main ... #1 (alpha proposal)    |
+      |          | Finding proposal from beta into alpha ... #2 (beta proposal)     |
+      |          | Finding proposal from gamma into beta ... #3 (gamma proposal)    |
+      |          | Update body for #1 ... ok                                        |
+      |          | Finding all proposals for beta ... alpha                         |
+      |          | Finding proposal from alpha into main ... #1 (alpha proposal)    |
+      |          | Update body for #2 ... ok                                        |
+      |          | Finding all proposals for branch-1 ... main                      |
+      |          | Update body for #4 ... ok                                        |
+      |          | Finding all proposals for gamma ... beta                         |
+      |          | Finding proposal from beta into alpha ... #2 (beta proposal)     |
+      |          | Update body for #3 ... ok                                        |
     And the initial branches and lineage exist now
     And the proposals are now
       """
@@ -0,0 +1,58 @@
+Feature: merge conflict while syncing a branch in another worktree
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME      | TYPE    | PARENT | LOCATIONS     |
+      | feature-1 | feature | main   | local, origin |
+      | feature-2 | feature | main   | local, origin |
+    And the commits
+      | BRANCH    | LOCATION      | MESSAGE                    | FILE NAME        | FILE CONTENT    |
+      | main      | local, origin | conflicting main commit    | conflicting_file | main content    |
+      | feature-2 | local         | conflicting feature commit | conflicting_file | feature content |
+    And the current branch is "feature-1"
+    And branch "feature-2" is active in another worktree
+    When I run "git-town sync --all --worktrees"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH    | COMMAND                       |
+      | feature-1 | git fetch --prune --tags      |
+      |           | git merge --no-edit --ff main |
+      |           | git push                      |
+      | feature-2 | git merge --no-edit --ff main |
+    And Git Town prints the error:
+      """
+      CONFLICT (add/add): Merge conflict in conflicting_file
+      """
+    And the current branch is still "feature-1"
+    And the current branch in the other worktree is still "feature-2"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH    | COMMAND                                         |
+      | feature-2 | git merge --abort                               |
+      | feature-1 | git reset --hard {{ sha 'initial commit' }}     |
+      |           | git push --force-with-lease --force-if-includes |
+    And the current branch is still "feature-1"
+    And the current branch in the other worktree is still "feature-2"
+    And the initial commits exist now
+    And the initial branches and lineage exist now
+
+  Scenario: resolve and continue
+    When I resolve the conflict in "conflicting_file" in the other worktree
+    And I run "git-town continue" and close the editor
+    Then Git Town runs the commands
+      | BRANCH    | COMMAND                                   |
+      | feature-2 | git commit --no-edit                      |
+      |           | git merge --no-edit --ff origin/feature-2 |
+      |           | git push                                  |
+      | feature-1 | git push --tags                           |
+    And the current branch is still "feature-1"
+    And the current branch in the other worktree is still "feature-2"
+    And these commits exist now
+      | BRANCH    | LOCATION                | MESSAGE                            |
+      | main      | local, origin, worktree | conflicting main commit            |
+      | feature-2 | origin, worktree        | conflicting feature commit         |
+      |           |                         | Merge branch 'main' into feature-2 |
@@ -0,0 +1,56 @@
+Feature: sync branches that are active in other worktrees
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME      | TYPE    | PARENT | LOCATIONS     |
+      | feature-1 | feature | main   | local, origin |
+      | feature-2 | feature | main   | local, origin |
+    And the commits
+      | BRANCH    | LOCATION | MESSAGE          |
+      | main      | origin   | main commit      |
+      | feature-1 | local    | feature-1 commit |
+      | feature-2 | local    | feature-2 commit |
+    And the current branch is "feature-1"
+    And branch "feature-2" is active in another worktree
+    When I run "git-town sync --all --worktrees"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH    | COMMAND                                           |
+      | feature-1 | git fetch --prune --tags                          |
+      |           | git checkout main                                 |
+      | main      | git -c rebase.updateRefs=false rebase origin/main |
+      |           | git checkout feature-1                            |
+      | feature-1 | git merge --no-edit --ff main                     |
+      |           | git merge --no-edit --ff origin/feature-1         |
+      |           | git push                                          |
+      | feature-2 | git merge --no-edit --ff main                     |
+      |           | git merge --no-edit --ff origin/feature-2         |
+      |           | git push                                          |
+      | feature-1 | git push --tags                                   |
+    And the current branch is still "feature-1"
+    And the current branch in the other worktree is still "feature-2"
+    And these commits exist now
+      | BRANCH    | LOCATION                | MESSAGE                            |
+      | main      | local, origin, worktree | main commit                        |
+      | feature-1 | local, origin           | feature-1 commit                   |
+      |           |                         | Merge branch 'main' into feature-1 |
+      | feature-2 | origin, worktree        | feature-2 commit                   |
+      |           |                         | Merge branch 'main' into feature-2 |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH    | COMMAND                                                                 |
+      | feature-1 | git reset --hard {{ sha 'feature-1 commit' }}                           |
+      |           | git push --force-with-lease origin {{ sha 'initial commit' }}:feature-1 |
+      | feature-2 | git reset --hard {{ sha 'feature-2 commit' }}                           |
+      | feature-1 | git push --force-with-lease origin {{ sha 'initial commit' }}:feature-2 |
+      |           | git checkout main                                                       |
+      | main      | git reset --hard {{ sha 'initial commit' }}                             |
+      |           | git checkout feature-1                                                  |
+    And the current branch is still "feature-1"
+    And the current branch in the other worktree is still "feature-2"
+    And the initial commits exist now
+    And the initial branches and lineage exist now
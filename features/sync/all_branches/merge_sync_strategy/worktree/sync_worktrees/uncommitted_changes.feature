@@ -0,0 +1,30 @@
+Feature: does not sync branches in other worktrees that have uncommitted changes
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME      | TYPE    | PARENT | LOCATIONS     |
+      | feature-1 | feature | main   | local, origin |
+      | feature-2 | feature | main   | local, origin |
+    And the commits
+      | BRANCH    | LOCATION | MESSAGE          |
+      | main      | origin   | main commit      |
+      | feature-2 | local    | feature-2 commit |
+    And the current branch is "feature-1"
+    And branch "feature-2" is active in another worktree
+    And an uncommitted file in the other worktree
+    When I run "git-town sync --all --worktrees"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH    | COMMAND                  |
+      | feature-1 | git fetch --prune --tags |
+    And Git Town prints the error:
+      """
+      cannot sync branch feature-2 because its worktree
+      """
+    And the initial commits exist now
+    #
+    # NOTE: Cannot test undo here.
+    # The Git Town command under test has not created an undoable runstate.
+    # Executing "git town undo" would undo the Git Town command executed during setup.
@@ -0,0 +1,43 @@
+Feature: sync all feature branches using the rebase strategy and push them together
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME       | TYPE      | PARENT | LOCATIONS     |
+      | alpha      | feature   | main   | local, origin |
+      | beta       | feature   | main   | local, origin |
+      | production | perennial |        | local, origin |
+    And the commits
+      | BRANCH     | LOCATION      | MESSAGE                  |
+      | main       | origin        | main commit              |
+      | alpha      | local, origin | alpha commit             |
+      | beta       | local, origin | beta commit              |
+      | production | local         | local production commit  |
+      |            | origin        | origin production commit |
+    And Git setting "git-town.sync-feature-strategy" is "rebase"
+    And the current branch is "alpha"
+    When I run "git-town sync --all --batch-push"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH     | COMMAND                                                                      |
+      | alpha      | git fetch --prune --tags                                                     |
+      |            | git checkout main                                                            |
+      | main       | git -c rebase.updateRefs=false rebase origin/main                            |
+      |            | git checkout alpha                                                           |
+      | alpha      | git -c rebase.updateRefs=false rebase --onto main {{ sha 'initial commit' }} |
+      |            | git checkout beta                                                            |
+      | beta       | git -c rebase.updateRefs=false rebase --onto main {{ sha 'initial commit' }} |
+      |            | git checkout production                                                      |
+      | production | git -c rebase.updateRefs=false rebase origin/production                      |
+      |            | git push origin production                                                   |
+      |            | git push --force-with-lease --force-if-includes origin alpha beta            |
+      |            | git checkout alpha                                                           |
+      | alpha      | git push --tags                                                              |
+    And these commits exist now
+      | BRANCH     | LOCATION      | MESSAGE                  |
+      | main       | local, origin | main commit              |
+      | alpha      | local, origin | alpha commit             |
+      | beta       | local, origin | beta commit              |
+      | production | local, origin | origin production commit |
+      |            |               | local production commit  |
@@ -0,0 +1,27 @@
+Feature: CLI flags take precedence over per-branch settings
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE        |
+      | feature | local    | feature commit |
+    And local Git setting "git-town-branch.feature.push-branches" is "false"
+    And the current branch is "feature"
+
+  Scenario: per-branch setting
+    When I run "git-town sync"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                 |
+      | feature | git fetch --prune --tags                |
+      |         | git merge --no-edit --ff origin/feature |
+
+  Scenario: CLI flag
+    When I run "git-town sync --push"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                 |
+      | feature | git fetch --prune --tags                |
+      |         | git merge --no-edit --ff origin/feature |
+      |         | git push                                |
@@ -0,0 +1,57 @@
+Feature: sync branches whose settings the configuration file overrides through a glob pattern
+
+  Background:
+    Given a Git repo with origin
+    And the committed configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [branches."release/*"]
+      push-branches = false
+      sync-feature-strategy = "rebase"
+
+      [sync]
+      feature-strategy = "merge"
+      """
+    And the branches
+      | NAME      | TYPE    | PARENT | LOCATIONS     |
+      | feature   | feature | main   | local, origin |
+      | release/1 | feature | main   | local, origin |
+    And the commits
+      | BRANCH    | LOCATION | MESSAGE        |
+      | main      | origin   | main commit    |
+      | feature   | local    | feature commit |
+      | release/1 | local    | release commit |
+    And the current branch is "feature"
+    When I run "git-town sync --all"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH    | COMMAND                                                                             |
+      | feature   | git fetch --prune --tags                                                            |
+      |           | git checkout main                                                                   |
+      | main      | git -c rebase.updateRefs=false rebase origin/main                                   |
+      |           | git checkout feature                                                                |
+      | feature   | git merge --no-edit --ff main                                                       |
+      |           | git merge --no-edit --ff origin/feature                                             |
+      |           | git push                                                                            |
+      |           | git checkout release/1                                                              |
+      | release/1 | git -c rebase.updateRefs=false rebase origin/release/1                              |
+      |           | git -c rebase.updateRefs=false rebase --onto main {{ sha 'persisted config file' }} |
+      |           | git checkout feature                                                                |
+      | feature   | git push --tags                                                                     |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH    | COMMAND                                                                      |
+      | feature   | git reset --hard {{ sha 'feature commit' }}                                  |
+      |           | git push --force-with-lease origin {{ sha 'persisted config file' }}:feature |
+      |           | git checkout main                                                            |
+      | main      | git reset --hard {{ sha 'persisted config file' }}                           |
+      |           | git checkout release/1                                                       |
+      | release/1 | git reset --hard {{ sha-initial 'release commit' }}                          |
+      |           | git checkout feature                                                         |
+    And the initial branches and lineage exist now
+    And the initial commits exist now
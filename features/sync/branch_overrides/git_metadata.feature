@@ -0,0 +1,49 @@
+Feature: sync a branch that overrides the sync-feature-strategy in the Git metadata
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME      | TYPE    | PARENT | LOCATIONS     |
+      | feature-1 | feature | main   | local, origin |
+      | feature-2 | feature | main   | local, origin |
+    And the commits
+      | BRANCH    | LOCATION | MESSAGE          |
+      | main      | origin   | main commit      |
+      | feature-1 | local    | feature-1 commit |
+      | feature-2 | local    | feature-2 commit |
+    And Git setting "git-town.sync-feature-strategy" is "rebase"
+    And local Git setting "git-town-branch.feature-2.sync-feature-strategy" is "merge"
+    And the current branch is "feature-1"
+    When I run "git-town sync --all"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH    | COMMAND                                                                      |
+      | feature-1 | git fetch --prune --tags                                                     |
+      |           | git checkout main                                                            |
+      | main      | git -c rebase.updateRefs=false rebase origin/main                            |
+      |           | git checkout feature-1                                                       |
+      | feature-1 | git push --force-with-lease --force-if-includes                              |
+      |           | git -c rebase.updateRefs=false rebase --onto main {{ sha 'initial commit' }} |
+      |           | git push --force-with-lease --force-if-includes                              |
+      |           | git checkout feature-2                                                       |
+      | feature-2 | git merge --no-edit --ff main                                                |
+      |           | git merge --no-edit --ff origin/feature-2                                    |
+      |           | git push                                                                     |
+      |           | git checkout feature-1                                                       |
+      | feature-1 | git push --tags                                                              |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH    | COMMAND                                                                 |
+      | feature-1 | git reset --hard {{ sha-initial 'feature-1 commit' }}                   |
+      |           | git push --force-with-lease origin {{ sha 'initial commit' }}:feature-1 |
+      |           | git checkout feature-2                                                  |
+      | feature-2 | git reset --hard {{ sha 'feature-2 commit' }}                           |
+      |           | git push --force-with-lease origin {{ sha 'initial commit' }}:feature-2 |
+      |           | git checkout main                                                       |
+      | main      | git reset --hard {{ sha 'initial commit' }}                             |
+      |           | git checkout feature-1                                                  |
+    And the initial branches and lineage exist now
+    And the initial commits exist now
@@ -0,0 +1,45 @@
+Feature: sync a shipped branch that overrides settings
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME      | TYPE    | PARENT | LOCATIONS     |
+      | feature-1 | feature | main   | local, origin |
+      | feature-2 | feature | main   | local, origin |
+    And the commits
+      | BRANCH    | LOCATION      | MESSAGE          | FILE NAME      | FILE CONTENT      |
+      | feature-1 | local, origin | feature-1 commit | feature-1-file | feature 1 content |
+      | feature-2 | local, origin | feature-2 commit | feature-2-file | feature 2 content |
+    And local Git setting "git-town-branch.feature-1.sync-feature-strategy" is "rebase"
+    And origin ships the "feature-1" branch using the "squash-merge" ship-strategy
+    And the current branch is "feature-2"
+    When I run "git-town sync --all"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH    | COMMAND                                           |
+      | feature-2 | git fetch --prune --tags                          |
+      |           | git checkout main                                 |
+      | main      | git -c rebase.updateRefs=false rebase origin/main |
+      |           | git branch -D feature-1                           |
+      |           | git checkout feature-2                            |
+      | feature-2 | git merge --no-edit --ff main                     |
+      |           | git push                                          |
+      |           | git push --tags                                   |
+    And local Git setting "git-town-branch.feature-1.sync-feature-strategy" now doesn't exist
+    And the branches are now
+      | REPOSITORY    | BRANCHES        |
+      | local, origin | main, feature-2 |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH    | COMMAND                                                   |
+      | feature-2 | git reset --hard {{ sha-initial 'feature-2 commit' }}     |
+      |           | git push --force-with-lease --force-if-includes           |
+      |           | git checkout main                                         |
+      | main      | git reset --hard {{ sha 'initial commit' }}               |
+      |           | git branch feature-1 {{ sha-initial 'feature-1 commit' }} |
+      |           | git checkout feature-2                                    |
+    And local Git setting "git-town-branch.feature-1.sync-feature-strategy" is now "rebase"
+    And the initial branches and lineage exist now
@@ -29,6 +29,8 @@ Feature: sync the current feature branch and update proposals
     Then Git Town runs the commands
       | BRANCH   | COMMAND                                                                 |
       | branch-2 | git fetch --prune --tags                                                |
+      |          | Finding open, merged, and closed proposals for branch-1 ... main        |
+      |          | Finding open, merged, and closed proposals for branch-2 ... branch-1    |
       |          | git checkout branch-1                                                   |
       | branch-1 | git checkout branch-2                                                   |
       | branch-2 | git merge --no-edit --ff branch-1                                       |
@@ -0,0 +1,53 @@
+Feature: sync a feature branch whose proposal the forge has merged
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        | FILE NAME    | FILE CONTENT    |
+      | feature | local, origin | feature commit | feature_file | feature content |
+      | main    | origin        | feature (#1)   | feature_file | feature content |
+    And the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | STATE  | HEAD COMMIT    | URL                      |
+      | 1  | feature       | main          | merged | feature commit | https://example.com/pr/1 |
+    And the current branch is "feature"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                         |
+      | feature | git fetch --prune --tags                                        |
+      |         | Finding open, merged, and closed proposals for feature ... main |
+      |         | git checkout main                                               |
+      | main    | git -c rebase.updateRefs=false rebase origin/main               |
+      |         | git push origin :feature                                        |
+      |         | git branch -D feature                                           |
+    And Git Town prints:
+      """
+      branch feature was merged at the forge
+      """
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And this lineage exists now
+      """
+      """
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE      |
+      | main   | local, origin | feature (#1) |
+    And the initial proposals exist now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                       |
+      | main   | git branch feature {{ sha 'feature commit' }} |
+      |        | git push -u origin feature                    |
+      |        | git reset --hard {{ sha 'initial commit' }}   |
+      |        | git checkout feature                          |
+    And the initial branches and lineage exist now
+    And the initial commits exist now
+    And the initial proposals exist now
@@ -0,0 +1,57 @@
+Feature: sync a feature branch that received new commits after the forge merged its proposal
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        | FILE NAME    | FILE CONTENT    |
+      | feature | local, origin | feature commit | feature_file | feature content |
+      | feature | local, origin | new commit     | new_file     | new content     |
+      | main    | origin        | feature (#1)   | feature_file | feature content |
+    And the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | STATE  | HEAD COMMIT    | URL                      |
+      | 1  | feature       | main          | merged | feature commit | https://example.com/pr/1 |
+    And the current branch is "feature"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                         |
+      | feature | git fetch --prune --tags                                        |
+      |         | Finding open, merged, and closed proposals for feature ... main |
+      |         | git checkout main                                               |
+      | main    | git -c rebase.updateRefs=false rebase origin/main               |
+      |         | git checkout feature                                            |
+      | feature | git merge --no-edit --ff main                                   |
+      |         | git push                                                        |
+    And the branches are now
+      | REPOSITORY    | BRANCHES      |
+      | local, origin | main, feature |
+    And this lineage exists now
+      """
+      main
+        feature
+      """
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE                          |
+      | main    | local, origin | feature (#1)                     |
+      | feature | local, origin | feature commit                   |
+      |         |               | new commit                       |
+      |         |               | Merge branch 'main' into feature |
+    And the initial proposals exist now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                         |
+      | feature | git reset --hard {{ sha-initial 'new commit' }} |
+      |         | git push --force-with-lease --force-if-includes |
+      |         | git checkout main                               |
+      | main    | git reset --hard {{ sha 'initial commit' }}     |
+      |         | git checkout feature                            |
+    And the initial branches and lineage exist now
+    And the initial commits exist now
+    And the initial proposals exist now
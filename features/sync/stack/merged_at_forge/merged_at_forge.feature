@@ -0,0 +1,68 @@
+Feature: sync a stack whose parent branch the forge has squash-merged
+
+  Background:
+    Given a Git repo with origin
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the branches
+      | NAME   | TYPE    | PARENT | LOCATIONS     |
+      | parent | feature | main   | local, origin |
+      | child  | feature | parent | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME   | FILE CONTENT   |
+      | parent | local, origin | parent commit | parent_file | parent content |
+      | child  | local, origin | child commit  | child_file  | child content  |
+      | main   | origin        | parent (#1)   | parent_file | parent content |
+    And the proposals
+      | ID | SOURCE BRANCH | TARGET BRANCH | STATE  | HEAD COMMIT   | URL                      |
+      | 1  | parent        | main          | merged | parent commit | https://example.com/pr/1 |
+      | 2  | child         | parent        | open   | child commit  | https://example.com/pr/2 |
+    And Git setting "git-town.sync-feature-strategy" is "rebase"
+    And the current branch is "child"
+    When I run "git-town sync --stack"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                         |
+      | child  | git fetch --prune --tags                                        |
+      |        | Finding open, merged, and closed proposals for parent ... main  |
+      |        | Finding open, merged, and closed proposals for child ... parent |
+      |        | git checkout main                                               |
+      | main   | git -c rebase.updateRefs=false rebase origin/main               |
+      |        | git push origin :parent                                         |
+      |        | git checkout child                                              |
+      | child  | git pull                                                        |
+      |        | git -c rebase.updateRefs=false rebase --onto main parent        |
+      |        | git push --force-with-lease                                     |
+      |        | git branch -D parent                                            |
+    And Git Town prints:
+      """
+      branch parent was merged at the forge
+      """
+    And the branches are now
+      | REPOSITORY    | BRANCHES    |
+      | local, origin | main, child |
+    And this lineage exists now
+      """
+      main
+        child
+      """
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE      |
+      | main   | local, origin | parent (#1)  |
+      | child  | local, origin | child commit |
+    And the initial proposals exist now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                           |
+      | child  | git reset --hard {{ sha-initial 'child commit' }} |
+      |        | git push --force-with-lease --force-if-includes   |
+      |        | git branch parent {{ sha 'parent commit' }}       |
+      |        | git push -u origin parent                         |
+      |        | git checkout main                                 |
+      | main   | git reset --hard {{ sha 'initial commit' }}       |
+      |        | git checkout child                                |
+    And the initial branches and lineage exist now
+    And the initial commits exist now
+    And the initial proposals exist now
@@ -24,6 +24,7 @@ Feature: sync stacked changes without updating proposals of observed branches
     Then Git Town runs the commands
       | BRANCH | COMMAND                                                                                  |
       | child  | git fetch --prune --tags                                                                 |
+      |        | Finding open, merged, and closed proposals for child ... observed                        |
       |        | git -c rebase.updateRefs=false rebase --onto observed {{ sha-initial 'initial commit' }} |
       |        | git push --force-with-lease --force-if-includes                                          |
       |        | Finding all proposals for child ... observed                                             |
@@ -28,6 +28,8 @@ Feature: sync stacked changes and update proposals
     Then Git Town runs the commands
       | BRANCH | COMMAND                                                                                |
       | child  | git fetch --prune --tags                                                               |
+      |        | Finding open, merged, and closed proposals for parent ... main                         |
+      |        | Finding open, merged, and closed proposals for child ... parent                        |
       |        | git checkout parent                                                                    |
       | parent | git -c rebase.updateRefs=false rebase --onto main {{ sha-initial 'initial commit' }}   |
       |        | git push --force-with-lease --force-if-includes                                        |
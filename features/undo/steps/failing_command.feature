@@ -0,0 +1,31 @@
+Feature: stop undoing older commands when undoing a command fails
+
+  Scenario:
+    Given a Git repo with origin
+    And I ran "git-town hack alpha"
+    And the current branch is "main"
+    And I ran "git-town hack beta"
+    And the current branch is "main"
+    And I ran "git-town hack gamma"
+    And branch "beta" is active in another worktree
+    When I run "git-town undo --steps 3"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND             |
+      | gamma  | git checkout main   |
+      | main   | git branch -D gamma |
+      |        | git branch -D beta  |
+    And Git Town prints the error:
+      """
+      could not fully undo the "hack" command, stopped undoing older commands
+      """
+    And the branches are now
+      | REPOSITORY | BRANCHES          |
+      | local      | main, alpha, beta |
+      | origin     | main              |
+    When I run "git-town history"
+    Then Git Town prints something like:
+      """
+      Git Town command history, most recent first:
+       1  \d{4}-\d\d-\d\d \d\d:\d\d:\d\d  hack  beta, main
+       2  \d{4}-\d\d-\d\d \d\d:\d\d:\d\d  hack  alpha, main
+      """
@@ -0,0 +1,21 @@
+Feature: refuse to undo multiple commands if a branch changed locally and remotely since
+
+  Scenario:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+    And the current branch is "alpha"
+    And I ran "git-town hack beta"
+    And I ran "git-town hack gamma"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE       |
+      | alpha  | local    | local commit  |
+      | alpha  | origin   | origin commit |
+    And I ran "git fetch"
+    When I run "git-town undo --steps 2"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      cannot undo the "hack" command because branch alpha was changed both locally and at its tracking branch afterwards
+      """
@@ -0,0 +1,36 @@
+Feature: undo the previous command after undoing the most recent one
+
+  Background:
+    Given a Git repo with origin
+    And I ran "git-town hack alpha"
+    And I ran "git-town hack beta"
+    And I ran "git-town undo"
+
+  Scenario: undo again
+    When I run "git-town undo"
+    Then Git Town runs no commands
+    And Git Town prints:
+      """
+      nothing to undo, the most recent Git Town command has already been undone.
+      Run "git town history" to see older commands and "git town undo --steps <number>" to undo them.
+      """
+    And the branches are now
+      | REPOSITORY | BRANCHES    |
+      | local      | main, alpha |
+      | origin     | main        |
+    And this lineage exists now
+      """
+      main
+        alpha
+      """
+
+  Scenario: undo the previous command with --steps
+    When I run "git-town undo --steps 1"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND             |
+      | alpha  | git checkout main   |
+      | main   | git branch -D alpha |
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And no lineage exists now
@@ -0,0 +1,12 @@
+Feature: undo more commands than Git Town remembers
+
+  Scenario:
+    Given a Git repo with origin
+    And I ran "git-town hack alpha"
+    When I run "git-town undo --steps 2"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      cannot undo 2 commands because Git Town remembers only 1
+      """
+    And the current branch is still "alpha"
@@ -0,0 +1,40 @@
+Feature: undo the two most recent commands
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION | MESSAGE      |
+      | alpha  | local    | alpha commit |
+    And the current branch is "alpha"
+    And I ran "git-town sync"
+    And I ran "git-town hack beta"
+    When I run "git-town undo --steps 2"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                             |
+      | beta   | git checkout alpha                                                  |
+      | alpha  | git branch -D beta                                                  |
+      |        | git push --force-with-lease origin {{ sha 'initial commit' }}:alpha |
+    And the branches are now
+      | REPOSITORY    | BRANCHES    |
+      | local, origin | main, alpha |
+    And this lineage exists now
+      """
+      main
+        alpha
+      """
+    And these commits exist now
+      | BRANCH | LOCATION | MESSAGE      |
+      | alpha  | local    | alpha commit |
+
+  Scenario: undo again
+    When I run "git-town undo"
+    Then Git Town runs no commands
+    And Git Town prints:
+      """
+      nothing to undo
+      """
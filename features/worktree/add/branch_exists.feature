@@ -0,0 +1,17 @@
+Feature: cannot create a worktree for an existing branch
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME     | TYPE    | PARENT | LOCATIONS     |
+      | existing | feature | main   | local, origin |
+    And the current branch is "main"
+    When I run "git-town worktree add existing"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      there is already a branch existing
+      """
+    And the initial branches and lineage exist now
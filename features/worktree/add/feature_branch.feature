@@ -0,0 +1,35 @@
+Feature: create a feature branch in a new worktree
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME     | TYPE    | PARENT | LOCATIONS     |
+      | existing | feature | main   | local, origin |
+    And the commits
+      | BRANCH   | LOCATION      | MESSAGE         |
+      | existing | local, origin | existing commit |
+    And the current branch is "existing"
+    When I run "git-town worktree add new"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                                         |
+      | existing | git branch new existing                         |
+      |          | git worktree add ../developer.worktrees/new new |
+    And the current branch is still "existing"
+    And this lineage exists now
+      """
+      main
+        existing
+          new
+      """
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                                        |
+      | existing | git worktree remove ../developer.worktrees/new |
+      |          | git branch -D new                              |
+    And the current branch is still "existing"
+    And the initial branches and lineage exist now
+    And the initial commits exist now
@@ -0,0 +1,28 @@
+Feature: create a prototype branch in a new worktree
+
+  Background:
+    Given a Git repo with origin
+    And the current branch is "main"
+    When I run "git-town worktree add new --prototype"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                         |
+      | main   | git branch new main                             |
+      |        | git worktree add ../developer.worktrees/new new |
+    And the current branch is still "main"
+    And branch "new" now has type "prototype"
+    And this lineage exists now
+      """
+      main
+        new
+      """
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                        |
+      | main   | git worktree remove ../developer.worktrees/new |
+      |        | git branch -D new                              |
+    And the current branch is still "main"
+    And the initial branches and lineage exist now
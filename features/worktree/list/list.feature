@@ -0,0 +1,20 @@
+Feature: display the worktrees of all branches
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+    And the current branch is "alpha"
+    And I ran "git-town worktree add beta"
+    When I run "git-town worktree list"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND |
+    And Git Town prints:
+      """
+        main
+      *   alpha   .
+      +     beta  ../developer.worktrees/beta
+      """
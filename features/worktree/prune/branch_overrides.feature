@@ -0,0 +1,26 @@
+Feature: remove the settings that a pruned branch overrides
+
+  Background:
+    Given a Git repo with origin
+    And Git setting "git-town.share-new-branches" is "push"
+    And I ran "git-town worktree add shipped"
+    And local Git setting "git-town-branch.shipped.sync-feature-strategy" is "rebase"
+    And origin deletes the "shipped" branch
+    When I run "git-town worktree prune"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                            |
+      | main   | git fetch --prune --tags                           |
+      |        | git worktree remove ../developer.worktrees/shipped |
+      |        | git branch -D shipped                              |
+    And local Git setting "git-town-branch.shipped.sync-feature-strategy" now doesn't exist
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                 |
+      | main   | git branch shipped {{ sha 'initial commit' }}           |
+      |        | git worktree add ../developer.worktrees/shipped shipped |
+    And local Git setting "git-town-branch.shipped.sync-feature-strategy" is now "rebase"
+    And the initial branches and lineage exist now
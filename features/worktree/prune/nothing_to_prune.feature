@@ -0,0 +1,16 @@
+Feature: no worktrees to prune
+
+  Background:
+    Given a Git repo with origin
+    And Git setting "git-town.share-new-branches" is "push"
+    And I ran "git-town worktree add active"
+    When I run "git-town worktree prune"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git fetch --prune --tags |
+    And Git Town prints:
+      """
+      no worktrees to prune
+      """
@@ -0,0 +1,34 @@
+Feature: remove the worktrees of branches that were shipped at the remote
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS |
+      | current | feature | main   | local     |
+    And the current branch is "current"
+    And Git setting "git-town.share-new-branches" is "push"
+    And I ran "git-town worktree add shipped"
+    And I ran "git-town worktree add active"
+    And origin deletes the "shipped" branch
+    When I run "git-town worktree prune"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                            |
+      | current | git fetch --prune --tags                           |
+      |         | git worktree remove ../developer.worktrees/shipped |
+      |         | git branch -D shipped                              |
+    And this lineage exists now
+      """
+      main
+        current
+          active
+      """
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                 |
+      | current | git branch shipped {{ sha 'initial commit' }}           |
+      |         | git worktree add ../developer.worktrees/shipped shipped |
+    And the initial branches and lineage exist now
@@ -0,0 +1,19 @@
+Feature: does not remove worktrees with uncommitted changes
+
+  Background:
+    Given a Git repo with origin
+    And Git setting "git-town.share-new-branches" is "push"
+    And I ran "git-town worktree add shipped"
+    And I ran "touch ../developer.worktrees/shipped/uncommitted"
+    And origin deletes the "shipped" branch
+    When I run "git-town worktree prune"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git fetch --prune --tags |
+    And Git Town prints the error:
+      """
+      cannot remove worktree ../developer.worktrees/shipped of branch shipped because it has uncommitted changes
+      """
+    And the initial branches and lineage exist now
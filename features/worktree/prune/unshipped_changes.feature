@@ -0,0 +1,26 @@
+Feature: does not remove worktrees of branches with unshipped changes
+
+  Background:
+    Given a Git repo with origin
+    And Git setting "git-town.share-new-branches" is "push"
+    And I ran "git-town worktree add unshipped"
+    And I ran "touch ../developer.worktrees/unshipped/unshipped_file"
+    And I ran "git -C ../developer.worktrees/unshipped add unshipped_file"
+    And I ran "git -C ../developer.worktrees/unshipped commit -m unshipped"
+    And origin deletes the "unshipped" branch
+    When I run "git-town worktree prune"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git fetch --prune --tags |
+    And Git Town prints:
+      """
+      Branch unshipped was deleted at the remote but the local branch contains unshipped changes.
+      I am therefore not removing its worktree ../developer.worktrees/unshipped.
+      """
+    And this lineage exists now
+      """
+      main
+        unshipped
+      """
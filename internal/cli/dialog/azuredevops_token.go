@@ -0,0 +1,47 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogcomponents"
+	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogdomain"
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/messages"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+const (
+	azuredevopsTokenTitle = `Azure DevOps personal access token`
+	azuredevopsTokenHelp  = `
+Git Town can update pull requests
+and ship branches on Azure DevOps for you.
+To enable this, please enter
+an Azure DevOps personal access token
+with the "Code (Read & Write)" scope.
+More info at
+https://www.git-town.com/preferences/azuredevops-token.
+
+If you leave this empty,
+Git Town will not use the Azure DevOps API.
+
+`
+)
+
+func AzuredevopsToken(args Args[forgedomain.AzuredevopsToken]) (Option[forgedomain.AzuredevopsToken], dialogdomain.Exit, error) {
+	input, exit, err := dialogcomponents.TextField(dialogcomponents.TextFieldArgs{
+		DialogName:     "azuredevops-token",
+		DisplayDialogs: args.DisplayDialogs,
+		ExistingValue:  args.Local.Or(args.Global).StringOr(""),
+		Help:           azuredevopsTokenHelp,
+		Inputs:         args.Inputs,
+		Prompt:         messages.AzuredevopsTokenPrompt,
+		Title:          azuredevopsTokenTitle,
+	})
+	newValue := forgedomain.ParseAzuredevopsToken(input)
+	if args.Global.Equal(newValue) {
+		// the user has entered the global value --> keep using the global value, don't store the local value
+		newValue = None[forgedomain.AzuredevopsToken]()
+	}
+	fmt.Printf(messages.AzuredevopsTokenResult, dialogcomponents.FormattedOption(newValue, args.Global.IsSome(), exit))
+	return newValue, exit, err
+}
@@ -22,6 +22,9 @@ and remove the shipped branch locally and remotely.
 Options:
 
 - api: merge the proposal on your forge via the forge API
+- api-auto-merge: let your forge merge the proposal
+                  once it is ready, for example
+                  through a merge queue or merge train
 - always-merge: on your machine, merge by
                 always creating a merge comment
 								(git merge --no-ff)
@@ -47,6 +50,10 @@ func ShipStrategy(args Args[configdomain.ShipStrategy]) (Option[configdomain.Shi
 			Data: Some(configdomain.ShipStrategyAPI),
 			Text: `api: merge the proposal on your forge via the forge API`,
 		},
+		{
+			Data: Some(configdomain.ShipStrategyAPIAutoMerge),
+			Text: `api-auto-merge: enable auto-merge for the proposal on your forge, which merges it through the merge queue or merge train once it is ready`,
+		},
 		{
 			Data: Some(configdomain.ShipStrategyAlwaysMerge),
 			Text: `always-merge: in your local repo, merge the feature branch into its parent by always creating a merge comment (merge --no-ff)`,
@@ -0,0 +1,36 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogcomponents"
+	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogcomponents/list"
+	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogdomain"
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+)
+
+const (
+	stackPositionTitle = `Stack position %d`
+	stackPositionHelp  = `
+Please select the branch that should be at position %d of the stack.
+Position 1 is the branch directly on top of %s.
+
+`
+)
+
+type StackPositionArgs struct {
+	Branches       gitdomain.LocalBranchNames // the branches that don't have a position yet
+	DisplayDialogs configdomain.DisplayDialogs
+	Inputs         dialogcomponents.Inputs
+	Position       int // the 1-based position in the stack to select a branch for
+	Root           gitdomain.LocalBranchName
+}
+
+// StackPosition lets the user select the branch to place at the given position of a stack.
+func StackPosition(args StackPositionArgs) (gitdomain.LocalBranchName, dialogdomain.Exit, error) {
+	entries := list.NewEntries(args.Branches...)
+	title := fmt.Sprintf(stackPositionTitle, args.Position)
+	help := fmt.Sprintf(stackPositionHelp, args.Position, args.Root)
+	return dialogcomponents.RadioList(entries, 0, title, help, args.Inputs, args.DisplayDialogs, "stack-position")
+}
@@ -0,0 +1,23 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/spf13/cobra"
+)
+
+const assigneeLong = "assignee"
+
+// type-safe access to the CLI arguments of type gitdomain.ProposalMetadataNames
+func Assignee() (AddFunc, ReadAssigneeFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().StringSlice(assigneeLong, []string{}, "assign the proposal to the given person (can be repeated)")
+	}
+	readFlag := func(cmd *cobra.Command) (gitdomain.ProposalMetadataNames, error) {
+		values, err := cmd.Flags().GetStringSlice(assigneeLong)
+		return gitdomain.NewProposalMetadataNames(values...), err
+	}
+	return addFlag, readFlag
+}
+
+// ReadAssigneeFlagFunc is the type signature for the function that reads the "assignee" flag from the args to the given Cobra command.
+type ReadAssigneeFlagFunc func(*cobra.Command) (gitdomain.ProposalMetadataNames, error)
@@ -0,0 +1,22 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+const batchPushLong = "batch-push"
+
+// type-safe access to the CLI arguments of type configdomain.BatchPush
+func BatchPush() (AddFunc, ReadBatchPushFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().Bool(batchPushLong, false, "push all synced branches together at the end")
+	}
+	readFlag := func(cmd *cobra.Command) (configdomain.BatchPush, error) {
+		return readBoolFlag[configdomain.BatchPush](cmd.Flags(), batchPushLong)
+	}
+	return addFlag, readFlag
+}
+
+// ReadBatchPushFlagFunc is the type signature for the function that reads the "batch-push" flag from the args to the given Cobra command.
+type ReadBatchPushFlagFunc func(*cobra.Command) (configdomain.BatchPush, error)
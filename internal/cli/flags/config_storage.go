@@ -0,0 +1,43 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+const (
+	configFileLong   = "file"
+	configGlobalLong = "global"
+	configLocalLong  = "local"
+)
+
+// ConfigStorage provides type-safe access to the CLI arguments that select where to store a configuration setting.
+func ConfigStorage() (AddFunc, ReadConfigStorageFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().Bool(configFileLong, false, "store the setting in the configuration file")
+		cmd.Flags().Bool(configGlobalLong, false, "store the setting in the global Git metadata")
+		cmd.Flags().Bool(configLocalLong, false, "store the setting in the local Git metadata (default)")
+		cmd.MarkFlagsMutuallyExclusive(configFileLong, configGlobalLong, configLocalLong)
+	}
+	readFlag := func(cmd *cobra.Command) (configdomain.ConfigOrigin, error) {
+		file, err := cmd.Flags().GetBool(configFileLong)
+		if err != nil {
+			return configdomain.ConfigOriginLocal, err
+		}
+		global, err := cmd.Flags().GetBool(configGlobalLong)
+		if err != nil {
+			return configdomain.ConfigOriginLocal, err
+		}
+		switch {
+		case file:
+			return configdomain.ConfigOriginFile, nil
+		case global:
+			return configdomain.ConfigOriginGlobal, nil
+		}
+		return configdomain.ConfigOriginLocal, nil
+	}
+	return addFlag, readFlag
+}
+
+// ReadConfigStorageFlagFunc is the type signature for the function that reads the "file", "global", and "local" flags from the args to the given Cobra command.
+type ReadConfigStorageFlagFunc func(*cobra.Command) (configdomain.ConfigOrigin, error)
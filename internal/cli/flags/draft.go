@@ -0,0 +1,24 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const draftLong = "draft"
+
+// type-safe access to the CLI arguments of type configdomain.ProposeDraft
+func Draft() (AddFunc, ReadDraftFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().Bool(draftLong, false, "create the proposal as a draft")
+		defineNegatedFlag(cmd.Flags(), draftLong, "create the proposal as ready for review")
+	}
+	readFlag := func(cmd *cobra.Command) (Option[configdomain.ProposeDraft], error) {
+		return readNegatableFlag[configdomain.ProposeDraft](cmd.Flags(), draftLong)
+	}
+	return addFlag, readFlag
+}
+
+// ReadDraftFlagFunc is the type signature for the function that reads the "draft" flag from the args to the given Cobra command.
+type ReadDraftFlagFunc func(*cobra.Command) (Option[configdomain.ProposeDraft], error)
@@ -0,0 +1,22 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+const fixLong = "fix"
+
+// type-safe access to the CLI arguments of type configdomain.Fix
+func Fix(description string) (AddFunc, ReadFixFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().Bool(fixLong, false, description)
+	}
+	readFlag := func(cmd *cobra.Command) (configdomain.Fix, error) {
+		return readBoolFlag[configdomain.Fix](cmd.Flags(), fixLong)
+	}
+	return addFlag, readFlag
+}
+
+// ReadFixFlagFunc is the type signature for the function that reads the "fix" flag from the args to the given Cobra command.
+type ReadFixFlagFunc func(*cobra.Command) (configdomain.Fix, error)
@@ -0,0 +1,27 @@
+package flags
+
+import (
+	"cmp"
+
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const formatLong = "format"
+
+// type-safe access to the CLI arguments of type configdomain.OutputFormat
+func Format() (AddFunc, ReadFormatFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().String(formatLong, "", "output format (text or json)")
+	}
+	readFlag := func(cmd *cobra.Command) (Option[configdomain.OutputFormat], error) {
+		text, errFlag := cmd.Flags().GetString(formatLong)
+		format, errParse := configdomain.ParseOutputFormat(text, "--format flag")
+		return format, cmp.Or(errFlag, errParse)
+	}
+	return addFlag, readFlag
+}
+
+// ReadFormatFlagFunc is the type signature for the function that reads the "format" flag from the args to the given Cobra command.
+type ReadFormatFlagFunc func(*cobra.Command) (Option[configdomain.OutputFormat], error)
@@ -0,0 +1,23 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/spf13/cobra"
+)
+
+const labelLong = "label"
+
+// type-safe access to the CLI arguments of type gitdomain.ProposalMetadataNames
+func Label() (AddFunc, ReadLabelFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().StringSlice(labelLong, []string{}, "add the given label to the proposal (can be repeated)")
+	}
+	readFlag := func(cmd *cobra.Command) (gitdomain.ProposalMetadataNames, error) {
+		values, err := cmd.Flags().GetStringSlice(labelLong)
+		return gitdomain.NewProposalMetadataNames(values...), err
+	}
+	return addFlag, readFlag
+}
+
+// ReadLabelFlagFunc is the type signature for the function that reads the "label" flag from the args to the given Cobra command.
+type ReadLabelFlagFunc func(*cobra.Command) (gitdomain.ProposalMetadataNames, error)
@@ -0,0 +1,20 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+// MergeDown provides type-safe access to the CLI arguments of type configdomain.MergeDown.
+func MergeDown() (AddFunc, ReadMergeDownFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().BoolP(downLong, downShort, false, "merge the current branch into its child branch")
+	}
+	readFlag := func(cmd *cobra.Command) (configdomain.MergeDown, error) {
+		return readBoolFlag[configdomain.MergeDown](cmd.Flags(), downLong)
+	}
+	return addFlag, readFlag
+}
+
+// ReadMergeDownFlagFunc is the type signature for the function that reads the "down" flag of "git town merge".
+type ReadMergeDownFlagFunc func(*cobra.Command) (configdomain.MergeDown, error)
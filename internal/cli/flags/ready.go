@@ -0,0 +1,22 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+const readyLong = "ready"
+
+// type-safe access to the CLI arguments of type configdomain.ProposeReady
+func Ready() (AddFunc, ReadReadyFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().Bool(readyLong, false, "mark the existing draft proposal as ready for review")
+	}
+	readFlag := func(cmd *cobra.Command) (configdomain.ProposeReady, error) {
+		return readBoolFlag[configdomain.ProposeReady](cmd.Flags(), readyLong)
+	}
+	return addFlag, readFlag
+}
+
+// ReadReadyFlagFunc is the type signature for the function that reads the "ready" flag from the args to the given Cobra command.
+type ReadReadyFlagFunc func(*cobra.Command) (configdomain.ProposeReady, error)
@@ -0,0 +1,23 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/spf13/cobra"
+)
+
+const reviewerLong = "reviewer"
+
+// type-safe access to the CLI arguments of type gitdomain.ProposalMetadataNames
+func Reviewer() (AddFunc, ReadReviewerFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().StringSlice(reviewerLong, []string{}, "request a review from the given person (can be repeated)")
+	}
+	readFlag := func(cmd *cobra.Command) (gitdomain.ProposalMetadataNames, error) {
+		values, err := cmd.Flags().GetStringSlice(reviewerLong)
+		return gitdomain.NewProposalMetadataNames(values...), err
+	}
+	return addFlag, readFlag
+}
+
+// ReadReviewerFlagFunc is the type signature for the function that reads the "reviewer" flag from the args to the given Cobra command.
+type ReadReviewerFlagFunc func(*cobra.Command) (gitdomain.ProposalMetadataNames, error)
@@ -0,0 +1,22 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+const schemaLong = "schema"
+
+// Schema provides type-safe access to the CLI arguments of type configdomain.Schema.
+func Schema() (AddFunc, ReadSchemaFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().Bool(schemaLong, false, "print the JSON Schema of the configuration file")
+	}
+	readFlag := func(cmd *cobra.Command) (configdomain.Schema, error) {
+		return readBoolFlag[configdomain.Schema](cmd.Flags(), schemaLong)
+	}
+	return addFlag, readFlag
+}
+
+// ReadSchemaFlagFunc is the type signature for the function that reads the "schema" flag from the args to the given Cobra command.
+type ReadSchemaFlagFunc func(*cobra.Command) (configdomain.Schema, error)
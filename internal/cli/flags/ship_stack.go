@@ -0,0 +1,18 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+// ShipStack provides type-safe access to the CLI arguments of type configdomain.FullStack for the ship command.
+// Unlike Stack, it has no shorthand because "ship" uses "-s" for the ship strategy.
+func ShipStack() (AddFunc, ReadStackFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().Bool(stackLong, false, "ship the current branch and all its ancestors")
+	}
+	readFlag := func(cmd *cobra.Command) (configdomain.FullStack, error) {
+		return readBoolFlag[configdomain.FullStack](cmd.Flags(), stackLong)
+	}
+	return addFlag, readFlag
+}
@@ -0,0 +1,22 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+const showOriginLong = "show-origin"
+
+// ShowOrigin provides type-safe access to the CLI arguments of type configdomain.ShowOrigin.
+func ShowOrigin() (AddFunc, ReadShowOriginFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().Bool(showOriginLong, false, "display where the value comes from")
+	}
+	readFlag := func(cmd *cobra.Command) (configdomain.ShowOrigin, error) {
+		return readBoolFlag[configdomain.ShowOrigin](cmd.Flags(), showOriginLong)
+	}
+	return addFlag, readFlag
+}
+
+// ReadShowOriginFlagFunc is the type signature for the function that reads the "show-origin" flag from the args to the given Cobra command.
+type ReadShowOriginFlagFunc func(*cobra.Command) (configdomain.ShowOrigin, error)
@@ -0,0 +1,23 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const stepsLong = "steps"
+
+// type-safe access to the CLI arguments of type configdomain.UndoSteps
+func Steps() (AddFunc, ReadStepsFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().Uint(stepsLong, 1, "number of recent Git Town commands to undo")
+	}
+	readFlag := func(cmd *cobra.Command) (Option[configdomain.UndoSteps], error) {
+		return readUintOptFlag[configdomain.UndoSteps](cmd.Flags(), stepsLong)
+	}
+	return addFlag, readFlag
+}
+
+// ReadStepsFlagFunc is the type signature for the function that reads the "steps" flag from the args to the given Cobra command.
+type ReadStepsFlagFunc func(*cobra.Command) (Option[configdomain.UndoSteps], error)
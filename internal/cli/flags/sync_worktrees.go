@@ -0,0 +1,21 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+const worktreesLong = "worktrees"
+
+// type-safe access to the CLI arguments of type configdomain.SyncWorktrees
+func SyncWorktrees() (AddFunc, ReadSyncWorktreesFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().Bool(worktreesLong, false, "also sync branches checked out in other worktrees")
+	}
+	readFlag := func(cmd *cobra.Command) (configdomain.SyncWorktrees, error) {
+		return readBoolFlag[configdomain.SyncWorktrees](cmd.Flags(), worktreesLong)
+	}
+	return addFlag, readFlag
+}
+
+type ReadSyncWorktreesFlagFunc func(*cobra.Command) (configdomain.SyncWorktrees, error)
@@ -0,0 +1,98 @@
+package format
+
+import (
+	"encoding/json"
+
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// BranchesJSONVersion is the version of the JSON schema that BranchesJSON implements.
+// Increment it only when making changes that aren't backwards compatible.
+const BranchesJSONVersion = 1
+
+// BranchesJSON is the output of "git town branch --format=json".
+// This is a public API that editor plugins and shell prompts rely on.
+// Adding fields is fine. Renaming or removing fields requires incrementing BranchesJSONVersion.
+type BranchesJSON struct {
+	Branches      []BranchJSON                      `json:"branches"`      // all local branches, in the order in which "git town branch" displays them
+	CurrentBranch Option[gitdomain.LocalBranchName] `json:"currentBranch"` // the currently checked out branch, null in a detached HEAD
+	Version       int                               `json:"version"`       // the version of this JSON schema
+}
+
+// BranchJSON describes a single branch in BranchesJSON.
+type BranchJSON struct {
+	Children       gitdomain.LocalBranchNames         `json:"children"`       // the child branches in the lineage
+	LocalSHA       Option[gitdomain.SHA]              `json:"localSHA"`       // the SHA of the local branch
+	Name           gitdomain.LocalBranchName          `json:"name"`           // the name of this branch
+	OtherWorktree  bool                               `json:"otherWorktree"`  // whether this branch is checked out in another worktree
+	Parent         Option[gitdomain.LocalBranchName]  `json:"parent"`         // the parent branch in the lineage, null for perennial branches
+	Proposal       Option[ProposalJSON]               `json:"proposal"`       // the proposal for this branch, null if there is none or Git Town cannot access the forge
+	RemoteSHA      Option[gitdomain.SHA]              `json:"remoteSHA"`      // the SHA of the tracking branch
+	SyncStatus     gitdomain.SyncStatus               `json:"syncStatus"`     // how the local branch relates to its tracking branch
+	TrackingBranch Option[gitdomain.RemoteBranchName] `json:"trackingBranch"` // the name of the tracking branch
+	Type           configdomain.BranchType            `json:"type"`           // the type of this branch
+}
+
+// ProposalJSON describes the proposal of a branch in BranchesJSON.
+type ProposalJSON struct {
+	Number forgedomain.ProposalNumber `json:"number"`
+	URL    string                     `json:"url"`
+}
+
+// BranchesJSONArgs provides the data to render as BranchesJSON.
+type BranchesJSONArgs struct {
+	BranchInfos   gitdomain.BranchInfos
+	Branches      []BranchesJSONEntry // the branches to render, in display order
+	CurrentBranch Option[gitdomain.LocalBranchName]
+	Lineage       configdomain.Lineage
+	Order         configdomain.Order
+	Proposals     map[gitdomain.LocalBranchName]forgedomain.Proposal
+}
+
+// BranchesJSONEntry describes a branch to render in BranchesJSON.
+type BranchesJSONEntry struct {
+	Branch        gitdomain.LocalBranchName
+	OtherWorktree bool
+	Type          configdomain.BranchType
+}
+
+// NewBranchesJSON provides the JSON serialization of the given branches.
+func NewBranchesJSON(args BranchesJSONArgs) ([]byte, error) {
+	result := BranchesJSON{
+		Branches:      make([]BranchJSON, len(args.Branches)),
+		CurrentBranch: args.CurrentBranch,
+		Version:       BranchesJSONVersion,
+	}
+	for e, entry := range args.Branches {
+		branchJSON := BranchJSON{
+			Children:       args.Lineage.Children(entry.Branch, args.Order),
+			LocalSHA:       None[gitdomain.SHA](),
+			Name:           entry.Branch,
+			OtherWorktree:  entry.OtherWorktree,
+			Parent:         args.Lineage.Parent(entry.Branch),
+			Proposal:       None[ProposalJSON](),
+			RemoteSHA:      None[gitdomain.SHA](),
+			SyncStatus:     gitdomain.SyncStatusLocalOnly,
+			TrackingBranch: None[gitdomain.RemoteBranchName](),
+			Type:           entry.Type,
+		}
+		if branchInfo, hasBranchInfo := args.BranchInfos.FindByLocalName(entry.Branch).Get(); hasBranchInfo {
+			branchJSON.LocalSHA = branchInfo.LocalSHA()
+			branchJSON.RemoteSHA = branchInfo.RemoteSHA
+			branchJSON.SyncStatus = branchInfo.SyncStatus
+			branchJSON.TrackingBranch = branchInfo.RemoteName
+		}
+		if proposal, hasProposal := args.Proposals[entry.Branch]; hasProposal {
+			proposalData := proposal.Data.Data()
+			branchJSON.Proposal = Some(ProposalJSON{
+				Number: proposalData.Number,
+				URL:    proposalData.URL,
+			})
+		}
+		result.Branches[e] = branchJSON
+	}
+	return json.MarshalIndent(result, "", "  ")
+}
@@ -0,0 +1,113 @@
+package format_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/cli/format"
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestNewBranchesJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("branches with and without proposals", func(t *testing.T) {
+		t.Parallel()
+		lineage := configdomain.NewLineageWith(configdomain.LineageData{
+			"feature": "main",
+		})
+		have, err := format.NewBranchesJSON(format.BranchesJSONArgs{
+			BranchInfos: gitdomain.BranchInfos{
+				{
+					Local:      Some(gitdomain.BranchData{Name: "main", SHA: "111111"}),
+					RemoteName: Some(gitdomain.NewRemoteBranchName("origin/main")),
+					RemoteSHA:  Some(gitdomain.NewSHA("111111")),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+				},
+				{
+					Local:      Some(gitdomain.BranchData{Name: "feature", SHA: "222222"}),
+					RemoteName: Some(gitdomain.NewRemoteBranchName("origin/feature")),
+					RemoteSHA:  Some(gitdomain.NewSHA("333333")),
+					SyncStatus: gitdomain.SyncStatusNotInSync,
+				},
+			},
+			Branches: []format.BranchesJSONEntry{
+				{Branch: "main", OtherWorktree: false, Type: configdomain.BranchTypeMainBranch},
+				{Branch: "feature", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
+			},
+			CurrentBranch: Some(gitdomain.NewLocalBranchName("feature")),
+			Lineage:       lineage,
+			Order:         configdomain.OrderAsc,
+			Proposals: map[gitdomain.LocalBranchName]forgedomain.Proposal{
+				"feature": {
+					Data: forgedomain.ProposalData{
+						Number: 123,
+						URL:    "https://github.com/git-town/git-town/pull/123",
+					},
+					ForgeType: forgedomain.ForgeTypeGithub,
+				},
+			},
+		})
+		must.NoError(t, err)
+		want := `
+{
+  "branches": [
+    {
+      "children": [
+        "feature"
+      ],
+      "localSHA": "111111",
+      "name": "main",
+      "otherWorktree": false,
+      "parent": null,
+      "proposal": null,
+      "remoteSHA": "111111",
+      "syncStatus": "up to date",
+      "trackingBranch": "origin/main",
+      "type": "main"
+    },
+    {
+      "children": [],
+      "localSHA": "222222",
+      "name": "feature",
+      "otherWorktree": false,
+      "parent": "main",
+      "proposal": {
+        "number": 123,
+        "url": "https://github.com/git-town/git-town/pull/123"
+      },
+      "remoteSHA": "333333",
+      "syncStatus": "not in sync",
+      "trackingBranch": "origin/feature",
+      "type": "feature"
+    }
+  ],
+  "currentBranch": "feature",
+  "version": 1
+}`[1:]
+		must.EqOp(t, want, string(have))
+	})
+
+	t.Run("no branches", func(t *testing.T) {
+		t.Parallel()
+		have, err := format.NewBranchesJSON(format.BranchesJSONArgs{
+			BranchInfos:   gitdomain.BranchInfos{},
+			Branches:      []format.BranchesJSONEntry{},
+			CurrentBranch: None[gitdomain.LocalBranchName](),
+			Lineage:       configdomain.NewLineage(),
+			Order:         configdomain.OrderAsc,
+			Proposals:     map[gitdomain.LocalBranchName]forgedomain.Proposal{},
+		})
+		must.NoError(t, err)
+		want := `
+{
+  "branches": [],
+  "currentBranch": null,
+  "version": 1
+}`[1:]
+		must.EqOp(t, want, string(have))
+	})
+}
@@ -9,7 +9,15 @@ import (
 )
 
 // The Logger logger logs activities of a particular component on the CLI.
-type Logger struct{}
+type Logger struct {
+	silent bool // whether to suppress all output
+}
+
+// SilentLogger provides a Logger that doesn't print anything.
+// Use it when the CLI output must contain only machine-readable data.
+func SilentLogger() Logger {
+	return Logger{silent: true}
+}
 
 func (self Logger) Failed(failure string) {
 	self.Log(colors.BoldRed().Styled(fmt.Sprintf("%v\n", failure)))
@@ -24,6 +32,9 @@ func (self Logger) Finished(err error) {
 }
 
 func (self Logger) Log(text string) {
+	if self.silent {
+		return
+	}
 	fmt.Println(text)
 }
 
@@ -32,6 +43,9 @@ func (self Logger) Ok() {
 }
 
 func (self Logger) Start(template string, data ...any) {
+	if self.silent {
+		return
+	}
 	fmt.Println()
 	if len(data) == 0 {
 		fmt.Print(colors.Bold().Styled(template))
@@ -23,7 +23,6 @@ import (
 	"github.com/git-town/git-town/v22/internal/gohacks/stringslice"
 	"github.com/git-town/git-town/v22/internal/messages"
 	"github.com/git-town/git-town/v22/internal/state/runstate"
-	"github.com/git-town/git-town/v22/internal/subshell/subshelldomain"
 	"github.com/git-town/git-town/v22/internal/validate"
 	"github.com/git-town/git-town/v22/internal/vm/interpreter/fullinterpreter"
 	"github.com/git-town/git-town/v22/internal/vm/opcodes"
@@ -179,7 +178,7 @@ Start:
 	case configdomain.ProgramFlowRestart:
 		goto Start
 	}
-	runProgram := appendProgram(repo.Backend, data, repo.FinalMessages, false)
+	runProgram := appendProgram(repo, data, repo.FinalMessages, false)
 	runState := runstate.RunState{
 		BeginBranchesSnapshot: data.branchesSnapshot,
 		BeginConfigSnapshot:   repo.ConfigSnapshot,
@@ -226,6 +225,7 @@ type appendFeatureData struct {
 	commitsToBeam             gitdomain.Commits
 	config                    config.ValidatedConfig
 	connector                 Option[forgedomain.Connector]
+	draft                     configdomain.ProposeDraft
 	hasOpenChanges            bool
 	initialBranch             gitdomain.LocalBranchName
 	initialBranchInfo         *gitdomain.BranchInfo
@@ -254,6 +254,7 @@ func determineAppendData(args determineAppendDataArgs, repo execute.OpenRepoResu
 	}
 	config := repo.UnvalidatedConfig.NormalConfig
 	connector, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
 		Backend:              repo.Backend,
 		BitbucketAppPassword: config.BitbucketAppPassword,
 		BitbucketUsername:    config.BitbucketUsername,
@@ -377,7 +378,7 @@ func determineAppendData(args determineAppendDataArgs, repo execute.OpenRepoResu
 			return emptyResult, configdomain.ProgramFlowExit, err
 		}
 	}
-	if validatedConfig.NormalConfig.ShareNewBranches == configdomain.ShareNewBranchesPropose {
+	if validatedConfig.NormalConfig.ShareNewBranchesFor(targetBranch) == configdomain.ShareNewBranchesPropose {
 		args.propose = true
 	}
 	return appendFeatureData{
@@ -391,6 +392,7 @@ func determineAppendData(args determineAppendDataArgs, repo execute.OpenRepoResu
 		commitsToBeam:             commitsToBeam,
 		config:                    validatedConfig,
 		connector:                 connector,
+		draft:                     validatedConfig.NormalConfig.ProposeDraft,
 		hasOpenChanges:            repoStatus.OpenChanges,
 		initialBranch:             initialBranch,
 		initialBranchInfo:         initialBranchInfo,
@@ -416,29 +418,33 @@ type determineAppendDataArgs struct {
 	targetBranch  gitdomain.LocalBranchName
 }
 
-func appendProgram(frontend subshelldomain.Runner, data appendFeatureData, finalMessages stringslice.Collector, beamCherryPick bool) program.Program {
+func appendProgram(repo execute.OpenRepoResult, data appendFeatureData, finalMessages stringslice.Collector, beamCherryPick bool) program.Program {
 	prog := NewMutable(&program.Program{})
-	data.config.CleanupLineage(data.branchInfos, data.nonExistingBranches, finalMessages, frontend, data.config.NormalConfig.Order)
+	data.config.CleanupLineage(data.branchInfos, data.nonExistingBranches, finalMessages, repo.Backend, data.config.NormalConfig.Order)
 	if !data.hasOpenChanges && !data.beam.ShouldBeam() && !data.commit.ShouldCommit() && data.config.NormalConfig.AutoSync.ShouldSync() {
 		branchesToDelete := set.New[gitdomain.LocalBranchName]()
 		sync.BranchesProgram(data.branchesToSync, sync.BranchProgramArgs{
-			BranchInfos:         data.branchInfos,
-			BranchInfosPrevious: data.branchInfosLastRun,
-			BranchesToDelete:    NewMutable(&branchesToDelete),
-			Config:              data.config,
-			InitialBranch:       data.initialBranch,
-			PrefetchBranchInfos: data.preFetchBranchInfos,
-			Program:             prog,
-			Prune:               false,
-			Remotes:             data.remotes,
-			PushBranches:        data.config.NormalConfig.PushBranches,
+			BranchInfos:                       data.branchInfos,
+			BranchInfosPrevious:               data.branchInfosLastRun,
+			BranchesChangedSinceMergedAtForge: set.New[gitdomain.LocalBranchName](),
+			BranchesMergedAtForge:             set.New[gitdomain.LocalBranchName](),
+			BranchesToDelete:                  NewMutable(&branchesToDelete),
+			Config:                            data.config,
+			InitialBranch:                     data.initialBranch,
+			LocalBranchOverrides:              repo.UnvalidatedConfig.GitLocal.BranchOverrides,
+			PrefetchBranchInfos:               data.preFetchBranchInfos,
+			Program:                           prog,
+			Prune:                             false,
+			Remotes:                           data.remotes,
+			PushBranches:                      None[configdomain.PushBranches](),
+			Worktrees:                         gitdomain.Worktrees{},
 		})
 	}
 	prog.Value.Add(&opcodes.BranchCreateAndCheckoutExistingParent{
 		Ancestors: data.newBranchParentCandidates,
 		Branch:    data.targetBranch,
 	})
-	if data.remotes.HasRemote(data.config.NormalConfig.DevRemote) && data.config.NormalConfig.ShareNewBranches == configdomain.ShareNewBranchesPush && data.config.NormalConfig.Offline.IsOnline() {
+	if data.remotes.HasRemote(data.config.NormalConfig.DevRemote) && data.config.NormalConfig.ShareNewBranchesFor(data.targetBranch) == configdomain.ShareNewBranchesPush && data.config.NormalConfig.Offline.IsOnline() {
 		prog.Value.Add(&opcodes.BranchTrackingCreate{Branch: data.targetBranch})
 	}
 	prog.Value.Add(&opcodes.LineageParentSetFirstExisting{
@@ -474,10 +480,14 @@ func appendProgram(frontend subshelldomain.Runner, data appendFeatureData, final
 				Branch: data.targetBranch,
 			},
 			&opcodes.ProposalCreate{
+				Assignees:     data.config.NormalConfig.ProposeAssignees,
 				Branch:        data.targetBranch,
+				Draft:         data.draft.ShouldCreateDraft(),
+				Labels:        data.config.NormalConfig.ProposeLabels,
 				MainBranch:    data.config.ValidatedConfigData.MainBranch,
 				ProposalBody:  None[gitdomain.ProposalBody](),
 				ProposalTitle: title,
+				Reviewers:     data.config.NormalConfig.ProposeReviewers,
 			},
 		)
 	}
@@ -11,10 +11,13 @@ import (
 	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogcolors"
 	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogcomponents"
 	"github.com/git-town/git-town/v22/internal/cli/flags"
+	"github.com/git-town/git-town/v22/internal/cli/format"
+	"github.com/git-town/git-town/v22/internal/cli/print"
 	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v22/internal/config/cliconfig"
 	"github.com/git-town/git-town/v22/internal/config/configdomain"
 	"github.com/git-town/git-town/v22/internal/execute"
+	"github.com/git-town/git-town/v22/internal/forge"
 	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
 	"github.com/git-town/git-town/v22/internal/git/gitdomain"
 	"github.com/git-town/git-town/v22/pkg/colors"
@@ -25,11 +28,16 @@ import (
 const (
 	branchDesc = "Display the local branch hierarchy and types"
 	branchHelp = `
-Git Town's equivalent of the "git branch" command.`
+Git Town's equivalent of the "git branch" command.
+
+Use "--format=json" to get machine-readable output
+that includes the type, sync status, SHAs, parent,
+and proposal of each branch.`
 )
 
 func branchCmd() *cobra.Command {
 	addDisplayTypesFlag, readDisplayTypesFlag := flags.Displaytypes()
+	addFormatFlag, readFormatFlag := flags.Format()
 	addOrderFlag, readOrderFlag := flags.Order()
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	cmd := cobra.Command{
@@ -40,9 +48,10 @@ func branchCmd() *cobra.Command {
 		Long:    cmdhelpers.Long(branchDesc, branchHelp),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			displayTypes, errDisplayTypes := readDisplayTypesFlag(cmd)
+			outputFormat, errFormat := readFormatFlag(cmd)
 			order, errOrder := readOrderFlag(cmd)
 			verbose, errVerbose := readVerboseFlag(cmd)
-			if err := cmp.Or(errDisplayTypes, errOrder, errVerbose); err != nil {
+			if err := cmp.Or(errDisplayTypes, errFormat, errOrder, errVerbose); err != nil {
 				return err
 			}
 			cliConfig := cliconfig.New(cliconfig.NewArgs{
@@ -57,22 +66,24 @@ func branchCmd() *cobra.Command {
 				Stash:             None[configdomain.Stash](),
 				Verbose:           verbose,
 			})
-			return executeBranch(cliConfig)
+			return executeBranch(cliConfig, outputFormat.GetOr(configdomain.OutputFormatText))
 		},
 	}
 	addDisplayTypesFlag(&cmd)
+	addFormatFlag(&cmd)
 	addOrderFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeBranch(cliConfig configdomain.PartialConfig) error {
+func executeBranch(cliConfig configdomain.PartialConfig, outputFormat configdomain.OutputFormat) error {
+	isText := outputFormat == configdomain.OutputFormatText
 Start:
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		CliConfig:        cliConfig,
 		IgnoreUnknown:    false,
-		PrintBranchNames: true,
-		PrintCommands:    true,
+		PrintBranchNames: isText,
+		PrintCommands:    isText,
 		ValidateGitRepo:  true,
 		ValidateIsOnline: false,
 	})
@@ -102,7 +113,12 @@ Start:
 		ShowAllBranches:   false,
 		UnknownBranchType: repo.UnvalidatedConfig.NormalConfig.UnknownBranchType,
 	})
-	fmt.Print(branchLayout(entries, data, repo.UnvalidatedConfig.NormalConfig.DisplayTypes))
+	switch outputFormat {
+	case configdomain.OutputFormatJSON:
+		return printBranchJSON(entries, data, repo)
+	case configdomain.OutputFormatText:
+		fmt.Print(branchLayout(entries, data, repo.UnvalidatedConfig.NormalConfig.DisplayTypes))
+	}
 	return nil
 }
 
@@ -190,3 +206,90 @@ func branchLayout(entries dialog.SwitchBranchEntries, data branchData, displayTy
 	}
 	return s.String()
 }
+
+func printBranchJSON(entries dialog.SwitchBranchEntries, data branchData, repo execute.OpenRepoResult) error {
+	config := repo.UnvalidatedConfig.NormalConfig
+	branches := make([]format.BranchesJSONEntry, len(entries))
+	for e, entry := range entries {
+		branches[e] = format.BranchesJSONEntry{
+			Branch:        entry.Branch,
+			OtherWorktree: entry.OtherWorktree,
+			Type:          entry.Type,
+		}
+	}
+	proposals, err := loadBranchProposals(entries, data, repo)
+	if err != nil {
+		return err
+	}
+	content, err := format.NewBranchesJSON(format.BranchesJSONArgs{
+		BranchInfos:   data.branchInfos,
+		Branches:      branches,
+		CurrentBranch: data.initialBranchOpt,
+		Lineage:       config.Lineage,
+		Order:         config.Order,
+		Proposals:     proposals,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(content))
+	return nil
+}
+
+// loadBranchProposals provides the proposals for the given branches,
+// if the forge connector can find proposals.
+func loadBranchProposals(entries dialog.SwitchBranchEntries, data branchData, repo execute.OpenRepoResult) (map[gitdomain.LocalBranchName]forgedomain.Proposal, error) {
+	result := map[gitdomain.LocalBranchName]forgedomain.Proposal{}
+	config := repo.UnvalidatedConfig.NormalConfig
+	if repo.IsOffline {
+		return result, nil
+	}
+	connectorOpt, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
+		Backend:              repo.Backend,
+		BitbucketAppPassword: config.BitbucketAppPassword,
+		BitbucketUsername:    config.BitbucketUsername,
+		Browser:              config.Browser,
+		ConfigDir:            repo.ConfigDir,
+		ForgeType:            config.ForgeType,
+		ForgejoToken:         config.ForgejoToken,
+		Frontend:             repo.Frontend,
+		GiteaToken:           config.GiteaToken,
+		GithubConnectorType:  config.GithubConnectorType,
+		GithubToken:          config.GithubToken,
+		GitlabConnectorType:  config.GitlabConnectorType,
+		GitlabToken:          config.GitlabToken,
+		Log:                  print.SilentLogger(),
+		RemoteURL:            config.DevURL(repo.Backend),
+	})
+	if err != nil {
+		return result, err
+	}
+	connector, hasConnector := connectorOpt.Get()
+	if !hasConnector {
+		return result, nil
+	}
+	proposalFinder, canFindProposals := connector.(forgedomain.ProposalFinder)
+	if !canFindProposals {
+		return result, nil
+	}
+	for _, entry := range entries {
+		parent, hasParent := config.Lineage.Parent(entry.Branch).Get()
+		if !hasParent {
+			continue
+		}
+		branchInfo, hasBranchInfo := data.branchInfos.FindByLocalName(entry.Branch).Get()
+		if !hasBranchInfo || !branchInfo.HasTrackingBranch() {
+			continue
+		}
+		// proposals are optional information, so we don't fail the entire command if the forge cannot provide them
+		proposalOpt, err := proposalFinder.FindProposal(entry.Branch, parent)
+		if err != nil {
+			continue
+		}
+		if proposal, hasProposal := proposalOpt.Get(); hasProposal {
+			result[entry.Branch] = proposal
+		}
+	}
+	return result, nil
+}
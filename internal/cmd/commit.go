@@ -107,7 +107,7 @@ Start:
 	if err != nil {
 		return err
 	}
-	runProgram := commitProgram(data)
+	runProgram := commitProgram(repo, data)
 	runState := runstate.RunState{
 		BeginBranchesSnapshot: data.branchesSnapshot,
 		BeginConfigSnapshot:   repo.ConfigSnapshot,
@@ -181,6 +181,7 @@ func determineCommitData(repo execute.OpenRepoResult, commitMessage Option[gitdo
 	}
 	config := repo.UnvalidatedConfig.NormalConfig
 	connector, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
 		Backend:              repo.Backend,
 		BitbucketAppPassword: config.BitbucketAppPassword,
 		BitbucketUsername:    config.BitbucketUsername,
@@ -296,7 +297,7 @@ func determineCommitData(repo execute.OpenRepoResult, commitMessage Option[gitdo
 	}, configdomain.ProgramFlowContinue, nil
 }
 
-func commitProgram(data commitData) program.Program {
+func commitProgram(repo execute.OpenRepoResult, data commitData) program.Program {
 	prog := NewMutable(&program.Program{})
 	prog.Value.Add(
 		&opcodes.Checkout{Branch: data.branchToCommitInto},
@@ -307,16 +308,20 @@ func commitProgram(data commitData) program.Program {
 		},
 	)
 	sync.BranchesProgram(data.branchesToSync, sync.BranchProgramArgs{
-		BranchInfos:         data.branchInfosToSync,
-		BranchInfosPrevious: data.branchInfosLastRun,
-		BranchesToDelete:    NewMutable(&set.Set[gitdomain.LocalBranchName]{}),
-		Config:              data.config,
-		InitialBranch:       data.initialBranch,
-		PrefetchBranchInfos: data.prefetchBranchesSnapshot.Branches,
-		Program:             prog,
-		Prune:               false,
-		PushBranches:        false,
-		Remotes:             data.remotes,
+		BranchInfos:                       data.branchInfosToSync,
+		BranchInfosPrevious:               data.branchInfosLastRun,
+		BranchesChangedSinceMergedAtForge: set.New[gitdomain.LocalBranchName](),
+		BranchesMergedAtForge:             set.New[gitdomain.LocalBranchName](),
+		BranchesToDelete:                  NewMutable(&set.Set[gitdomain.LocalBranchName]{}),
+		Config:                            data.config,
+		InitialBranch:                     data.initialBranch,
+		LocalBranchOverrides:              repo.UnvalidatedConfig.GitLocal.BranchOverrides,
+		PrefetchBranchInfos:               data.prefetchBranchesSnapshot.Branches,
+		Program:                           prog,
+		Prune:                             false,
+		PushBranches:                      Some(configdomain.PushBranches(false)),
+		Remotes:                           data.remotes,
+		Worktrees:                         gitdomain.Worktrees{},
 	})
 	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
 		DryRun:                   data.config.NormalConfig.DryRun,
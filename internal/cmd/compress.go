@@ -198,6 +198,7 @@ func determineCompressData(repo execute.OpenRepoResult, message Option[gitdomain
 	}
 	config := repo.UnvalidatedConfig.NormalConfig
 	connector, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
 		Backend:              repo.Backend,
 		BitbucketAppPassword: config.BitbucketAppPassword,
 		BitbucketUsername:    config.BitbucketUsername,
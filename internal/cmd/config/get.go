@@ -0,0 +1,95 @@
+package config
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/git-town/git-town/v22/internal/cli/flags"
+	"github.com/git-town/git-town/v22/internal/cli/print"
+	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v22/internal/config/cliconfig"
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/execute"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const (
+	getConfigDesc = "Displays the value of a configuration setting"
+	getConfigHelp = `
+Displays the value that Git Town uses for the given configuration setting.
+You can provide the key with or without the "git-town." prefix,
+for example "sync-feature-strategy" or "git-town.sync-feature-strategy".
+
+With --show-origin, this command also displays
+where this value comes from:
+- cli: a CLI flag
+- env: an environment variable
+- local: the local Git metadata
+- global: the global Git metadata
+- file: the configuration file
+- system: the system Git Town runs on
+- default: the default value`
+)
+
+func getConfigCommand() *cobra.Command {
+	addShowOriginFlag, readShowOriginFlag := flags.ShowOrigin()
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:   "get <key>",
+		Args:  cobra.ExactArgs(1),
+		Short: getConfigDesc,
+		Long:  cmdhelpers.Long(getConfigDesc, getConfigHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			showOrigin, errShowOrigin := readShowOriginFlag(cmd)
+			verbose, errVerbose := readVerboseFlag(cmd)
+			if err := cmp.Or(errShowOrigin, errVerbose); err != nil {
+				return err
+			}
+			cliConfig := cliconfig.New(cliconfig.NewArgs{
+				AutoResolve:       None[configdomain.AutoResolve](),
+				AutoSync:          None[configdomain.AutoSync](),
+				Detached:          None[configdomain.Detached](),
+				DisplayTypes:      None[configdomain.DisplayTypes](),
+				DryRun:            None[configdomain.DryRun](),
+				IgnoreUncommitted: None[configdomain.IgnoreUncommitted](),
+				Order:             None[configdomain.Order](),
+				PushBranches:      None[configdomain.PushBranches](),
+				Stash:             None[configdomain.Stash](),
+				Verbose:           verbose,
+			})
+			return executeGetConfig(args[0], showOrigin, cliConfig)
+		},
+	}
+	addShowOriginFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeGetConfig(name string, showOrigin configdomain.ShowOrigin, cliConfig configdomain.PartialConfig) error {
+	key, err := parseKey(name)
+	if err != nil {
+		return err
+	}
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		CliConfig:        cliConfig,
+		IgnoreUnknown:    true,
+		PrintBranchNames: false,
+		PrintCommands:    false,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+	})
+	if err != nil {
+		return err
+	}
+	valueOpt, origin := repo.UnvalidatedConfig.Lookup(key)
+	if value, hasValue := valueOpt.Get(); hasValue {
+		if showOrigin.ShouldShowOrigin() {
+			fmt.Printf("%s\t%s\n", origin, value)
+		} else {
+			fmt.Println(value)
+		}
+	}
+	print.Footer(repo.UnvalidatedConfig.NormalConfig.Verbose, repo.CommandsCounter.Immutable(), repo.FinalMessages.Result())
+	return nil
+}
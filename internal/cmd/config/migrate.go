@@ -0,0 +1,341 @@
+package config
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/git-town/git-town/v22/internal/cli/dialog"
+	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogcomponents"
+	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogdomain"
+	"github.com/git-town/git-town/v22/internal/cli/flags"
+	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v22/internal/config"
+	"github.com/git-town/git-town/v22/internal/config/cliconfig"
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/config/configfile"
+	"github.com/git-town/git-town/v22/internal/execute"
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/gohacks/mapstools"
+	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/state/runstate"
+	"github.com/git-town/git-town/v22/internal/validate"
+	"github.com/git-town/git-town/v22/internal/vm/interpreter/fullinterpreter"
+	"github.com/git-town/git-town/v22/internal/vm/opcodes"
+	"github.com/git-town/git-town/v22/internal/vm/program"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const (
+	migrateConfigDesc = "Moves the configuration between the Git metadata and the configuration file"
+	migrateConfigHelp = `
+"git town config migrate file" moves the settings
+from the local Git metadata into the configuration file.
+Settings that the configuration file cannot store,
+like the lineage, branch types, and forge credentials,
+remain in the Git metadata.
+
+"git town config migrate git" moves the settings
+from the configuration file into the local Git metadata
+and deletes the configuration file.
+Configuration files that include other configuration files
+cannot be migrated into the Git metadata.
+
+Both directions upgrade deprecated settings.
+You can undo the migration with "git town undo".`
+)
+
+func migrateConfigCommand() *cobra.Command {
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:       "migrate <file|git>",
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: []string{dialog.ConfigStorageOptionFile.Short(), dialog.ConfigStorageOptionGit.Short()},
+		Short:     migrateConfigDesc,
+		Long:      cmdhelpers.Long(migrateConfigDesc, migrateConfigHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			target, err := parseConfigStorageOption(args[0])
+			if err != nil {
+				return err
+			}
+			dryRun, errDryRun := readDryRunFlag(cmd)
+			verbose, errVerbose := readVerboseFlag(cmd)
+			if err := cmp.Or(errDryRun, errVerbose); err != nil {
+				return err
+			}
+			cliConfig := cliconfig.New(cliconfig.NewArgs{
+				AutoResolve:       None[configdomain.AutoResolve](),
+				AutoSync:          None[configdomain.AutoSync](),
+				Detached:          None[configdomain.Detached](),
+				DisplayTypes:      None[configdomain.DisplayTypes](),
+				DryRun:            dryRun,
+				IgnoreUncommitted: None[configdomain.IgnoreUncommitted](),
+				Order:             None[configdomain.Order](),
+				PushBranches:      None[configdomain.PushBranches](),
+				Stash:             None[configdomain.Stash](),
+				Verbose:           verbose,
+			})
+			return executeMigrateConfig(target, cliConfig)
+		},
+	}
+	addDryRunFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeMigrateConfig(target dialog.ConfigStorageOption, cliConfig configdomain.PartialConfig) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		CliConfig:        cliConfig,
+		IgnoreUnknown:    false,
+		PrintBranchNames: false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+	})
+	if err != nil {
+		return err
+	}
+	data, exit, err := determineMigrateConfigData(repo)
+	if err != nil || exit {
+		return err
+	}
+	var runProgram, undoProgram program.Program
+	switch target {
+	case dialog.ConfigStorageOptionFile:
+		runProgram, undoProgram, err = migrateToFileProgram(repo)
+	case dialog.ConfigStorageOptionGit:
+		runProgram, undoProgram, err = migrateToGitProgram(repo)
+	}
+	if err != nil {
+		return err
+	}
+	if runProgram.IsEmpty() {
+		fmt.Println(messages.ConfigMigrateNothing)
+		return nil
+	}
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: data.branchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        data.stashSize,
+		BranchInfosLastRun:    data.branchInfosLastRun,
+		Command:               "config migrate",
+		DryRun:                data.config.NormalConfig.DryRun,
+		EndBranchesSnapshot:   None[gitdomain.BranchesSnapshot](),
+		EndConfigSnapshot:     None[configdomain.EndConfigSnapshot](),
+		EndStashSize:          None[gitdomain.StashSize](),
+		FinalUndoFilesProgram: undoProgram,
+		FinalUndoProgram:      program.Program{},
+		RunProgram:            runProgram,
+		TouchedBranches:       runProgram.TouchedBranches(),
+		UndoAPIProgram:        program.Program{},
+	}
+	return fullinterpreter.Execute(fullinterpreter.ExecuteArgs{
+		Backend:                 repo.Backend,
+		CommandsCounter:         repo.CommandsCounter,
+		Config:                  data.config,
+		ConfigDir:               repo.ConfigDir,
+		Connector:               None[forgedomain.Connector](),
+		DryRun:                  data.config.NormalConfig.DryRun,
+		FinalMessages:           repo.FinalMessages,
+		Frontend:                repo.Frontend,
+		Git:                     repo.Git,
+		HasOpenChanges:          data.hasOpenChanges,
+		InitialBranch:           data.initialBranch,
+		InitialBranchesSnapshot: data.branchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        data.stashSize,
+		Inputs:                  data.inputs,
+		PendingCommand:          None[string](),
+		RunState:                runState,
+	})
+}
+
+type migrateConfigData struct {
+	branchInfosLastRun Option[gitdomain.BranchInfos]
+	branchesSnapshot   gitdomain.BranchesSnapshot
+	config             config.ValidatedConfig
+	hasOpenChanges     bool
+	initialBranch      gitdomain.LocalBranchName
+	inputs             dialogcomponents.Inputs
+	stashSize          gitdomain.StashSize
+}
+
+func determineMigrateConfigData(repo execute.OpenRepoResult) (data migrateConfigData, exit dialogdomain.Exit, err error) {
+	inputs := dialogcomponents.LoadInputs(os.Environ())
+	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
+	if err != nil {
+		return data, false, err
+	}
+	branchesSnapshot, stashSize, branchInfosLastRun, flow, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		Backend:               repo.Backend,
+		CommandsCounter:       repo.CommandsCounter,
+		ConfigSnapshot:        repo.ConfigSnapshot,
+		Connector:             None[forgedomain.Connector](),
+		Fetch:                 false,
+		FinalMessages:         repo.FinalMessages,
+		Frontend:              repo.Frontend,
+		Git:                   repo.Git,
+		HandleUnfinishedState: true,
+		Inputs:                inputs,
+		Repo:                  repo,
+		RepoStatus:            repoStatus,
+		RootDir:               repo.RootDir,
+		UnvalidatedConfig:     repo.UnvalidatedConfig,
+		ValidateNoOpenChanges: false,
+	})
+	if err != nil {
+		return data, false, err
+	}
+	switch flow {
+	case configdomain.ProgramFlowContinue:
+	case configdomain.ProgramFlowExit, configdomain.ProgramFlowRestart:
+		return data, true, nil
+	}
+	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
+	if !hasInitialBranch {
+		return data, false, errors.New(messages.CurrentBranchCannotDetermine)
+	}
+	localBranches := branchesSnapshot.Branches.LocalBranches().NamesLocalBranches()
+	remotes, err := repo.Git.Remotes(repo.Backend)
+	if err != nil {
+		return data, false, err
+	}
+	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
+		Backend:            repo.Backend,
+		BranchInfos:        branchesSnapshot.Branches,
+		BranchesAndTypes:   repo.UnvalidatedConfig.UnvalidatedBranchesAndTypes(localBranches),
+		BranchesToValidate: gitdomain.LocalBranchNames{},
+		ConfigDir:          repo.ConfigDir,
+		ConfigSnapshot:     repo.ConfigSnapshot,
+		Connector:          None[forgedomain.Connector](),
+		Frontend:           repo.Frontend,
+		Git:                repo.Git,
+		Inputs:             inputs,
+		LocalBranches:      localBranches,
+		Remotes:            remotes,
+		RepoStatus:         repoStatus,
+		Unvalidated:        NewMutable(&repo.UnvalidatedConfig),
+	})
+	if err != nil || exit {
+		return data, exit, err
+	}
+	return migrateConfigData{
+		branchInfosLastRun: branchInfosLastRun,
+		branchesSnapshot:   branchesSnapshot,
+		config:             validatedConfig,
+		hasOpenChanges:     repoStatus.OpenChanges,
+		initialBranch:      initialBranch,
+		inputs:             inputs,
+		stashSize:          stashSize,
+	}, false, nil
+}
+
+// migrateToFileProgram provides the program that moves the settings in the local Git metadata into the configuration file,
+// and the program that restores the configuration file when undoing it.
+func migrateToFileProgram(repo execute.OpenRepoResult) (runProgram, undoProgram program.Program, err error) {
+	emptyFile := configfile.RenderTOML(configdomain.EmptyPartialConfig())
+	migrated := configdomain.EmptyPartialConfig()
+	for key, value := range mapstools.SortedKeyValues(repo.ConfigSnapshot.Local) {
+		setting, err := config.NewPartialConfigFromSnapshot(configdomain.SingleSnapshot{key: value}, false, true, repo.Backend)
+		if err != nil {
+			return runProgram, undoProgram, err
+		}
+		// settings that the configuration file cannot store remain in the Git metadata
+		if configfile.RenderTOML(setting) == emptyFile {
+			continue
+		}
+		migrated = migrated.Merge(setting)
+		runProgram.Add(&opcodes.ConfigRemoveFrontend{
+			Key:   key,
+			Scope: configdomain.ConfigScopeLocal,
+		})
+	}
+	if runProgram.IsEmpty() {
+		return runProgram, undoProgram, nil
+	}
+	fileConfig := repo.UnvalidatedConfig.File.Merge(migrated)
+	fileConfig.PerennialBranches = repo.UnvalidatedConfig.File.PerennialBranches.AppendAllMissing(migrated.PerennialBranches)
+	filePath, hasFile := configfile.FindFile(repo.RootDir.String()).Get()
+	mode := os.FileMode(0o600)
+	if hasFile {
+		restoreFile, err := restoreConfigFileOpcode(filePath)
+		if err != nil {
+			return runProgram, undoProgram, err
+		}
+		mode = restoreFile.Mode
+		undoProgram.Add(restoreFile)
+	} else {
+		filePath = filepath.Join(repo.RootDir.String(), configfile.FileName)
+		undoProgram.Add(&opcodes.ConfigFileRemove{FilePath: filePath})
+	}
+	runProgram.Prepend(&opcodes.ConfigFileWrite{
+		Content:  configfile.RenderFile(fileConfig, filePath),
+		FilePath: filePath,
+		Mode:     mode,
+	})
+	repo.FinalMessages.Addf(messages.ConfigMigratedToFile, filepath.Base(filePath))
+	return runProgram, undoProgram, nil
+}
+
+// migrateToGitProgram provides the program that moves the settings in the configuration file into the local Git metadata,
+// and the program that restores the configuration file when undoing it.
+func migrateToGitProgram(repo execute.OpenRepoResult) (runProgram, undoProgram program.Program, err error) {
+	filePath, hasFile := configfile.FindFile(repo.RootDir.String()).Get()
+	if !hasFile {
+		return runProgram, undoProgram, errors.New(messages.ConfigMigrateNoFile)
+	}
+	// the Git metadata cannot express includes, and the included files are often shared with other repositories
+	if len(configfile.Includes(filePath)) > 0 {
+		return runProgram, undoProgram, fmt.Errorf(messages.ConfigMigrateIncludes, filepath.Base(filePath))
+	}
+	restoreFile, err := restoreConfigFileOpcode(filePath)
+	if err != nil {
+		return runProgram, undoProgram, err
+	}
+	for key, value := range mapstools.SortedKeyValues(repo.UnvalidatedConfig.File.Snapshot()) {
+		if existing, hasExisting := repo.ConfigSnapshot.Local[key]; hasExisting && existing == value {
+			continue
+		}
+		runProgram.Add(&opcodes.ConfigSetFrontend{
+			Key:   key,
+			Scope: configdomain.ConfigScopeLocal,
+			Value: value,
+		})
+	}
+	runProgram.Add(&opcodes.ConfigFileRemove{FilePath: filePath})
+	undoProgram.Add(restoreFile)
+	repo.FinalMessages.Addf(messages.ConfigMigratedToGit, filepath.Base(filePath))
+	return runProgram, undoProgram, nil
+}
+
+// parseConfigStorageOption provides the ConfigStorageOption with the given short name.
+func parseConfigStorageOption(text string) (dialog.ConfigStorageOption, error) {
+	for _, option := range []dialog.ConfigStorageOption{dialog.ConfigStorageOptionFile, dialog.ConfigStorageOptionGit} {
+		if option.Short() == text {
+			return option, nil
+		}
+	}
+	return dialog.ConfigStorageOptionGit, fmt.Errorf(messages.ConfigMigrateTargetUnknown, text)
+}
+
+// restoreConfigFileOpcode provides the opcode that restores the current content and permissions of the given configuration file.
+func restoreConfigFileOpcode(filePath string) (*opcodes.ConfigFileWrite, error) {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return nil, fmt.Errorf(messages.ConfigFileCannotRead, filePath, err)
+	}
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf(messages.ConfigFileCannotRead, filePath, err)
+	}
+	return &opcodes.ConfigFileWrite{
+		Content:  string(content),
+		FilePath: filePath,
+		Mode:     info.Mode().Perm(),
+	}, nil
+}
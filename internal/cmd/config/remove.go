@@ -64,10 +64,10 @@ func executeRemoveConfig(cliConfig configdomain.PartialConfig) error {
 	if err = gitconfig.RemoveLocalGitConfiguration(repo.Backend, repo.ConfigSnapshot.Local); err != nil {
 		return err
 	}
-	aliasNames := slices.Collect(maps.Keys(repo.UnvalidatedConfig.NormalConfig.Aliases))
+	aliasNames := slices.Collect(maps.Keys(repo.UnvalidatedConfig.GitGlobal.Aliases))
 	slice.NaturalSort(aliasNames)
 	for _, aliasName := range aliasNames {
-		if strings.HasPrefix(repo.UnvalidatedConfig.NormalConfig.Aliases[aliasName], "town ") {
+		if strings.HasPrefix(repo.UnvalidatedConfig.GitGlobal.Aliases[aliasName], "town ") {
 			if err = gitconfig.RemoveAlias(repo.Frontend, aliasName); err != nil {
 				return err
 			}
@@ -4,6 +4,7 @@ package config
 import (
 	"cmp"
 	"fmt"
+	"strings"
 
 	"github.com/git-town/git-town/v22/internal/cli/flags"
 	"github.com/git-town/git-town/v22/internal/cli/format"
@@ -54,8 +55,12 @@ func RootCmd() *cobra.Command {
 	addDisplayTypesFlag(&configCmd)
 	addVerboseFlag(&configCmd)
 	addRedactFlag(&configCmd)
+	configCmd.AddCommand(getConfigCommand())
 	configCmd.AddCommand(getParentCommand())
+	configCmd.AddCommand(migrateConfigCommand())
 	configCmd.AddCommand(removeConfigCommand())
+	configCmd.AddCommand(setConfigCommand())
+	configCmd.AddCommand(validateConfigCommand())
 	return &configCmd
 }
 
@@ -102,12 +107,14 @@ func printConfig(config config.UnvalidatedConfig, redact configdomain.Redact) {
 	print.Entry("new branch type", format.OptionalStringerSetting(config.NormalConfig.NewBranchType))
 	print.Entry("share new branches", config.NormalConfig.ShareNewBranches.String())
 	print.Entry("stash uncommitted changes", format.Bool(config.NormalConfig.Stash.ShouldStash()))
+	print.Entry("worktree root", format.OptionalStringerSetting(config.NormalConfig.WorktreeRoot))
 	fmt.Println()
 	print.Header("Hosting")
 	print.Entry("browser", format.OptionalStringerSetting(config.NormalConfig.Browser))
 	print.Entry("development remote", config.NormalConfig.DevRemote.String())
 	print.Entry("forge type", format.OptionalStringerSetting(config.NormalConfig.ForgeType))
 	print.Entry("origin hostname", format.OptionalStringerSetting(config.NormalConfig.HostingOriginHostname))
+	print.Entry("Azure DevOps token", formatToken(config.NormalConfig.AzuredevopsToken, redact))
 	print.Entry("Bitbucket username", format.OptionalStringerSetting(config.NormalConfig.BitbucketUsername))
 	print.Entry("Bitbucket app password", formatToken(config.NormalConfig.BitbucketAppPassword, redact))
 	print.Entry("Forgejo token", formatToken(config.NormalConfig.ForgejoToken, redact))
@@ -120,8 +127,14 @@ func printConfig(config config.UnvalidatedConfig, redact configdomain.Redact) {
 	print.Header("Propose")
 	print.Entry("breadcrumb", format.StringsSetting(config.NormalConfig.ProposalBreadcrumb.String()))
 	print.Entry("breadcrumb direction", format.StringsSetting(config.NormalConfig.ProposalBreadcrumbDirection.String()))
+	print.Entry("create drafts", format.Bool(config.NormalConfig.ProposeDraft.ShouldCreateDraft()))
+	print.Entry("assignees", format.StringsSetting(config.NormalConfig.ProposeAssignees.Join(", ")))
+	print.Entry("labels", format.StringsSetting(config.NormalConfig.ProposeLabels.Join(", ")))
+	print.Entry("reviewers", format.StringsSetting(config.NormalConfig.ProposeReviewers.Join(", ")))
+	print.Entry("template", format.OptionalStringerSetting(config.NormalConfig.ProposeTemplate))
 	fmt.Println()
 	print.Header("Ship")
+	print.Entry("api merge method", config.NormalConfig.ShipAPIMergeMethod.String())
 	print.Entry("delete tracking branch", format.Bool(config.NormalConfig.ShipDeleteTrackingBranch.ShouldDeleteTrackingBranch()))
 	print.Entry("ignore uncommitted changes", format.Bool(config.NormalConfig.IgnoreUncommitted.AllowUncommitted()))
 	print.Entry("ship strategy", config.NormalConfig.ShipStrategy.String())
@@ -142,6 +155,35 @@ func printConfig(config config.UnvalidatedConfig, redact configdomain.Redact) {
 	if config.NormalConfig.Lineage.Len() > 0 {
 		print.LabelAndValue("Branch Lineage", format.BranchLineage(config.NormalConfig.Lineage, config.NormalConfig.Order))
 	}
+	print.LabelAndValue("Configuration sources (later sources override earlier ones)", configSources(config.Files))
+}
+
+// configSources provides the sources of the configuration in the order in which Git Town merges them.
+func configSources(files configdomain.ConfigFiles) string {
+	sources := []string{"default values"}
+	for _, file := range files {
+		sources = append(sources, configFileLabel(file.Kind)+": "+file.Path)
+	}
+	sources = append(sources, "Git metadata (global, then local)", "environment variables", "CLI flags")
+	result := strings.Builder{}
+	for s, source := range sources {
+		result.WriteString(fmt.Sprintf("%d. %s\n", s+1, source))
+	}
+	return strings.TrimSuffix(result.String(), "\n")
+}
+
+func configFileLabel(kind configdomain.ConfigFileKind) string {
+	switch kind {
+	case configdomain.ConfigFileKindIncluded:
+		return "included config file"
+	case configdomain.ConfigFileKindRepo:
+		return "config file"
+	case configdomain.ConfigFileKindSubfolder:
+		return "subfolder config file"
+	case configdomain.ConfigFileKindUser:
+		return "user config file"
+	}
+	return kind.String()
 }
 
 // formatToken returns a formatted token value. If redact is true and the token is set, it returns "(configured)".
@@ -0,0 +1,126 @@
+package config
+
+import (
+	"cmp"
+	"fmt"
+	"path/filepath"
+
+	"github.com/git-town/git-town/v22/internal/cli/flags"
+	"github.com/git-town/git-town/v22/internal/cli/print"
+	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v22/internal/config"
+	"github.com/git-town/git-town/v22/internal/config/cliconfig"
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/config/configfile"
+	"github.com/git-town/git-town/v22/internal/config/gitconfig"
+	"github.com/git-town/git-town/v22/internal/execute"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/subshell/subshelldomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const (
+	setConfigDesc = "Changes a configuration setting"
+	setConfigHelp = `
+Stores the given value for the given configuration setting.
+You can provide the key with or without the "git-town." prefix,
+for example "sync-feature-strategy" or "git-town.sync-feature-strategy".
+
+By default, this command stores the setting in the local Git metadata.
+Use --global to store it in the global Git metadata
+or --file to store it in the configuration file.`
+)
+
+func setConfigCommand() *cobra.Command {
+	addConfigStorageFlag, readConfigStorageFlag := flags.ConfigStorage()
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:   "set <key> <value>",
+		Args:  cobra.ExactArgs(2),
+		Short: setConfigDesc,
+		Long:  cmdhelpers.Long(setConfigDesc, setConfigHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			storage, errStorage := readConfigStorageFlag(cmd)
+			verbose, errVerbose := readVerboseFlag(cmd)
+			if err := cmp.Or(errStorage, errVerbose); err != nil {
+				return err
+			}
+			cliConfig := cliconfig.New(cliconfig.NewArgs{
+				AutoResolve:       None[configdomain.AutoResolve](),
+				AutoSync:          None[configdomain.AutoSync](),
+				Detached:          None[configdomain.Detached](),
+				DisplayTypes:      None[configdomain.DisplayTypes](),
+				DryRun:            None[configdomain.DryRun](),
+				IgnoreUncommitted: None[configdomain.IgnoreUncommitted](),
+				Order:             None[configdomain.Order](),
+				PushBranches:      None[configdomain.PushBranches](),
+				Stash:             None[configdomain.Stash](),
+				Verbose:           verbose,
+			})
+			return executeSetConfig(args[0], args[1], storage, cliConfig)
+		},
+	}
+	addConfigStorageFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeSetConfig(name, value string, storage configdomain.ConfigOrigin, cliConfig configdomain.PartialConfig) error {
+	key, err := parseKey(name)
+	if err != nil {
+		return err
+	}
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		CliConfig:        cliConfig,
+		IgnoreUnknown:    true,
+		PrintBranchNames: false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+	})
+	if err != nil {
+		return err
+	}
+	// parsing the new value as Git metadata validates it the same way Git Town validates existing settings
+	setting, err := config.NewPartialConfigFromSnapshot(configdomain.SingleSnapshot{key: value}, false, false, repo.Frontend)
+	if err != nil {
+		return err
+	}
+	if err = storeSetting(key, value, setting, storage, repo.RootDir, repo.Frontend); err != nil {
+		return err
+	}
+	print.Footer(repo.UnvalidatedConfig.NormalConfig.Verbose, repo.CommandsCounter.Immutable(), repo.FinalMessages.Result())
+	return nil
+}
+
+// parseKey provides the configuration key with the given name.
+// The name can omit the "git-town." prefix.
+func parseKey(name string) (configdomain.Key, error) {
+	key, hasKey := configdomain.ParseKey(name).Or(configdomain.ParseKey("git-town." + name)).Get()
+	if !hasKey {
+		return key, fmt.Errorf(messages.ConfigKeyUnknown, name)
+	}
+	if key.IsDeprecated() {
+		return key, fmt.Errorf(messages.ConfigKeyDeprecated, key)
+	}
+	return key, nil
+}
+
+func storeSetting(key configdomain.Key, value string, setting configdomain.PartialConfig, storage configdomain.ConfigOrigin, rootDir gitdomain.RepoRootDir, runner subshelldomain.Runner) error {
+	switch storage {
+	case configdomain.ConfigOriginGlobal:
+		return gitconfig.SetConfigValue(runner, configdomain.ConfigScopeGlobal, key, value)
+	case configdomain.ConfigOriginLocal:
+		return gitconfig.SetConfigValue(runner, configdomain.ConfigScopeLocal, key, value)
+	case configdomain.ConfigOriginFile:
+		if configfile.RenderTOML(setting) == configfile.RenderTOML(configdomain.EmptyPartialConfig()) {
+			return fmt.Errorf(messages.ConfigFileUnsupportedKey, key)
+		}
+		path := configfile.FindFile(rootDir.String()).GetOr(filepath.Join(rootDir.String(), configfile.FileName))
+		return configfile.SaveSetting(setting, path)
+	case configdomain.ConfigOriginCLI, configdomain.ConfigOriginDefault, configdomain.ConfigOriginEnv, configdomain.ConfigOriginSystem:
+	}
+	panic("unhandled config storage: " + storage.String())
+}
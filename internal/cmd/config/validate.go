@@ -0,0 +1,149 @@
+package config
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/git-town/git-town/v22/internal/cli"
+	"github.com/git-town/git-town/v22/internal/cli/flags"
+	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/config/configfile"
+	"github.com/git-town/git-town/v22/internal/git"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/gohacks"
+	"github.com/git-town/git-town/v22/internal/gohacks/cache"
+	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/subshell"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const (
+	validateConfigDesc = "Verifies the configuration file"
+	validateConfigHelp = `
+Checks the given configuration file,
+or the configuration file in the root directory of the current repository,
+for unknown keys, deprecated keys, and invalid values.
+Configuration files other than the user-wide one
+must not contain forge credentials.
+
+With --schema, this command prints the JSON Schema
+of the configuration file format instead.
+Editors use it to autocomplete and validate configuration files.`
+)
+
+func validateConfigCommand() *cobra.Command {
+	addSchemaFlag, readSchemaFlag := flags.Schema()
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:   "validate [<file>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: validateConfigDesc,
+		Long:  cmdhelpers.Long(validateConfigDesc, validateConfigHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			schema, errSchema := readSchemaFlag(cmd)
+			verbose, errVerbose := readVerboseFlag(cmd)
+			if err := cmp.Or(errSchema, errVerbose); err != nil {
+				return err
+			}
+			if schema.ShouldPrintSchema() {
+				return printSchema()
+			}
+			path := None[string]()
+			if len(args) > 0 {
+				path = Some(args[0])
+			}
+			return executeValidateConfig(path, verbose.GetOr(false))
+		},
+	}
+	addSchemaFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeValidateConfig(pathOpt Option[string], verbose configdomain.Verbose) error {
+	path, hasPath := pathOpt.Get()
+	if !hasPath {
+		var err error
+		path, err = repoConfigFile(verbose)
+		if err != nil {
+			return err
+		}
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf(messages.ConfigFileCannotRead, path, err)
+	}
+	userLevel, err := isUserConfigFile(path)
+	if err != nil {
+		return err
+	}
+	problems, err := configfile.Check(string(content), userLevel)
+	if err != nil {
+		return fmt.Errorf(messages.ConfigFileInvalidContent, path, err)
+	}
+	if len(problems) > 0 {
+		for _, problem := range problems {
+			fmt.Println(problem)
+		}
+		return fmt.Errorf(messages.ConfigFileProblems, path)
+	}
+	fmt.Printf(messages.ConfigFileValid+"\n", path)
+	return nil
+}
+
+// isUserConfigFile indicates whether the given path points to the user-wide configuration file.
+func isUserConfigFile(path string) (bool, error) {
+	userConfigDir, err := cli.SystemUserConfigDir()
+	if err != nil {
+		return false, err
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return false, err
+	}
+	return absPath == configfile.UserFilePath(userConfigDir), nil
+}
+
+func printSchema() error {
+	schema, err := configfile.JSONSchema()
+	if err != nil {
+		return err
+	}
+	fmt.Println(schema)
+	return nil
+}
+
+// repoConfigFile provides the path of the configuration file in the root directory of the current repository,
+// relative to the current working directory.
+func repoConfigFile(verbose configdomain.Verbose) (string, error) {
+	backendRunner := subshell.BackendRunner{
+		Dir:             None[string](),
+		CommandsCounter: NewMutable(new(gohacks.Counter)),
+		Verbose:         verbose,
+	}
+	gitCommands := git.Commands{
+		CurrentBranchCache: &cache.WithPrevious[gitdomain.LocalBranchName]{},
+		RemotesCache:       &cache.Cache[gitdomain.Remotes]{},
+	}
+	rootDir, hasRootDir := gitCommands.RootDirectory(backendRunner).Get()
+	if !hasRootDir {
+		return "", errors.New(messages.RepoOutside)
+	}
+	path, hasPath := configfile.FindFile(rootDir.String()).Get()
+	if !hasPath {
+		return "", errors.New(messages.ConfigFileMissing)
+	}
+	workingDir, err := os.Getwd()
+	if err != nil {
+		return "", errors.New(messages.DirCurrentProblem)
+	}
+	if relPath, err := filepath.Rel(workingDir, path); err == nil {
+		return relPath, nil
+	}
+	return path, nil
+}
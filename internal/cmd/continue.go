@@ -118,6 +118,7 @@ func determineContinueData(repo execute.OpenRepoResult) (continueData, configdom
 	}
 	config := repo.UnvalidatedConfig.NormalConfig
 	connector, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
 		Backend:              repo.Backend,
 		BitbucketAppPassword: config.BitbucketAppPassword,
 		BitbucketUsername:    config.BitbucketUsername,
@@ -12,6 +12,7 @@ import (
 	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v22/internal/cmd/ship"
 	"github.com/git-town/git-town/v22/internal/cmd/sync"
+	"github.com/git-town/git-town/v22/internal/cmd/worktree"
 	"github.com/git-town/git-town/v22/internal/config"
 	"github.com/git-town/git-town/v22/internal/config/cliconfig"
 	"github.com/git-town/git-town/v22/internal/config/configdomain"
@@ -142,6 +143,9 @@ Start:
 		TouchedBranches:       deletePrograms.runProgram.TouchedBranches(),
 		UndoAPIProgram:        program.Program{},
 	}
+	if worktreeToRemove, hasWorktreeToRemove := data.worktreeToRemove.Get(); hasWorktreeToRemove {
+		runState.Worktrees = gitdomain.Worktrees{worktreeToRemove}
+	}
 	return fullinterpreter.Execute(fullinterpreter.ExecuteArgs{
 		Backend:                 repo.Backend,
 		CommandsCounter:         repo.CommandsCounter,
@@ -179,6 +183,7 @@ type deleteData struct {
 	previousBranch           Option[gitdomain.LocalBranchName]
 	proposalsOfChildBranches []forgedomain.Proposal
 	stashSize                gitdomain.StashSize
+	worktreeToRemove         Option[gitdomain.Worktree] // the worktree that Git Town manages for the branch to delete
 }
 
 func determineDeleteData(args []string, repo execute.OpenRepoResult) (deleteData, configdomain.ProgramFlow, error) {
@@ -190,6 +195,7 @@ func determineDeleteData(args []string, repo execute.OpenRepoResult) (deleteData
 	}
 	config := repo.UnvalidatedConfig.NormalConfig
 	connector, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
 		Backend:              repo.Backend,
 		BitbucketAppPassword: config.BitbucketAppPassword,
 		BitbucketUsername:    config.BitbucketUsername,
@@ -245,12 +251,21 @@ func determineDeleteData(args []string, repo execute.OpenRepoResult) (deleteData
 	} else {
 		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.DeleteNoActiveBranch)
 	}
-	branchToDeleteInfo, hasBranchToDeleteInfo := branchesSnapshot.Branches.FindByLocalName(branchToDelete).Get()
+	branchToDeleteInfoPtr, hasBranchToDeleteInfo := branchesSnapshot.Branches.FindByLocalName(branchToDelete).Get()
 	if !hasBranchToDeleteInfo {
 		return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.BranchDoesntExist, branchToDelete)
 	}
+	branchToDeleteInfo := *branchToDeleteInfoPtr
+	worktreeToRemove := None[gitdomain.Worktree]()
 	if branchToDeleteInfo.SyncStatus == gitdomain.SyncStatusOtherWorktree {
-		return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.BranchOtherWorktree, branchToDelete)
+		worktreeToRemove, err = worktree.RemovableWorktree(repo, branchToDelete)
+		if err != nil {
+			return emptyResult, configdomain.ProgramFlowExit, err
+		}
+		if worktreeToRemove.IsNone() {
+			return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.BranchOtherWorktree, branchToDelete)
+		}
+		branchToDeleteInfo.SyncStatus = worktree.SyncStatus(branchToDeleteInfo)
 	}
 	localBranches := branchesSnapshot.Branches.LocalBranches().NamesLocalBranches()
 	branchesAndTypes := repo.UnvalidatedConfig.UnvalidatedBranchesAndTypes(branchesSnapshot.Branches.LocalBranches().NamesLocalBranches())
@@ -303,7 +318,7 @@ func determineDeleteData(args []string, repo execute.OpenRepoResult) (deleteData
 	oldClan := validatedConfig.NormalConfig.Lineage.Clan(gitdomain.LocalBranchNames{branchToDelete}, validatedConfig.MainAndPerennials())
 	return deleteData{
 		branchInfosLastRun:       branchInfosLastRun,
-		branchToDeleteInfo:       *branchToDeleteInfo,
+		branchToDeleteInfo:       branchToDeleteInfo,
 		branchToDeleteType:       branchTypeToDelete,
 		branchWhenDone:           branchWhenDone,
 		branchesSnapshot:         branchesSnapshot,
@@ -317,6 +332,7 @@ func determineDeleteData(args []string, repo execute.OpenRepoResult) (deleteData
 		previousBranch:           previousBranchOpt,
 		proposalsOfChildBranches: proposalsOfChildBranches,
 		stashSize:                stashSize,
+		worktreeToRemove:         worktreeToRemove,
 	}, configdomain.ProgramFlowContinue, nil
 }
 
@@ -345,6 +361,7 @@ func deleteProgram(repo execute.OpenRepoResult, data deleteData, finalMessages s
 			Branch: localBranchNameToDelete,
 		})
 	}
+	programs.RemoveBranchOverridesProgram(prog, repo.UnvalidatedConfig.GitLocal.BranchOverrides, localBranchNameToDelete)
 	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
 		DryRun:                   data.config.NormalConfig.DryRun,
 		InitialStashSize:         data.stashSize,
@@ -399,25 +416,29 @@ func deleteLocalBranch(prog, finalUndoProgram Mutable[program.Program], data del
 			}
 		}
 		// delete the commits of this branch from all descendents
-		if data.config.NormalConfig.SyncFeatureStrategy == configdomain.SyncFeatureStrategyRebase {
-			descendents := data.config.NormalConfig.Lineage.Descendants(localBranchToDelete, data.config.NormalConfig.Order)
-			for _, descendent := range descendents {
-				if branchInfo, hasBranchInfo := data.branchesSnapshot.Branches.FindByLocalName(descendent).Get(); hasBranchInfo {
-					parent := data.config.NormalConfig.Lineage.Parent(descendent).GetOr(data.config.ValidatedConfigData.MainBranch)
-					if parent == localBranchToDelete {
-						parent = data.config.NormalConfig.Lineage.Parent(parent).GetOr(data.config.ValidatedConfigData.MainBranch)
-					}
-					sync.RemoveAncestorCommits(sync.RemoveAncestorCommitsArgs{
-						Ancestor:          localBranchToDelete.BranchName(),
-						Branch:            descendent,
-						HasTrackingBranch: branchInfo.HasTrackingBranch(),
-						Program:           prog,
-						RebaseOnto:        parent,
-					})
+		descendents := data.config.NormalConfig.Lineage.Descendants(localBranchToDelete, data.config.NormalConfig.Order)
+		for _, descendent := range descendents {
+			if data.config.NormalConfig.SyncFeatureStrategyFor(descendent) != configdomain.SyncFeatureStrategyRebase {
+				continue
+			}
+			if branchInfo, hasBranchInfo := data.branchesSnapshot.Branches.FindByLocalName(descendent).Get(); hasBranchInfo {
+				parent := data.config.NormalConfig.Lineage.Parent(descendent).GetOr(data.config.ValidatedConfigData.MainBranch)
+				if parent == localBranchToDelete {
+					parent = data.config.NormalConfig.Lineage.Parent(parent).GetOr(data.config.ValidatedConfigData.MainBranch)
 				}
+				sync.RemoveAncestorCommits(sync.RemoveAncestorCommitsArgs{
+					Ancestor:          localBranchToDelete.BranchName(),
+					Branch:            descendent,
+					HasTrackingBranch: branchInfo.HasTrackingBranch(),
+					Program:           prog,
+					RebaseOnto:        parent,
+				})
 			}
 		}
 		prog.Value.Add(&opcodes.CheckoutIfNeeded{Branch: data.branchWhenDone})
+		if worktreeToRemove, hasWorktreeToRemove := data.worktreeToRemove.Get(); hasWorktreeToRemove {
+			prog.Value.Add(&opcodes.WorktreeRemove{Path: worktreeToRemove.Path})
+		}
 		prog.Value.Add(&opcodes.BranchLocalDelete{
 			Branch: localBranchToDelete,
 		})
@@ -4,9 +4,11 @@ package cmd
 import (
 	"github.com/git-town/git-town/v22/internal/cmd/config"
 	"github.com/git-town/git-town/v22/internal/cmd/ship"
+	"github.com/git-town/git-town/v22/internal/cmd/stack"
 	"github.com/git-town/git-town/v22/internal/cmd/status"
 	"github.com/git-town/git-town/v22/internal/cmd/swap"
 	"github.com/git-town/git-town/v22/internal/cmd/sync"
+	"github.com/git-town/git-town/v22/internal/cmd/worktree"
 )
 
 // Execute runs the Cobra stack.
@@ -26,6 +28,7 @@ func Execute() error {
 	rootCmd.AddCommand(downCmd())
 	rootCmd.AddCommand(featureCmd())
 	rootCmd.AddCommand(hackCmd())
+	rootCmd.AddCommand(historyCommand())
 	rootCmd.AddCommand(initCommand())
 	rootCmd.AddCommand(mergeCommand())
 	rootCmd.AddCommand(observeCmd())
@@ -35,17 +38,22 @@ func Execute() error {
 	rootCmd.AddCommand(proposeCommand())
 	rootCmd.AddCommand(prototypeCmd())
 	rootCmd.AddCommand(renameCommand())
+	rootCmd.AddCommand(reorderCommand())
 	rootCmd.AddCommand(repoCommand())
+	rootCmd.AddCommand(restackCommand())
 	rootCmd.AddCommand(runLogCommand())
 	rootCmd.AddCommand(status.RootCommand())
 	rootCmd.AddCommand(setParentCommand())
 	rootCmd.AddCommand(ship.Cmd())
 	rootCmd.AddCommand(skipCmd())
+	rootCmd.AddCommand(splitCommand())
+	rootCmd.AddCommand(stack.RootCommand())
 	rootCmd.AddCommand(swap.Cmd())
 	rootCmd.AddCommand(switchCmd())
 	rootCmd.AddCommand(sync.Cmd())
 	rootCmd.AddCommand(undoCmd())
 	rootCmd.AddCommand(upCmd())
 	rootCmd.AddCommand(walkCommand())
+	rootCmd.AddCommand(worktree.RootCommand())
 	return rootCmd.Execute()
 }
@@ -63,6 +63,7 @@ func hackCmd() *cobra.Command {
 	addCommitFlag, readCommitFlag := flags.Commit()
 	addCommitMessageFlag, readCommitMessageFlag := flags.CommitMessage("the commit message")
 	addDetachedFlag, readDetachedFlag := flags.Detached()
+	addDraftFlag, readDraftFlag := flags.Draft()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
 	addProposeFlag, readProposeFlag := flags.Propose()
 	addPrototypeFlag, readPrototypeFlag := flags.Prototype()
@@ -81,13 +82,14 @@ func hackCmd() *cobra.Command {
 			commit, errCommit := readCommitFlag(cmd)
 			commitMessage, errCommitMessage := readCommitMessageFlag(cmd)
 			detached, errDetached := readDetachedFlag(cmd)
+			draft, errDraft := readDraftFlag(cmd)
 			dryRun, errDryRun := readDryRunFlag(cmd)
 			propose, errPropose := readProposeFlag(cmd)
 			prototype, errPrototype := readPrototypeFlag(cmd)
 			stash, errStash := readStashFlag(cmd)
 			sync, errSync := readSyncFlag(cmd)
 			verbose, errVerbose := readVerboseFlag(cmd)
-			if err := cmp.Or(errAutoResolve, errBeam, errCommit, errCommitMessage, errDetached, errDryRun, errPropose, errPrototype, errStash, errSync, errVerbose); err != nil {
+			if err := cmp.Or(errAutoResolve, errBeam, errCommit, errCommitMessage, errDetached, errDraft, errDryRun, errPropose, errPrototype, errStash, errSync, errVerbose); err != nil {
 				return err
 			}
 			if commitMessage.IsSome() || propose.ShouldPropose() {
@@ -111,6 +113,7 @@ func hackCmd() *cobra.Command {
 				cliConfig:     cliConfig,
 				commit:        commit,
 				commitMessage: commitMessage,
+				draft:         draft,
 				propose:       propose,
 				prototype:     prototype,
 			})
@@ -121,6 +124,7 @@ func hackCmd() *cobra.Command {
 	addCommitFlag(&cmd)
 	addCommitMessageFlag(&cmd)
 	addDetachedFlag(&cmd)
+	addDraftFlag(&cmd)
 	addDryRunFlag(&cmd)
 	addProposeFlag(&cmd)
 	addPrototypeFlag(&cmd)
@@ -136,6 +140,7 @@ type hackArgs struct {
 	cliConfig     configdomain.PartialConfig
 	commit        configdomain.Commit
 	commitMessage Option[gitdomain.CommitMessage]
+	draft         Option[configdomain.ProposeDraft]
 	propose       configdomain.Propose
 	prototype     configdomain.Prototype
 }
@@ -164,7 +169,7 @@ Start:
 	case configdomain.ProgramFlowRestart:
 		goto Start
 	}
-	runProgram := appendProgram(repo.Backend, data, repo.FinalMessages, true)
+	runProgram := appendProgram(repo, data, repo.FinalMessages, true)
 	runState := runstate.RunState{
 		BeginBranchesSnapshot: data.branchesSnapshot,
 		BeginConfigSnapshot:   repo.ConfigSnapshot,
@@ -216,6 +221,7 @@ func determineHackData(args hackArgs, repo execute.OpenRepoResult) (appendFeatur
 	}
 	config := repo.UnvalidatedConfig.NormalConfig
 	connector, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
 		Backend:              repo.Backend,
 		BitbucketAppPassword: config.BitbucketAppPassword,
 		BitbucketUsername:    config.BitbucketUsername,
@@ -390,7 +396,7 @@ func determineHackData(args hackArgs, repo execute.OpenRepoResult) (appendFeatur
 			return emptyResult, configdomain.ProgramFlowExit, err
 		}
 	}
-	if validatedConfig.NormalConfig.ShareNewBranches == configdomain.ShareNewBranchesPropose {
+	if validatedConfig.NormalConfig.ShareNewBranchesFor(targetBranch) == configdomain.ShareNewBranchesPropose {
 		args.propose = true
 	}
 	data := appendFeatureData{
@@ -404,6 +410,7 @@ func determineHackData(args hackArgs, repo execute.OpenRepoResult) (appendFeatur
 		commitsToBeam:             commitsToBeam,
 		config:                    validatedConfig,
 		connector:                 connector,
+		draft:                     args.draft.GetOr(validatedConfig.NormalConfig.ProposeDraft),
 		hasOpenChanges:            repoStatus.OpenChanges,
 		initialBranch:             initialBranch,
 		initialBranchInfo:         initialBranchInfo,
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v22/internal/cli/flags"
+	"github.com/git-town/git-town/v22/internal/cli/print"
+	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v22/internal/config/cliconfig"
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/execute"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/gohacks/slice"
+	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/state/runhistory"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const (
+	historyDesc = "Displays the most recent Git Town commands that can be undone"
+	historyHelp = `
+Git Town remembers the %d most recent Git Town commands.
+This command lists them, most recent first,
+together with the time they finished and the branches they touched.
+
+To undo the N most recent commands, run "git town undo --steps N".`
+)
+
+func historyCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "history",
+		Args:    cobra.NoArgs,
+		GroupID: cmdhelpers.GroupIDErrors,
+		Short:   historyDesc,
+		Long:    cmdhelpers.Long(historyDesc, fmt.Sprintf(historyHelp, runhistory.MaxEntries)),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			verbose, err := readVerboseFlag(cmd)
+			if err != nil {
+				return err
+			}
+			cliConfig := cliconfig.New(cliconfig.NewArgs{
+				AutoResolve:       None[configdomain.AutoResolve](),
+				AutoSync:          None[configdomain.AutoSync](),
+				Detached:          None[configdomain.Detached](),
+				DisplayTypes:      None[configdomain.DisplayTypes](),
+				DryRun:            None[configdomain.DryRun](),
+				IgnoreUncommitted: None[configdomain.IgnoreUncommitted](),
+				Order:             None[configdomain.Order](),
+				PushBranches:      None[configdomain.PushBranches](),
+				Stash:             None[configdomain.Stash](),
+				Verbose:           verbose,
+			})
+			return executeHistory(cliConfig)
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeHistory(cliConfig configdomain.PartialConfig) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		CliConfig:        cliConfig,
+		IgnoreUnknown:    true,
+		PrintBranchNames: true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+	})
+	if err != nil {
+		return err
+	}
+	history, err := runhistory.Load(runhistory.NewRunhistoryPath(repo.ConfigDir))
+	if err != nil {
+		return fmt.Errorf(messages.RunhistoryLoadProblem, err)
+	}
+	showHistory(history)
+	print.Footer(repo.UnvalidatedConfig.NormalConfig.Verbose, repo.CommandsCounter.Immutable(), []string{})
+	return nil
+}
+
+func showHistory(history runhistory.History) {
+	if len(history) == 0 {
+		fmt.Println(messages.RunhistoryEmpty)
+		return
+	}
+	fmt.Print(messages.RunhistoryDisplaying)
+	for e, entry := range history.Newest(len(history)) {
+		touchedBranches := slice.AppendAllMissing(gitdomain.LocalBranchNames{}, entry.RunState.TouchedBranches.LocalBranchNames()...)
+		slice.NaturalSort(touchedBranches)
+		fmt.Printf(messages.RunhistoryEntry, e+1, entry.Time.Format("2006-01-02 15:04:05"), entry.RunState.Command, touchedBranches.Join(", "))
+	}
+}
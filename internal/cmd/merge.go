@@ -62,12 +62,19 @@ main
 *   branch-2
      \
       branch-4
+
+With the --down flag, "git town merge" merges the current branch
+into its only child branch instead.
+In the stack above, running "git town merge --down" on "branch-3"
+removes "branch-3" and makes "branch-4" a child of "branch-2".
+The "branch-4" branch keeps the changes from the old "branch-3" branch.
 `
 )
 
 func mergeCommand() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addDownFlag, readDownFlag := flags.MergeDown()
 	cmd := cobra.Command{
 		Use:     mergeCmd,
 		Args:    cobra.NoArgs,
@@ -75,9 +82,10 @@ func mergeCommand() *cobra.Command {
 		Short:   mergeDesc,
 		Long:    cmdhelpers.Long(mergeDesc, mergeHelp),
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			down, errDown := readDownFlag(cmd)
 			dryRun, errDryRun := readDryRunFlag(cmd)
 			verbose, errVerbose := readVerboseFlag(cmd)
-			if err := cmp.Or(errDryRun, errVerbose); err != nil {
+			if err := cmp.Or(errDown, errDryRun, errVerbose); err != nil {
 				return err
 			}
 			cliConfig := cliconfig.New(cliconfig.NewArgs{
@@ -92,15 +100,16 @@ func mergeCommand() *cobra.Command {
 				Stash:             None[configdomain.Stash](),
 				Verbose:           verbose,
 			})
-			return executeMerge(cliConfig)
+			return executeMerge(cliConfig, down)
 		},
 	}
+	addDownFlag(&cmd)
 	addDryRunFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeMerge(cliConfig configdomain.PartialConfig) error {
+func executeMerge(cliConfig configdomain.PartialConfig, down configdomain.MergeDown) error {
 Start:
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		CliConfig:        cliConfig,
@@ -113,7 +122,7 @@ Start:
 	if err != nil {
 		return err
 	}
-	data, flow, err := determineMergeData(repo)
+	data, flow, err := determineMergeData(repo, down)
 	if err != nil {
 		return err
 	}
@@ -124,10 +133,18 @@ Start:
 	case configdomain.ProgramFlowRestart:
 		goto Start
 	}
-	if err = validateMergeData(repo, data); err != nil {
-		return err
+	var runProgram program.Program
+	if down.Enabled() {
+		if err = validateMergeDownData(repo, data); err != nil {
+			return err
+		}
+		runProgram = mergeDownProgram(repo, data)
+	} else {
+		if err = validateMergeData(repo, data); err != nil {
+			return err
+		}
+		runProgram = mergeProgram(repo, data)
 	}
-	runProgram := mergeProgram(repo, data)
 	runState := runstate.RunState{
 		BeginBranchesSnapshot: data.branchesSnapshot,
 		BeginConfigSnapshot:   repo.ConfigSnapshot,
@@ -166,6 +183,8 @@ Start:
 type mergeData struct {
 	branchInfosLastRun       Option[gitdomain.BranchInfos]
 	branchesSnapshot         gitdomain.BranchesSnapshot
+	childBranch              Option[gitdomain.LocalBranchName] // the child branch to merge into when merging down
+	childBranchInfo          Option[gitdomain.BranchInfo]
 	childBranches            gitdomain.LocalBranchNames
 	config                   config.ValidatedConfig
 	connector                Option[forgedomain.Connector]
@@ -184,7 +203,7 @@ type mergeData struct {
 	stashSize                gitdomain.StashSize
 }
 
-func determineMergeData(repo execute.OpenRepoResult) (mergeData, configdomain.ProgramFlow, error) {
+func determineMergeData(repo execute.OpenRepoResult, down configdomain.MergeDown) (mergeData, configdomain.ProgramFlow, error) {
 	inputs := dialogcomponents.LoadInputs(os.Environ())
 	var emptyResult mergeData
 	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
@@ -193,6 +212,7 @@ func determineMergeData(repo execute.OpenRepoResult) (mergeData, configdomain.Pr
 	}
 	config := repo.UnvalidatedConfig.NormalConfig
 	connector, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
 		Backend:              repo.Backend,
 		BitbucketAppPassword: config.BitbucketAppPassword,
 		BitbucketUsername:    config.BitbucketUsername,
@@ -274,7 +294,7 @@ func determineMergeData(repo execute.OpenRepoResult) (mergeData, configdomain.Pr
 		return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.MergeNoParent, initialBranch)
 	}
 	grandParentBranch := validatedConfig.NormalConfig.Lineage.Parent(parentBranch)
-	if grandParentBranch.IsNone() {
+	if grandParentBranch.IsNone() && !down.Enabled() {
 		return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.MergeNoGrandParent, initialBranch, parentBranch)
 	}
 	previousBranch := repo.Git.PreviouslyCheckedOutBranch(repo.Backend)
@@ -298,6 +318,24 @@ func determineMergeData(repo execute.OpenRepoResult) (mergeData, configdomain.Pr
 	parentBranchType := validatedConfig.BranchType(parentBranch)
 
 	childBranches := validatedConfig.NormalConfig.Lineage.Children(initialBranch, validatedConfig.NormalConfig.Order)
+	childBranch := None[gitdomain.LocalBranchName]()
+	childBranchInfo := None[gitdomain.BranchInfo]()
+	if down.Enabled() {
+		switch len(childBranches) {
+		case 0:
+			return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.MergeDownNoChild, initialBranch)
+		case 1:
+		default:
+			return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.MergeDownMultipleChildren, initialBranch)
+		}
+		child := childBranches[0]
+		info, hasInfo := branchesSnapshot.Branches.FindByLocalName(child).Get()
+		if !hasInfo {
+			return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.MergeBranchNotLocal, child)
+		}
+		childBranch = Some(child)
+		childBranchInfo = Some(*info)
+	}
 	proposalsOfChildBranches := ship.LoadProposalsOfChildBranches(ship.LoadProposalsOfChildBranchesArgs{
 		ConnectorOpt:               connector,
 		Lineage:                    validatedConfig.NormalConfig.Lineage,
@@ -309,6 +347,8 @@ func determineMergeData(repo execute.OpenRepoResult) (mergeData, configdomain.Pr
 	return mergeData{
 		branchInfosLastRun:       branchInfosLastRun,
 		branchesSnapshot:         branchesSnapshot,
+		childBranch:              childBranch,
+		childBranchInfo:          childBranchInfo,
 		childBranches:            childBranches,
 		config:                   validatedConfig,
 		connector:                connector,
@@ -368,6 +408,54 @@ func mergeProgram(repo execute.OpenRepoResult, data mergeData) program.Program {
 			Branch: data.initialBranch,
 		})
 	}
+	programs.RemoveBranchOverridesProgram(prog, repo.UnvalidatedConfig.GitLocal.BranchOverrides, data.initialBranch)
+	previousBranchCandidates := []Option[gitdomain.LocalBranchName]{data.previousBranch}
+	updateBreadcrumb := data.config.NormalConfig.ProposalBreadcrumb.Enabled()
+	isOnline := data.config.NormalConfig.Offline.IsOnline()
+	if updateBreadcrumb && isOnline {
+		programs.UpdateBreadcrumbsProgram(programs.UpdateBreadcrumbsArgs{
+			Config:          data.config,
+			Program:         prog,
+			TouchedBranches: gitdomain.LocalBranchNames{data.initialBranch},
+		})
+	}
+	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
+		DryRun:                   data.config.NormalConfig.DryRun,
+		InitialStashSize:         data.stashSize,
+		RunInGitRoot:             true,
+		StashOpenChanges:         data.hasOpenChanges,
+		PreviousBranchCandidates: previousBranchCandidates,
+	})
+	return optimizer.Optimize(prog.Immutable())
+}
+
+// mergeDownProgram merges the initial branch into its only child branch.
+// The child branch already contains the commits of the initial branch,
+// so it only needs to take over the position of the initial branch in the lineage.
+func mergeDownProgram(repo execute.OpenRepoResult, data mergeData) program.Program {
+	prog := NewMutable(&program.Program{})
+	childBranch := data.childBranch.GetOrPanic()
+	ship.UpdateChildBranchProposalsToGrandParent(prog.Value, data.proposalsOfChildBranches)
+	prog.Value.Add(
+		&opcodes.Checkout{Branch: childBranch},
+		&opcodes.LineageParentSetToGrandParent{Branch: childBranch},
+		&opcodes.LineageParentRemove{Branch: data.initialBranch},
+	)
+	initialTrackingBranch, initialHasTrackingBranch := data.initialBranchInfo.RemoteName.Get()
+	if initialHasTrackingBranch && repo.IsOffline.IsOnline() {
+		prog.Value.Add(&opcodes.BranchTrackingDelete{
+			Branch: initialTrackingBranch,
+		})
+	}
+	prog.Value.Add(&opcodes.BranchLocalDelete{
+		Branch: data.initialBranch,
+	})
+	if _, hasOverride := data.config.NormalConfig.BranchTypeOverrides[data.initialBranch]; hasOverride {
+		prog.Value.Add(&opcodes.BranchTypeOverrideRemove{
+			Branch: data.initialBranch,
+		})
+	}
+	programs.RemoveBranchOverridesProgram(prog, repo.UnvalidatedConfig.GitLocal.BranchOverrides, data.initialBranch)
 	previousBranchCandidates := []Option[gitdomain.LocalBranchName]{data.previousBranch}
 	updateBreadcrumb := data.config.NormalConfig.ProposalBreadcrumb.Enabled()
 	isOnline := data.config.NormalConfig.Offline.IsOnline()
@@ -441,3 +529,35 @@ func verifyBranchType(branchType configdomain.BranchType) error {
 	}
 	return nil
 }
+
+func validateMergeDownData(repo execute.OpenRepoResult, data mergeData) error {
+	childBranch := data.childBranch.GetOrPanic()
+	childBranchInfo := data.childBranchInfo.GetOrPanic()
+	if err := verifyBranchType(data.initialBranchType); err != nil {
+		return err
+	}
+	if err := verifyBranchType(data.config.BranchType(childBranch)); err != nil {
+		return err
+	}
+	// ensure all commits on the initial branch are contained in the child branch
+	inSyncWithChild, err := repo.Git.BranchInSyncWithParent(repo.Backend, childBranch, data.initialBranch.BranchName())
+	if err != nil {
+		return err
+	}
+	if !inSyncWithChild {
+		return fmt.Errorf(messages.BranchNotInSyncWithParent, childBranch)
+	}
+	for _, branchInfo := range []gitdomain.BranchInfo{data.initialBranchInfo, childBranchInfo} {
+		branch := branchInfo.LocalName().GetOrPanic()
+		switch branchInfo.SyncStatus {
+		case gitdomain.SyncStatusUpToDate, gitdomain.SyncStatusLocalOnly:
+		case gitdomain.SyncStatusAhead, gitdomain.SyncStatusBehind, gitdomain.SyncStatusNotInSync, gitdomain.SyncStatusDeletedAtRemote:
+			return fmt.Errorf(messages.MergeNotInSyncWithTracking, branch)
+		case gitdomain.SyncStatusOtherWorktree:
+			return fmt.Errorf(messages.BranchOtherWorktree, branch)
+		case gitdomain.SyncStatusRemoteOnly:
+			// safe to ignore, this cannot happen
+		}
+	}
+	return nil
+}
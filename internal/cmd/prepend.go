@@ -267,6 +267,7 @@ func determinePrependData(args prependArgs, repo execute.OpenRepoResult) (prepen
 	}
 	config := repo.UnvalidatedConfig.NormalConfig
 	connector, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
 		Backend:              repo.Backend,
 		BitbucketAppPassword: config.BitbucketAppPassword,
 		BitbucketUsername:    config.BitbucketUsername,
@@ -400,7 +401,7 @@ func determinePrependData(args prependArgs, repo execute.OpenRepoResult) (prepen
 		proposalOpt = ship.FindProposal(connector, initialBranch, Some(ancestor))
 	}
 	propose := args.propose
-	if validatedConfig.NormalConfig.ShareNewBranches == configdomain.ShareNewBranchesPropose {
+	if validatedConfig.NormalConfig.ShareNewBranchesFor(targetBranch) == configdomain.ShareNewBranchesPropose {
 		propose = true
 	}
 	return prependData{
@@ -440,16 +441,20 @@ func prependProgram(repo execute.OpenRepoResult, data prependData, finalMessages
 		data.config.CleanupLineage(data.branchInfos, data.nonExistingBranches, finalMessages, repo.Backend, data.config.NormalConfig.Order)
 		branchesToDelete := set.New[gitdomain.LocalBranchName]()
 		sync.BranchesProgram(data.branchesToSync, sync.BranchProgramArgs{
-			BranchInfos:         data.branchInfos,
-			BranchInfosPrevious: data.branchInfosLastRun,
-			BranchesToDelete:    NewMutable(&branchesToDelete),
-			Config:              data.config,
-			InitialBranch:       data.initialBranch,
-			PrefetchBranchInfos: data.preFetchBranchInfos,
-			Program:             prog,
-			Prune:               false,
-			PushBranches:        data.config.NormalConfig.PushBranches,
-			Remotes:             data.remotes,
+			BranchInfos:                       data.branchInfos,
+			BranchInfosPrevious:               data.branchInfosLastRun,
+			BranchesChangedSinceMergedAtForge: set.New[gitdomain.LocalBranchName](),
+			BranchesMergedAtForge:             set.New[gitdomain.LocalBranchName](),
+			BranchesToDelete:                  NewMutable(&branchesToDelete),
+			Config:                            data.config,
+			InitialBranch:                     data.initialBranch,
+			LocalBranchOverrides:              repo.UnvalidatedConfig.GitLocal.BranchOverrides,
+			PrefetchBranchInfos:               data.preFetchBranchInfos,
+			Program:                           prog,
+			Prune:                             false,
+			PushBranches:                      None[configdomain.PushBranches](),
+			Remotes:                           data.remotes,
+			Worktrees:                         gitdomain.Worktrees{},
 		})
 	}
 	prog.Value.Add(&opcodes.BranchCreateAndCheckoutExistingParent{
@@ -472,7 +477,7 @@ func prependProgram(repo execute.OpenRepoResult, data prependData, finalMessages
 		prog.Value.Add(&opcodes.BranchTypeOverrideSet{Branch: data.targetBranch, BranchType: newBranchType.BranchType()})
 	}
 	proposal, hasProposal := data.proposal.Get()
-	if data.remotes.HasRemote(data.config.NormalConfig.DevRemote) && data.config.NormalConfig.Offline.IsOnline() && (data.config.NormalConfig.ShareNewBranches == configdomain.ShareNewBranchesPush || hasProposal) {
+	if data.remotes.HasRemote(data.config.NormalConfig.DevRemote) && data.config.NormalConfig.Offline.IsOnline() && (data.config.NormalConfig.ShareNewBranchesFor(data.targetBranch) == configdomain.ShareNewBranchesPush || hasProposal) {
 		prog.Value.Add(&opcodes.BranchTrackingCreate{Branch: data.targetBranch})
 	}
 	connector, hasConnector := data.connector.Get()
@@ -500,10 +505,14 @@ func prependProgram(repo execute.OpenRepoResult, data prependData, finalMessages
 				Branch: data.targetBranch,
 			},
 			&opcodes.ProposalCreate{
+				Assignees:     data.config.NormalConfig.ProposeAssignees,
 				Branch:        data.targetBranch,
+				Draft:         data.config.NormalConfig.ProposeDraft.ShouldCreateDraft(),
+				Labels:        data.config.NormalConfig.ProposeLabels,
 				MainBranch:    data.config.ValidatedConfigData.MainBranch,
 				ProposalBody:  data.proposalBody,
 				ProposalTitle: data.proposalTitle,
+				Reviewers:     data.config.NormalConfig.ProposeReviewers,
 			})
 	}
 	if data.commit {
@@ -533,7 +542,7 @@ func prependProgram(repo execute.OpenRepoResult, data prependData, finalMessages
 }
 
 // provides the strategy to use to sync a branch after beaming some of its commits to its new parent branch
-func afterBeamToParentSyncStrategy(branchType configdomain.BranchType, config config.NormalConfig) Option[configdomain.SyncStrategy] {
+func afterBeamToParentSyncStrategy(branch gitdomain.LocalBranchName, branchType configdomain.BranchType, config config.NormalConfig) Option[configdomain.SyncStrategy] {
 	switch branchType {
 	case
 		configdomain.BranchTypeContributionBranch,
@@ -544,7 +553,7 @@ func afterBeamToParentSyncStrategy(branchType configdomain.BranchType, config co
 	case
 		configdomain.BranchTypeFeatureBranch,
 		configdomain.BranchTypeParkedBranch:
-		return Some(config.SyncFeatureStrategy.SyncStrategy())
+		return Some(config.SyncFeatureStrategyFor(branch).SyncStrategy())
 	case configdomain.BranchTypePrototypeBranch:
 		return Some(config.SyncPrototypeStrategy.SyncStrategy())
 	}
@@ -587,7 +596,7 @@ func moveCommitsToPrependedBranch(prog Mutable[program.Program], data prependDat
 	}
 	// sync the initial branch with the new parent branch to remove the moved commits from the initial branch
 	initialBranchType := data.config.BranchType(data.initialBranch)
-	syncWithParent(prog, data.targetBranch, data.initialBranchInfo, initialBranchType, data.config.NormalConfig)
+	syncWithParent(prog, data.targetBranch, data.initialBranch, data.initialBranchInfo, initialBranchType, data.config.NormalConfig)
 	// go back to the target branch
 	prog.Value.Add(
 		&opcodes.Checkout{Branch: data.targetBranch},
@@ -595,8 +604,8 @@ func moveCommitsToPrependedBranch(prog Mutable[program.Program], data prependDat
 }
 
 // basic sync of the current branch with its parent after beaming some commits into the parent
-func syncWithParent(prog Mutable[program.Program], parentName gitdomain.LocalBranchName, initialBranchInfo gitdomain.BranchInfo, initialBranchType configdomain.BranchType, config config.NormalConfig) {
-	if syncStrategy, hasSyncStrategy := afterBeamToParentSyncStrategy(initialBranchType, config).Get(); hasSyncStrategy {
+func syncWithParent(prog Mutable[program.Program], parentName, initialBranch gitdomain.LocalBranchName, initialBranchInfo gitdomain.BranchInfo, initialBranchType configdomain.BranchType, config config.NormalConfig) {
+	if syncStrategy, hasSyncStrategy := afterBeamToParentSyncStrategy(initialBranch, initialBranchType, config).Get(); hasSyncStrategy {
 		switch syncStrategy {
 		case configdomain.SyncStrategyCompress, configdomain.SyncStrategyMerge:
 			prog.Value.Add(
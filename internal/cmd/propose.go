@@ -55,10 +55,15 @@ where hostname matches what is in your ssh config file.`
 )
 
 func proposeCommand() *cobra.Command {
+	addAssigneeFlag, readAssigneeFlag := flags.Assignee()
 	addAutoResolveFlag, readAutoResolveFlag := flags.AutoResolve()
 	addBodyFlag, readBodyFlag := flags.ProposalBody("b")
 	addBodyFileFlag, readBodyFileFlag := flags.ProposalBodyFile()
+	addDraftFlag, readDraftFlag := flags.Draft()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addLabelFlag, readLabelFlag := flags.Label()
+	addReadyFlag, readReadyFlag := flags.Ready()
+	addReviewerFlag, readReviewerFlag := flags.Reviewer()
 	addStackFlag, readStackFlag := flags.Stack("propose the entire stack")
 	addTitleFlag, readTitleFlag := flags.ProposalTitle()
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
@@ -69,14 +74,19 @@ func proposeCommand() *cobra.Command {
 		Short:   proposeDesc,
 		Long:    cmdhelpers.Long(proposeDesc, fmt.Sprintf(proposeHelp, configdomain.KeyForgeType, configdomain.KeyHostingOriginHostname)),
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			assignees, errAssignees := readAssigneeFlag(cmd)
 			autoResolve, errAutoResolve := readAutoResolveFlag(cmd)
 			bodyFile, errBodyFile := readBodyFileFlag(cmd)
 			bodyText, errBodyText := readBodyFlag(cmd)
+			draft, errDraft := readDraftFlag(cmd)
 			dryRun, errDryRun := readDryRunFlag(cmd)
+			labels, errLabels := readLabelFlag(cmd)
+			ready, errReady := readReadyFlag(cmd)
+			reviewers, errReviewers := readReviewerFlag(cmd)
 			stack, errStack := readStackFlag(cmd)
 			title, errTitle := readTitleFlag(cmd)
 			verbose, errVerbose := readVerboseFlag(cmd)
-			if err := cmp.Or(errBodyFile, errBodyText, errDryRun, errAutoResolve, errStack, errTitle, errVerbose); err != nil {
+			if err := cmp.Or(errAssignees, errBodyFile, errBodyText, errDraft, errDryRun, errAutoResolve, errLabels, errReady, errReviewers, errStack, errTitle, errVerbose); err != nil {
 				return err
 			}
 			cliConfig := cliconfig.New(cliconfig.NewArgs{
@@ -92,28 +102,44 @@ func proposeCommand() *cobra.Command {
 				Verbose:           verbose,
 			})
 			return executePropose(proposeArgs{
+				assignees: assignees,
 				body:      bodyText,
 				bodyFile:  bodyFile,
 				cliConfig: cliConfig,
+				draft:     draft,
+				labels:    labels,
+				ready:     ready,
+				reviewers: reviewers,
 				stack:     stack,
 				title:     title,
 			})
 		},
 	}
+	addAssigneeFlag(&cmd)
 	addBodyFlag(&cmd)
 	addBodyFileFlag(&cmd)
+	addDraftFlag(&cmd)
 	addDryRunFlag(&cmd)
 	addAutoResolveFlag(&cmd)
+	addLabelFlag(&cmd)
+	addReadyFlag(&cmd)
+	addReviewerFlag(&cmd)
 	addStackFlag(&cmd)
 	addTitleFlag(&cmd)
 	addVerboseFlag(&cmd)
+	cmd.MarkFlagsMutuallyExclusive("draft", "ready")
 	return &cmd
 }
 
 type proposeArgs struct {
+	assignees gitdomain.ProposalMetadataNames
 	body      Option[gitdomain.ProposalBody]
 	bodyFile  Option[gitdomain.ProposalBodyFile]
 	cliConfig configdomain.PartialConfig
+	draft     Option[configdomain.ProposeDraft]
+	labels    gitdomain.ProposalMetadataNames
+	ready     configdomain.ProposeReady
+	reviewers gitdomain.ProposalMetadataNames
 	stack     configdomain.FullStack
 	title     Option[gitdomain.ProposalTitle]
 }
@@ -179,6 +205,7 @@ Start:
 }
 
 type proposeData struct {
+	assignees           gitdomain.ProposalMetadataNames
 	branchInfos         gitdomain.BranchInfos
 	branchInfosLastRun  Option[gitdomain.BranchInfos]
 	branchesSnapshot    gitdomain.BranchesSnapshot
@@ -186,15 +213,20 @@ type proposeData struct {
 	branchesToSync      configdomain.BranchesToSync
 	config              config.ValidatedConfig
 	connector           Option[forgedomain.Connector]
+	draft               configdomain.ProposeDraft
 	hasOpenChanges      bool
 	initialBranch       gitdomain.LocalBranchName
 	inputs              dialogcomponents.Inputs
+	labels              gitdomain.ProposalMetadataNames
 	nonExistingBranches gitdomain.LocalBranchNames // branches that are listed in the lineage information, but don't exist in the repo, neither locally nor remotely
 	preFetchBranchInfos gitdomain.BranchInfos
 	previousBranch      Option[gitdomain.LocalBranchName]
 	proposalBody        Option[gitdomain.ProposalBody]
 	proposalTitle       Option[gitdomain.ProposalTitle]
+	ready               configdomain.ProposeReady
 	remotes             gitdomain.Remotes
+	reviewers           gitdomain.ProposalMetadataNames
+	stack               configdomain.FullStack
 	stashSize           gitdomain.StashSize
 }
 
@@ -224,6 +256,7 @@ func determineProposeData(repo execute.OpenRepoResult, args proposeArgs) (propos
 	}
 	config := repo.UnvalidatedConfig.NormalConfig
 	connectorOpt, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
 		Backend:              repo.Backend,
 		BitbucketAppPassword: config.BitbucketAppPassword,
 		BitbucketUsername:    config.BitbucketUsername,
@@ -330,7 +363,21 @@ func determineProposeData(repo execute.OpenRepoResult, args proposeArgs) (propos
 		return emptyResult, configdomain.ProgramFlowExit, err
 	}
 	bodyText, err := ship.ReadFile(args.body, args.bodyFile)
+	// the CLI flags replace the defaults in the config file
+	assignees := args.assignees
+	if len(assignees) == 0 {
+		assignees = validatedConfig.NormalConfig.ProposeAssignees
+	}
+	labels := args.labels
+	if len(labels) == 0 {
+		labels = validatedConfig.NormalConfig.ProposeLabels
+	}
+	reviewers := args.reviewers
+	if len(reviewers) == 0 {
+		reviewers = validatedConfig.NormalConfig.ProposeReviewers
+	}
 	return proposeData{
+		assignees:           assignees,
 		branchInfos:         branchesSnapshot.Branches,
 		branchInfosLastRun:  branchInfosLastRun,
 		branchesSnapshot:    branchesSnapshot,
@@ -338,35 +385,56 @@ func determineProposeData(repo execute.OpenRepoResult, args proposeArgs) (propos
 		branchesToSync:      branchesToSync,
 		config:              validatedConfig,
 		connector:           connectorOpt,
+		draft:               args.draft.GetOr(validatedConfig.NormalConfig.ProposeDraft),
 		hasOpenChanges:      repoStatus.OpenChanges,
 		initialBranch:       initialBranch,
 		inputs:              inputs,
+		labels:              labels,
 		nonExistingBranches: nonExistingBranches,
 		preFetchBranchInfos: preFetchBranchSnapshot.Branches,
 		previousBranch:      previousBranch,
 		proposalBody:        bodyText,
 		proposalTitle:       args.title,
+		ready:               args.ready,
 		remotes:             remotes,
+		reviewers:           reviewers,
+		stack:               args.stack,
 		stashSize:           stashSize,
 	}, configdomain.ProgramFlowContinue, err
 }
 
 func proposeProgram(repo execute.OpenRepoResult, data proposeData) program.Program {
 	prog := NewMutable(&program.Program{})
+	if data.ready.Enabled() {
+		prog.Value.Add(&opcodes.ProposalMarkReady{Branch: data.initialBranch})
+		return prog.Immutable()
+	}
 	data.config.CleanupLineage(data.branchInfos, data.nonExistingBranches, repo.FinalMessages, repo.Backend, data.config.NormalConfig.Order)
 	branchesToDelete := set.New[gitdomain.LocalBranchName]()
 	sync.BranchesProgram(data.branchesToSync, sync.BranchProgramArgs{
-		BranchInfos:         data.branchInfos,
-		BranchInfosPrevious: data.branchInfosLastRun,
-		BranchesToDelete:    NewMutable(&branchesToDelete),
-		Config:              data.config,
-		InitialBranch:       data.initialBranch,
-		PrefetchBranchInfos: data.preFetchBranchInfos,
-		Remotes:             data.remotes,
-		Program:             prog,
-		Prune:               false,
-		PushBranches:        true,
+		BranchInfos:                       data.branchInfos,
+		BranchInfosPrevious:               data.branchInfosLastRun,
+		BranchesChangedSinceMergedAtForge: set.New[gitdomain.LocalBranchName](),
+		BranchesMergedAtForge:             set.New[gitdomain.LocalBranchName](),
+		BranchesToDelete:                  NewMutable(&branchesToDelete),
+		Config:                            data.config,
+		InitialBranch:                     data.initialBranch,
+		LocalBranchOverrides:              repo.UnvalidatedConfig.GitLocal.BranchOverrides,
+		PrefetchBranchInfos:               data.preFetchBranchInfos,
+		Remotes:                           data.remotes,
+		Program:                           prog,
+		Prune:                             false,
+		PushBranches:                      Some(configdomain.PushBranches(true)),
+		Worktrees:                         gitdomain.Worktrees{},
 	})
+	// when proposing an entire stack, create the proposals through the forge API if possible
+	// and link them to each other once all of them exist
+	createViaAPI := false
+	if connector, hasConnector := data.connector.Get(); hasConnector && data.stack.Enabled() {
+		_, createViaAPI = connector.(forgedomain.ProposalCreator)
+	}
+	updateBreadcrumb := data.config.NormalConfig.ProposalBreadcrumb.Enabled()
+	proposedBranches := gitdomain.LocalBranchNames{}
 	for _, branchToPropose := range data.branchesToPropose {
 		if branchToPropose.syncStatus == gitdomain.SyncStatusDeletedAtRemote {
 			repo.FinalMessages.Addf(messages.BranchDeletedAtRemote, branchToPropose.name)
@@ -387,22 +455,47 @@ func proposeProgram(repo execute.OpenRepoResult, data proposeData) program.Progr
 			CurrentBranch: branchToPropose.name,
 		})
 		prog.Value.Add(&opcodes.CheckoutIfNeeded{Branch: branchToPropose.name})
-		updateBreadcrumb := data.config.NormalConfig.ProposalBreadcrumb.Enabled()
+		if createViaAPI {
+			prog.Value.Add(&opcodes.ProposalCreateViaAPI{
+				Assignees:     data.assignees,
+				Branch:        branchToPropose.name,
+				Draft:         data.draft.ShouldCreateDraft(),
+				Labels:        data.labels,
+				MainBranch:    data.config.ValidatedConfigData.MainBranch,
+				ProposalBody:  data.proposalBody,
+				ProposalTitle: data.proposalTitle,
+				Reviewers:     data.reviewers,
+			})
+			proposedBranches = append(proposedBranches, branchToPropose.name)
+			prog.Value.Add(&opcodes.ProgramEndOfBranch{})
+			continue
+		}
 		proposalBody := data.proposalBody
-		if updateBreadcrumb {
+		// when rendering a proposal template, the ProposalCreate opcode embeds the breadcrumb into the rendered body
+		renderTemplate := data.config.NormalConfig.ProposeTemplate.IsSome() && proposalBody.IsNone()
+		if updateBreadcrumb && !renderTemplate {
 			lineageSection := proposallineage.RenderSection(data.config.NormalConfig.Lineage, branchToPropose.name, data.config.NormalConfig.Order, data.config.NormalConfig.ProposalBreadcrumb, data.config.NormalConfig.ProposalBreadcrumbDirection, data.connector)
 			if len(lineageSection) > 0 {
 				proposalBody = Some(proposallineage.UpdateProposalBody(proposalBody.GetOrZero(), lineageSection))
 			}
 		}
 		prog.Value.Add(&opcodes.ProposalCreate{
+			Assignees:     data.assignees,
 			Branch:        branchToPropose.name,
+			Draft:         data.draft.ShouldCreateDraft(),
+			Labels:        data.labels,
 			MainBranch:    data.config.ValidatedConfigData.MainBranch,
 			ProposalBody:  proposalBody,
 			ProposalTitle: data.proposalTitle,
+			Reviewers:     data.reviewers,
 		})
 		prog.Value.Add(&opcodes.ProgramEndOfBranch{})
 	}
+	if updateBreadcrumb {
+		for _, proposedBranch := range proposedBranches {
+			prog.Value.Add(&opcodes.ProposalUpdateBreadcrumb{Branch: proposedBranch})
+		}
+	}
 	previousBranchCandidates := []Option[gitdomain.LocalBranchName]{data.previousBranch}
 	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
 		DryRun:                   data.config.NormalConfig.DryRun,
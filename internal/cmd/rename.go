@@ -164,6 +164,7 @@ func determineRenameData(args []string, force configdomain.Force, repo execute.O
 	}
 	config := repo.UnvalidatedConfig.NormalConfig
 	connector, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
 		Backend:              repo.Backend,
 		BitbucketAppPassword: config.BitbucketAppPassword,
 		BitbucketUsername:    config.BitbucketUsername,
@@ -332,6 +333,7 @@ func renameProgram(repo execute.OpenRepoResult, data renameData, finalMessages s
 				},
 			)
 		}
+		programs.MoveBranchOverridesProgram(prog, repo.UnvalidatedConfig.GitLocal.BranchOverrides, oldLocalBranch, data.newBranch)
 		if parentBranch, hasParent := data.config.NormalConfig.Lineage.Parent(oldLocalBranch).Get(); hasParent {
 			prog.Value.Add(&opcodes.LineageParentSet{Branch: data.newBranch, Parent: parentBranch})
 		}
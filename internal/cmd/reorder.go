@@ -0,0 +1,473 @@
+package cmd
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/git-town/git-town/v22/internal/cli/dialog"
+	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogcomponents"
+	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogdomain"
+	"github.com/git-town/git-town/v22/internal/cli/flags"
+	"github.com/git-town/git-town/v22/internal/cli/print"
+	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v22/internal/config"
+	"github.com/git-town/git-town/v22/internal/config/cliconfig"
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/execute"
+	"github.com/git-town/git-town/v22/internal/forge"
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/programs"
+	"github.com/git-town/git-town/v22/internal/state/runstate"
+	"github.com/git-town/git-town/v22/internal/validate"
+	"github.com/git-town/git-town/v22/internal/vm/interpreter/fullinterpreter"
+	"github.com/git-town/git-town/v22/internal/vm/opcodes"
+	"github.com/git-town/git-town/v22/internal/vm/program"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const (
+	reorderCommandName = "reorder"
+	reorderDesc        = "Change the order of the branches in a stack"
+	reorderHelp        = `
+Displays the branches of the current stack
+and lets you select a new position for each of them.
+Then rebases each branch onto its new parent
+and updates the targets of their proposals.
+
+The stack must be linear,
+i.e. each branch in it has at most one child branch.
+
+Consider this stack:
+
+main
+ \
+  branch-1
+   \
+    branch-2
+     \
+*     branch-3
+
+After running "git town reorder"
+and selecting "branch-3", "branch-1", and "branch-2" as the new order,
+we end up with this stack:
+
+main
+ \
+* branch-3
+   \
+    branch-1
+     \
+      branch-2
+`
+)
+
+func reorderCommand() *cobra.Command {
+	addAutoResolveFlag, readAutoResolveFlag := flags.AutoResolve()
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     reorderCommandName,
+		Args:    cobra.NoArgs,
+		Short:   reorderDesc,
+		GroupID: cmdhelpers.GroupIDStack,
+		Long:    cmdhelpers.Long(reorderDesc, reorderHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			autoResolve, errAutoResolve := readAutoResolveFlag(cmd)
+			dryRun, errDryRun := readDryRunFlag(cmd)
+			verbose, errVerbose := readVerboseFlag(cmd)
+			if err := cmp.Or(errAutoResolve, errDryRun, errVerbose); err != nil {
+				return err
+			}
+			cliConfig := cliconfig.New(cliconfig.NewArgs{
+				AutoResolve:       autoResolve,
+				AutoSync:          None[configdomain.AutoSync](),
+				Detached:          Some(configdomain.Detached(true)),
+				DisplayTypes:      None[configdomain.DisplayTypes](),
+				DryRun:            dryRun,
+				IgnoreUncommitted: None[configdomain.IgnoreUncommitted](),
+				Order:             None[configdomain.Order](),
+				PushBranches:      None[configdomain.PushBranches](),
+				Stash:             None[configdomain.Stash](),
+				Verbose:           verbose,
+			})
+			return executeReorder(cliConfig)
+		},
+	}
+	addAutoResolveFlag(&cmd)
+	addDryRunFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeReorder(cliConfig configdomain.PartialConfig) error {
+Start:
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		CliConfig:        cliConfig,
+		IgnoreUnknown:    false,
+		PrintBranchNames: true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+	})
+	if err != nil {
+		return err
+	}
+	data, flow, err := determineReorderData(repo)
+	if err != nil {
+		return err
+	}
+	switch flow {
+	case configdomain.ProgramFlowContinue:
+	case configdomain.ProgramFlowExit:
+		return nil
+	case configdomain.ProgramFlowRestart:
+		goto Start
+	}
+	runProgram := reorderProgram(data)
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: data.branchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        data.stashSize,
+		BranchInfosLastRun:    data.branchInfosLastRun,
+		Command:               reorderCommandName,
+		DryRun:                data.config.NormalConfig.DryRun,
+		EndBranchesSnapshot:   None[gitdomain.BranchesSnapshot](),
+		EndConfigSnapshot:     None[configdomain.EndConfigSnapshot](),
+		EndStashSize:          None[gitdomain.StashSize](),
+		RunProgram:            runProgram,
+		TouchedBranches:       runProgram.TouchedBranches(),
+		UndoAPIProgram:        program.Program{},
+	}
+	return fullinterpreter.Execute(fullinterpreter.ExecuteArgs{
+		Backend:                 repo.Backend,
+		CommandsCounter:         repo.CommandsCounter,
+		Config:                  data.config,
+		ConfigDir:               repo.ConfigDir,
+		Connector:               data.connector,
+		DryRun:                  data.config.NormalConfig.DryRun,
+		FinalMessages:           repo.FinalMessages,
+		Frontend:                repo.Frontend,
+		Git:                     repo.Git,
+		HasOpenChanges:          data.hasOpenChanges,
+		InitialBranch:           data.initialBranch,
+		InitialBranchesSnapshot: data.branchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        data.stashSize,
+		Inputs:                  data.inputs,
+		PendingCommand:          None[string](),
+		RunState:                runState,
+	})
+}
+
+type reorderData struct {
+	branchInfosLastRun Option[gitdomain.BranchInfos]
+	branchesSnapshot   gitdomain.BranchesSnapshot
+	config             config.ValidatedConfig
+	connector          Option[forgedomain.Connector]
+	hasOpenChanges     bool
+	initialBranch      gitdomain.LocalBranchName
+	inputs             dialogcomponents.Inputs
+	newOrder           []reorderBranch // the branches of the stack in their new order, oldest first
+	previousBranch     Option[gitdomain.LocalBranchName]
+	root               gitdomain.LocalBranchName // the perennial branch at the root of the stack
+	stashSize          gitdomain.StashSize
+}
+
+// reorderBranch describes a branch in the stack to reorder
+type reorderBranch struct {
+	branchType configdomain.BranchType
+	info       gitdomain.BranchInfo
+	name       gitdomain.LocalBranchName
+	oldParent  gitdomain.LocalBranchName
+	proposal   Option[forgedomain.Proposal]
+}
+
+func determineReorderData(repo execute.OpenRepoResult) (reorderData, configdomain.ProgramFlow, error) {
+	inputs := dialogcomponents.LoadInputs(os.Environ())
+	var emptyResult reorderData
+	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	config := repo.UnvalidatedConfig.NormalConfig
+	connector, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
+		Backend:              repo.Backend,
+		BitbucketAppPassword: config.BitbucketAppPassword,
+		BitbucketUsername:    config.BitbucketUsername,
+		Browser:              config.Browser,
+		ConfigDir:            repo.ConfigDir,
+		ForgeType:            config.ForgeType,
+		ForgejoToken:         config.ForgejoToken,
+		Frontend:             repo.Frontend,
+		GiteaToken:           config.GiteaToken,
+		GithubConnectorType:  config.GithubConnectorType,
+		GithubToken:          config.GithubToken,
+		GitlabConnectorType:  config.GitlabConnectorType,
+		GitlabToken:          config.GitlabToken,
+		Log:                  print.Logger{},
+		RemoteURL:            config.DevURL(repo.Backend),
+	})
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	branchesSnapshot, stashSize, branchInfosLastRun, flow, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		Backend:               repo.Backend,
+		CommandsCounter:       repo.CommandsCounter,
+		ConfigSnapshot:        repo.ConfigSnapshot,
+		Connector:             connector,
+		Fetch:                 true,
+		FinalMessages:         repo.FinalMessages,
+		Frontend:              repo.Frontend,
+		Git:                   repo.Git,
+		HandleUnfinishedState: true,
+		Inputs:                inputs,
+		Repo:                  repo,
+		RepoStatus:            repoStatus,
+		RootDir:               repo.RootDir,
+		UnvalidatedConfig:     repo.UnvalidatedConfig,
+		ValidateNoOpenChanges: false,
+	})
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	switch flow {
+	case configdomain.ProgramFlowContinue:
+	case configdomain.ProgramFlowExit, configdomain.ProgramFlowRestart:
+		return emptyResult, flow, nil
+	}
+	if branchesSnapshot.DetachedHead {
+		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.ReorderDetachedHead)
+	}
+	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
+	if !hasInitialBranch {
+		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.CurrentBranchCannotDetermine)
+	}
+	localBranches := branchesSnapshot.Branches.LocalBranches().NamesLocalBranches()
+	branchesAndTypes := repo.UnvalidatedConfig.UnvalidatedBranchesAndTypes(localBranches)
+	remotes, err := repo.Git.Remotes(repo.Backend)
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
+		Backend:            repo.Backend,
+		BranchInfos:        branchesSnapshot.Branches,
+		BranchesAndTypes:   branchesAndTypes,
+		BranchesToValidate: gitdomain.LocalBranchNames{initialBranch},
+		ConfigDir:          repo.ConfigDir,
+		ConfigSnapshot:     repo.ConfigSnapshot,
+		Connector:          connector,
+		Frontend:           repo.Frontend,
+		Git:                repo.Git,
+		Inputs:             inputs,
+		LocalBranches:      localBranches,
+		Remotes:            remotes,
+		RepoStatus:         repoStatus,
+		Unvalidated:        NewMutable(&repo.UnvalidatedConfig),
+	})
+	if err != nil || exit {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	lineage := validatedConfig.NormalConfig.Lineage
+	if !lineage.HasParents(initialBranch) {
+		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.ReorderNoStack)
+	}
+	root := lineage.Root(initialBranch)
+	stack := lineage.BranchLineageWithoutRoot(initialBranch, validatedConfig.NormalConfig.PerennialBranches, validatedConfig.NormalConfig.Order)
+	if len(stack) < 2 {
+		return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.ReorderSingleBranch, initialBranch)
+	}
+	branches := make([]reorderBranch, len(stack))
+	for b, branchName := range stack {
+		if len(lineage.Children(branchName, validatedConfig.NormalConfig.Order)) > 1 {
+			return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.ReorderNonLinearStack, branchName)
+		}
+		branchInfo, hasBranchInfo := branchesSnapshot.Branches.FindByLocalName(branchName).Get()
+		if !hasBranchInfo {
+			return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.ReorderRemoteBranch, branchName)
+		}
+		parent := root
+		if b > 0 {
+			parent = stack[b-1]
+		}
+		containsMerges, err := repo.Git.BranchContainsMerges(repo.Backend, branchName, parent)
+		if err != nil {
+			return emptyResult, configdomain.ProgramFlowExit, err
+		}
+		if containsMerges {
+			return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.ReorderNeedsCompress, branchName)
+		}
+		branches[b] = reorderBranch{
+			branchType: validatedConfig.BranchType(branchName),
+			info:       *branchInfo,
+			name:       branchName,
+			oldParent:  parent,
+			proposal:   None[forgedomain.Proposal](),
+		}
+	}
+	if err = validateReorderBranches(branches); err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	newOrder, exit, err := enterReorderedStack(branches, root, inputs, validatedConfig.NormalConfig.DisplayDialogs)
+	if err != nil || exit {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	if slices.EqualFunc(branches, newOrder, func(a, b reorderBranch) bool { return a.name == b.name }) {
+		fmt.Println(messages.ReorderUnchanged)
+		return emptyResult, configdomain.ProgramFlowExit, nil
+	}
+	if connector, hasConnector := connector.Get(); hasConnector {
+		if proposalFinder, canFindProposals := connector.(forgedomain.ProposalFinder); canFindProposals {
+			for b, branch := range newOrder {
+				newOrder[b].proposal, err = proposalFinder.FindProposal(branch.name, branch.oldParent)
+				if err != nil {
+					return emptyResult, configdomain.ProgramFlowExit, err
+				}
+			}
+		}
+	}
+	return reorderData{
+		branchInfosLastRun: branchInfosLastRun,
+		branchesSnapshot:   branchesSnapshot,
+		config:             validatedConfig,
+		connector:          connector,
+		hasOpenChanges:     repoStatus.OpenChanges,
+		initialBranch:      initialBranch,
+		inputs:             inputs,
+		newOrder:           newOrder,
+		previousBranch:     repo.Git.PreviouslyCheckedOutBranch(repo.Backend),
+		root:               root,
+		stashSize:          stashSize,
+	}, configdomain.ProgramFlowContinue, nil
+}
+
+// lets the user enter the new order of the given branches
+func enterReorderedStack(branches []reorderBranch, root gitdomain.LocalBranchName, inputs dialogcomponents.Inputs, displayDialogs configdomain.DisplayDialogs) ([]reorderBranch, dialogdomain.Exit, error) {
+	remaining := slices.Clone(branches)
+	result := make([]reorderBranch, 0, len(branches))
+	for len(remaining) > 1 {
+		remainingNames := make(gitdomain.LocalBranchNames, len(remaining))
+		for r, branch := range remaining {
+			remainingNames[r] = branch.name
+		}
+		selected, exit, err := dialog.StackPosition(dialog.StackPositionArgs{
+			Branches:       remainingNames,
+			DisplayDialogs: displayDialogs,
+			Inputs:         inputs,
+			Position:       len(result) + 1,
+			Root:           root,
+		})
+		if err != nil || exit {
+			return result, exit, err
+		}
+		index := slices.Index(remainingNames, selected)
+		result = append(result, remaining[index])
+		remaining = slices.Delete(remaining, index, index+1)
+	}
+	return append(result, remaining...), false, nil
+}
+
+func reorderProgram(data reorderData) program.Program {
+	prog := NewMutable(&program.Program{})
+	// As long as the branches keep their position, they don't need to change.
+	// Once a branch moves, all branches after it need to be rebased onto their new parent.
+	rebase := false
+	lineageUpdates := []*opcodes.LineageParentSet{}
+	for b, branch := range data.newOrder {
+		newParent := data.root
+		if b > 0 {
+			newParent = data.newOrder[b-1].name
+		}
+		if !rebase && newParent == branch.oldParent {
+			continue
+		}
+		rebase = true
+		if proposal, hasProposal := branch.proposal.Get(); hasProposal && newParent != branch.oldParent {
+			prog.Value.Add(&opcodes.ProposalUpdateTarget{
+				NewBranch: newParent,
+				OldBranch: branch.oldParent,
+				Proposal:  proposal,
+			})
+		}
+		commitsToRemove := branch.oldParent.Location()
+		if branch.oldParent != data.root {
+			commitsToRemove = reorderBranchSHA(data.newOrder, branch.oldParent).Location()
+		}
+		prog.Value.Add(
+			&opcodes.CheckoutIfNeeded{Branch: branch.name},
+			&opcodes.RebaseOnto{
+				BranchToRebaseOnto: newParent.BranchName(),
+				CommitsToRemove:    commitsToRemove,
+			},
+		)
+		if trackingBranch, hasTrackingBranch := branch.info.RemoteName.Get(); hasTrackingBranch {
+			prog.Value.Add(&opcodes.PushCurrentBranchForceIfNeeded{
+				CurrentBranch:   branch.name,
+				ForceIfIncludes: true,
+				TrackingBranch:  trackingBranch,
+			})
+		}
+		if newParent != branch.oldParent {
+			lineageUpdates = append(lineageUpdates, &opcodes.LineageParentSet{Branch: branch.name, Parent: newParent})
+		}
+	}
+	prog.Value.Add(&opcodes.CheckoutIfNeeded{Branch: data.initialBranch})
+	if !data.config.NormalConfig.DryRun {
+		for _, lineageUpdate := range lineageUpdates {
+			prog.Value.Add(lineageUpdate)
+		}
+	}
+	updateBreadcrumb := data.config.NormalConfig.ProposalBreadcrumb.Enabled()
+	isOnline := data.config.NormalConfig.Offline.IsOnline()
+	if updateBreadcrumb && isOnline {
+		programs.UpdateBreadcrumbsProgram(programs.UpdateBreadcrumbsArgs{
+			Config:          data.config,
+			Program:         prog,
+			TouchedBranches: gitdomain.LocalBranchNames{data.initialBranch},
+		})
+	}
+	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
+		DryRun:                   data.config.NormalConfig.DryRun,
+		InitialStashSize:         data.stashSize,
+		RunInGitRoot:             true,
+		StashOpenChanges:         data.hasOpenChanges && data.config.NormalConfig.Stash.ShouldStash(),
+		PreviousBranchCandidates: []Option[gitdomain.LocalBranchName]{data.previousBranch},
+	})
+	return prog.Immutable()
+}
+
+// provides the SHA that the given branch had before reordering
+func reorderBranchSHA(branches []reorderBranch, name gitdomain.LocalBranchName) gitdomain.SHA {
+	for _, branch := range branches {
+		if branch.name == name {
+			return branch.info.GetLocalOrRemoteSHA()
+		}
+	}
+	panic("branch not in stack: " + name.String())
+}
+
+func validateReorderBranches(branches []reorderBranch) error {
+	for _, branch := range branches {
+		switch branch.info.SyncStatus {
+		case gitdomain.SyncStatusUpToDate, gitdomain.SyncStatusAhead, gitdomain.SyncStatusLocalOnly:
+		case gitdomain.SyncStatusDeletedAtRemote, gitdomain.SyncStatusNotInSync, gitdomain.SyncStatusBehind:
+			return errors.New(messages.ReorderNeedsSync)
+		case gitdomain.SyncStatusOtherWorktree:
+			return fmt.Errorf(messages.ReorderOtherWorkTree, branch.name)
+		case gitdomain.SyncStatusRemoteOnly:
+			return fmt.Errorf(messages.ReorderRemoteBranch, branch.name)
+		}
+		switch branch.branchType {
+		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
+		case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypePerennialBranch:
+			return fmt.Errorf(messages.ReorderUnsupportedBranchType, branch.name, branch.branchType)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,345 @@
+package cmd
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogcomponents"
+	"github.com/git-town/git-town/v22/internal/cli/flags"
+	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v22/internal/config"
+	"github.com/git-town/git-town/v22/internal/config/cliconfig"
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/execute"
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/state/runlog"
+	"github.com/git-town/git-town/v22/internal/state/runstate"
+	"github.com/git-town/git-town/v22/internal/validate"
+	"github.com/git-town/git-town/v22/internal/vm/interpreter/fullinterpreter"
+	"github.com/git-town/git-town/v22/internal/vm/opcodes"
+	"github.com/git-town/git-town/v22/internal/vm/program"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/git-town/git-town/v22/pkg/set"
+	"github.com/spf13/cobra"
+)
+
+const (
+	restackCmd  = "restack"
+	restackDesc = "Rebase the descendants of the current branch onto their updated parents"
+	restackHelp = `
+After you amend or add commits on a branch in a stack,
+its descendant branches still build on the old commits of that branch.
+This command rebases all descendants of the current branch
+onto the new commits of their parent branches.
+
+Git Town determines the previous location of each parent branch
+from the end of the last Git Town command.
+
+Unlike "git town sync --stack", this command only changes local branches.
+It doesn't fetch, pull, or push.
+This makes it cheap enough to run from a post-commit hook.
+`
+)
+
+func restackCommand() *cobra.Command {
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     restackCmd,
+		Args:    cobra.NoArgs,
+		GroupID: cmdhelpers.GroupIDStack,
+		Short:   restackDesc,
+		Long:    cmdhelpers.Long(restackDesc, restackHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dryRun, errDryRun := readDryRunFlag(cmd)
+			verbose, errVerbose := readVerboseFlag(cmd)
+			if err := cmp.Or(errDryRun, errVerbose); err != nil {
+				return err
+			}
+			cliConfig := cliconfig.New(cliconfig.NewArgs{
+				AutoResolve:       None[configdomain.AutoResolve](),
+				AutoSync:          None[configdomain.AutoSync](),
+				Detached:          Some(configdomain.Detached(true)),
+				DisplayTypes:      None[configdomain.DisplayTypes](),
+				DryRun:            dryRun,
+				IgnoreUncommitted: None[configdomain.IgnoreUncommitted](),
+				Order:             None[configdomain.Order](),
+				PushBranches:      None[configdomain.PushBranches](),
+				Stash:             None[configdomain.Stash](),
+				Verbose:           verbose,
+			})
+			return executeRestack(cliConfig)
+		},
+	}
+	addDryRunFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeRestack(cliConfig configdomain.PartialConfig) error {
+Start:
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		CliConfig:        cliConfig,
+		IgnoreUnknown:    false,
+		PrintBranchNames: true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+	})
+	if err != nil {
+		return err
+	}
+	data, flow, err := determineRestackData(repo)
+	if err != nil {
+		return err
+	}
+	switch flow {
+	case configdomain.ProgramFlowContinue:
+	case configdomain.ProgramFlowExit:
+		return nil
+	case configdomain.ProgramFlowRestart:
+		goto Start
+	}
+	runProgram := restackProgram(data)
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: data.branchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        data.stashSize,
+		BranchInfosLastRun:    data.branchInfosLastRun,
+		Command:               restackCmd,
+		DryRun:                data.config.NormalConfig.DryRun,
+		EndBranchesSnapshot:   None[gitdomain.BranchesSnapshot](),
+		EndConfigSnapshot:     None[configdomain.EndConfigSnapshot](),
+		EndStashSize:          None[gitdomain.StashSize](),
+		RunProgram:            runProgram,
+		TouchedBranches:       runProgram.TouchedBranches(),
+		UndoAPIProgram:        program.Program{},
+	}
+	return fullinterpreter.Execute(fullinterpreter.ExecuteArgs{
+		Backend:                 repo.Backend,
+		CommandsCounter:         repo.CommandsCounter,
+		Config:                  data.config,
+		ConfigDir:               repo.ConfigDir,
+		Connector:               None[forgedomain.Connector](),
+		DryRun:                  data.config.NormalConfig.DryRun,
+		FinalMessages:           repo.FinalMessages,
+		Frontend:                repo.Frontend,
+		Git:                     repo.Git,
+		HasOpenChanges:          data.hasOpenChanges,
+		InitialBranch:           data.initialBranch,
+		InitialBranchesSnapshot: data.branchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        data.stashSize,
+		Inputs:                  data.inputs,
+		PendingCommand:          None[string](),
+		RunState:                runState,
+	})
+}
+
+type restackData struct {
+	branchInfosLastRun Option[gitdomain.BranchInfos]
+	branchesSnapshot   gitdomain.BranchesSnapshot
+	branchesToRestack  []restackBranch // the branches to rebase, parents before their children
+	config             config.ValidatedConfig
+	hasOpenChanges     bool
+	initialBranch      gitdomain.LocalBranchName
+	inputs             dialogcomponents.Inputs
+	previousBranch     Option[gitdomain.LocalBranchName]
+	stashSize          gitdomain.StashSize
+}
+
+// restackBranch describes a branch that needs to be rebased onto its updated parent
+type restackBranch struct {
+	name         gitdomain.LocalBranchName
+	oldParentSHA gitdomain.SHA // the commit of the parent branch that this branch currently builds on
+	parent       gitdomain.LocalBranchName
+}
+
+func determineRestackData(repo execute.OpenRepoResult) (restackData, configdomain.ProgramFlow, error) {
+	inputs := dialogcomponents.LoadInputs(os.Environ())
+	var emptyResult restackData
+	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	branchesSnapshot, stashSize, branchInfosLastRun, flow, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		Backend:               repo.Backend,
+		CommandsCounter:       repo.CommandsCounter,
+		ConfigSnapshot:        repo.ConfigSnapshot,
+		Connector:             None[forgedomain.Connector](),
+		Fetch:                 false,
+		FinalMessages:         repo.FinalMessages,
+		Frontend:              repo.Frontend,
+		Git:                   repo.Git,
+		HandleUnfinishedState: true,
+		Inputs:                inputs,
+		Repo:                  repo,
+		RepoStatus:            repoStatus,
+		RootDir:               repo.RootDir,
+		UnvalidatedConfig:     repo.UnvalidatedConfig,
+		ValidateNoOpenChanges: false,
+	})
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	switch flow {
+	case configdomain.ProgramFlowContinue:
+	case configdomain.ProgramFlowExit, configdomain.ProgramFlowRestart:
+		return emptyResult, flow, nil
+	}
+	if branchesSnapshot.DetachedHead {
+		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.RestackDetachedHead)
+	}
+	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
+	if !hasInitialBranch {
+		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.CurrentBranchCannotDetermine)
+	}
+	localBranches := branchesSnapshot.Branches.LocalBranches().NamesLocalBranches()
+	branchesAndTypes := repo.UnvalidatedConfig.UnvalidatedBranchesAndTypes(localBranches)
+	remotes, err := repo.Git.Remotes(repo.Backend)
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
+		Backend:            repo.Backend,
+		BranchInfos:        branchesSnapshot.Branches,
+		BranchesAndTypes:   branchesAndTypes,
+		BranchesToValidate: gitdomain.LocalBranchNames{initialBranch},
+		ConfigDir:          repo.ConfigDir,
+		ConfigSnapshot:     repo.ConfigSnapshot,
+		Connector:          None[forgedomain.Connector](),
+		Frontend:           repo.Frontend,
+		Git:                repo.Git,
+		Inputs:             inputs,
+		LocalBranches:      localBranches,
+		Remotes:            remotes,
+		RepoStatus:         repoStatus,
+		Unvalidated:        NewMutable(&repo.UnvalidatedConfig),
+	})
+	if err != nil || exit {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	runlogEntries, err := runlog.Load(runlog.NewRunlogPath(repo.ConfigDir))
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	previousSHAs := restackPreviousSHAs(branchInfosLastRun, runlogEntries, remotes)
+	lineage := validatedConfig.NormalConfig.Lineage
+	branchesToRestack := []restackBranch{}
+	restacked := set.New[gitdomain.LocalBranchName]()
+	skipped := set.New[gitdomain.LocalBranchName]()
+	for _, branch := range lineage.Descendants(initialBranch, validatedConfig.NormalConfig.Order) {
+		parent := lineage.Parent(branch).GetOrPanic()
+		if skipped.Contains(parent) || !restackCanRebase(branch, branchesSnapshot.Branches, validatedConfig) {
+			skipped.Add(branch)
+			continue
+		}
+		parentSHA := branchesSnapshot.Branches.FindByLocalName(parent).GetOrPanic().LocalSHA().GetOrPanic()
+		containsParent, err := repo.Git.BranchContainsCommit(repo.Backend, branch, parentSHA)
+		if err != nil {
+			return emptyResult, configdomain.ProgramFlowExit, err
+		}
+		oldParentSHA := None[gitdomain.SHA]()
+		switch {
+		case containsParent && restacked.Contains(parent):
+			oldParentSHA = Some(parentSHA)
+		case containsParent:
+			continue
+		default:
+			if previousSHA, hasPreviousSHA := previousSHAs[parent]; hasPreviousSHA {
+				containsPrevious, err := repo.Git.BranchContainsCommit(repo.Backend, branch, previousSHA)
+				if err != nil {
+					return emptyResult, configdomain.ProgramFlowExit, err
+				}
+				if containsPrevious {
+					oldParentSHA = Some(previousSHA)
+				}
+			}
+		}
+		sha, hasSHA := oldParentSHA.Get()
+		if !hasSHA {
+			return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.RestackUnknownParentSHA, branch, parent)
+		}
+		branchesToRestack = append(branchesToRestack, restackBranch{
+			name:         branch,
+			oldParentSHA: sha,
+			parent:       parent,
+		})
+		restacked.Add(branch)
+	}
+	if len(branchesToRestack) == 0 {
+		fmt.Printf(messages.RestackNothingToDo, initialBranch)
+		return emptyResult, configdomain.ProgramFlowExit, nil
+	}
+	return restackData{
+		branchInfosLastRun: branchInfosLastRun,
+		branchesSnapshot:   branchesSnapshot,
+		branchesToRestack:  branchesToRestack,
+		config:             validatedConfig,
+		hasOpenChanges:     repoStatus.OpenChanges,
+		initialBranch:      initialBranch,
+		inputs:             inputs,
+		previousBranch:     repo.Git.PreviouslyCheckedOutBranch(repo.Backend),
+		stashSize:          stashSize,
+	}, configdomain.ProgramFlowContinue, nil
+}
+
+func restackProgram(data restackData) program.Program {
+	prog := NewMutable(&program.Program{})
+	for _, branch := range data.branchesToRestack {
+		prog.Value.Add(
+			&opcodes.CheckoutIfNeeded{Branch: branch.name},
+			&opcodes.RebaseOnto{
+				BranchToRebaseOnto: branch.parent.BranchName(),
+				CommitsToRemove:    branch.oldParentSHA.Location(),
+			},
+		)
+	}
+	prog.Value.Add(&opcodes.CheckoutIfNeeded{Branch: data.initialBranch})
+	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
+		DryRun:                   data.config.NormalConfig.DryRun,
+		InitialStashSize:         data.stashSize,
+		RunInGitRoot:             true,
+		StashOpenChanges:         data.hasOpenChanges && data.config.NormalConfig.Stash.ShouldStash(),
+		PreviousBranchCandidates: []Option[gitdomain.LocalBranchName]{data.previousBranch},
+	})
+	return prog.Immutable()
+}
+
+// indicates whether "git town restack" can rebase the given branch
+func restackCanRebase(branch gitdomain.LocalBranchName, branchInfos gitdomain.BranchInfos, config config.ValidatedConfig) bool {
+	branchInfo, hasBranchInfo := branchInfos.FindByLocalName(branch).Get()
+	if !hasBranchInfo || branchInfo.SyncStatus == gitdomain.SyncStatusOtherWorktree {
+		return false
+	}
+	switch config.BranchType(branch) {
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
+		return true
+	case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypePerennialBranch:
+	}
+	return false
+}
+
+// provides the SHAs that the local branches had at the end of the last Git Town command,
+// preferring the runstate over the runlog
+func restackPreviousSHAs(branchInfosLastRun Option[gitdomain.BranchInfos], runlogEntries []runlog.Entry, remotes gitdomain.Remotes) map[gitdomain.LocalBranchName]gitdomain.SHA {
+	if branchInfos, hasBranchInfos := branchInfosLastRun.Get(); hasBranchInfos {
+		result := map[gitdomain.LocalBranchName]gitdomain.SHA{}
+		for _, branchInfo := range branchInfos {
+			if local, hasLocal := branchInfo.Local.Get(); hasLocal {
+				result[local.Name] = local.SHA
+			}
+		}
+		return result
+	}
+	for e := len(runlogEntries) - 1; e >= 0; e-- {
+		if runlogEntries[e].Event == runlog.EventEnd {
+			return runlogEntries[e].LocalBranches(remotes)
+		}
+	}
+	return map[gitdomain.LocalBranchName]gitdomain.SHA{}
+}
@@ -26,6 +26,9 @@ into an immutable, append-only log file called the runlog.
 The runlog provides an extra layer of safety,
 making it easier to manually roll back changes
 if git town undo doesn't fully undo the last command.
+
+Run "git town runlog restore" to reset your branches
+to the state recorded in a runlog entry.
 `
 )
 
@@ -58,6 +61,7 @@ func runLogCommand() *cobra.Command {
 		},
 	}
 	addVerboseFlag(&cmd)
+	cmd.AddCommand(runLogRestoreCommand())
 	return &cmd
 }
 
@@ -0,0 +1,421 @@
+package cmd
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+
+	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogcomponents"
+	"github.com/git-town/git-town/v22/internal/cli/flags"
+	"github.com/git-town/git-town/v22/internal/cli/print"
+	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v22/internal/config"
+	"github.com/git-town/git-town/v22/internal/config/cliconfig"
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/execute"
+	"github.com/git-town/git-town/v22/internal/forge"
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/state/runlog"
+	"github.com/git-town/git-town/v22/internal/state/runstate"
+	"github.com/git-town/git-town/v22/internal/validate"
+	"github.com/git-town/git-town/v22/internal/vm/interpreter/fullinterpreter"
+	"github.com/git-town/git-town/v22/internal/vm/opcodes"
+	"github.com/git-town/git-town/v22/internal/vm/program"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const (
+	runLogRestoreDesc = "Resets all branches to the SHAs recorded in a runlog entry"
+	runLogRestoreHelp = `
+Without arguments, this command lists all runlog entries, most recent first.
+
+When given the number of a runlog entry,
+this command resets all local branches and their tracking branches
+to the SHAs that the runlog recorded in this entry,
+and re-creates branches that no longer exist.
+It displays which branches will move before it changes anything.
+Branches that didn't exist at the time of the runlog entry remain unchanged.
+
+You can undo this command with "git town undo".
+`
+)
+
+func runLogRestoreCommand() *cobra.Command {
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:   "restore [<index>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: runLogRestoreDesc,
+		Long:  cmdhelpers.Long(runLogRestoreDesc, runLogRestoreHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dryRun, errDryRun := readDryRunFlag(cmd)
+			verbose, errVerbose := readVerboseFlag(cmd)
+			if err := cmp.Or(errDryRun, errVerbose); err != nil {
+				return err
+			}
+			cliConfig := cliconfig.New(cliconfig.NewArgs{
+				AutoResolve:       None[configdomain.AutoResolve](),
+				AutoSync:          None[configdomain.AutoSync](),
+				Detached:          None[configdomain.Detached](),
+				DisplayTypes:      None[configdomain.DisplayTypes](),
+				DryRun:            dryRun,
+				IgnoreUncommitted: None[configdomain.IgnoreUncommitted](),
+				Order:             None[configdomain.Order](),
+				PushBranches:      None[configdomain.PushBranches](),
+				Stash:             None[configdomain.Stash](),
+				Verbose:           verbose,
+			})
+			return executeRunLogRestore(args, cliConfig)
+		},
+	}
+	addDryRunFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeRunLogRestore(args []string, cliConfig configdomain.PartialConfig) error {
+Start:
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		CliConfig:        cliConfig,
+		IgnoreUnknown:    false,
+		PrintBranchNames: true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+	})
+	if err != nil {
+		return err
+	}
+	entries, err := runlog.Load(runlog.NewRunlogPath(repo.ConfigDir))
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		showRunLogEntries(entries)
+		print.Footer(repo.UnvalidatedConfig.NormalConfig.Verbose, repo.CommandsCounter.Immutable(), []string{})
+		return nil
+	}
+	data, flow, err := determineRunLogRestoreData(args[0], entries, repo)
+	if err != nil {
+		return err
+	}
+	switch flow {
+	case configdomain.ProgramFlowContinue:
+	case configdomain.ProgramFlowExit:
+		return nil
+	case configdomain.ProgramFlowRestart:
+		goto Start
+	}
+	if len(data.localChanges) == 0 && len(data.remoteChanges) == 0 {
+		fmt.Printf(messages.RunlogRestoreNothingToDo, data.index)
+		print.Footer(repo.UnvalidatedConfig.NormalConfig.Verbose, repo.CommandsCounter.Immutable(), []string{})
+		return nil
+	}
+	showRunLogRestorePreview(data)
+	runProgram := runLogRestoreProgram(data)
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: data.branchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        data.stashSize,
+		BranchInfosLastRun:    data.branchInfosLastRun,
+		Command:               "runlog restore",
+		DryRun:                data.config.NormalConfig.DryRun,
+		EndBranchesSnapshot:   None[gitdomain.BranchesSnapshot](),
+		EndConfigSnapshot:     None[configdomain.EndConfigSnapshot](),
+		EndStashSize:          None[gitdomain.StashSize](),
+		RunProgram:            runProgram,
+		TouchedBranches:       runLogRestoreTouchedBranches(data),
+		UndoAPIProgram:        program.Program{},
+	}
+	return fullinterpreter.Execute(fullinterpreter.ExecuteArgs{
+		Backend:                 repo.Backend,
+		CommandsCounter:         repo.CommandsCounter,
+		Config:                  data.config,
+		ConfigDir:               repo.ConfigDir,
+		Connector:               data.connector,
+		DryRun:                  data.config.NormalConfig.DryRun,
+		FinalMessages:           repo.FinalMessages,
+		Frontend:                repo.Frontend,
+		Git:                     repo.Git,
+		HasOpenChanges:          data.hasOpenChanges,
+		InitialBranch:           data.initialBranch,
+		InitialBranchesSnapshot: data.branchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        data.stashSize,
+		Inputs:                  data.inputs,
+		PendingCommand:          None[string](),
+		RunState:                runState,
+	})
+}
+
+type runLogRestoreData struct {
+	branchInfosLastRun Option[gitdomain.BranchInfos]
+	branchesSnapshot   gitdomain.BranchesSnapshot
+	config             config.ValidatedConfig
+	connector          Option[forgedomain.Connector]
+	entry              runlog.Entry
+	hasOpenChanges     bool
+	index              int
+	initialBranch      gitdomain.LocalBranchName
+	inputs             dialogcomponents.Inputs
+	localChanges       []runLogRestoreLocalChange  // the local branches to restore, sorted by name
+	remoteChanges      []runLogRestoreRemoteChange // the remote branches to restore, sorted by name
+	stashSize          gitdomain.StashSize
+}
+
+// runLogRestoreLocalChange describes how to restore a local branch
+type runLogRestoreLocalChange struct {
+	branch     gitdomain.LocalBranchName
+	currentSHA Option[gitdomain.SHA] // None if the branch doesn't exist right now
+	restoreSHA gitdomain.SHA
+}
+
+// runLogRestoreRemoteChange describes how to restore a remote branch
+type runLogRestoreRemoteChange struct {
+	branch     gitdomain.RemoteBranchName
+	currentSHA Option[gitdomain.SHA] // None if the branch doesn't exist right now
+	localSHA   Option[gitdomain.SHA] // the SHA of the local branch in the runlog entry
+	restoreSHA gitdomain.SHA
+}
+
+func determineRunLogRestoreData(indexText string, entries []runlog.Entry, repo execute.OpenRepoResult) (runLogRestoreData, configdomain.ProgramFlow, error) {
+	var emptyResult runLogRestoreData
+	index, err := strconv.Atoi(indexText)
+	if err != nil || index < 1 || index > len(entries) {
+		return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.RunlogRestoreIndexInvalid, indexText, len(entries))
+	}
+	entry := entries[len(entries)-index]
+	inputs := dialogcomponents.LoadInputs(os.Environ())
+	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	config := repo.UnvalidatedConfig.NormalConfig
+	connector, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
+		Backend:              repo.Backend,
+		BitbucketAppPassword: config.BitbucketAppPassword,
+		BitbucketUsername:    config.BitbucketUsername,
+		Browser:              config.Browser,
+		ConfigDir:            repo.ConfigDir,
+		ForgeType:            config.ForgeType,
+		ForgejoToken:         config.ForgejoToken,
+		Frontend:             repo.Frontend,
+		GiteaToken:           config.GiteaToken,
+		GithubConnectorType:  config.GithubConnectorType,
+		GithubToken:          config.GithubToken,
+		GitlabConnectorType:  config.GitlabConnectorType,
+		GitlabToken:          config.GitlabToken,
+		Log:                  print.Logger{},
+		RemoteURL:            config.DevURL(repo.Backend),
+	})
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	branchesSnapshot, stashSize, branchInfosLastRun, flow, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		Backend:               repo.Backend,
+		CommandsCounter:       repo.CommandsCounter,
+		ConfigSnapshot:        repo.ConfigSnapshot,
+		Connector:             connector,
+		Fetch:                 false,
+		FinalMessages:         repo.FinalMessages,
+		Frontend:              repo.Frontend,
+		Git:                   repo.Git,
+		HandleUnfinishedState: true,
+		Inputs:                inputs,
+		Repo:                  repo,
+		RepoStatus:            repoStatus,
+		RootDir:               repo.RootDir,
+		UnvalidatedConfig:     repo.UnvalidatedConfig,
+		ValidateNoOpenChanges: false,
+	})
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	switch flow {
+	case configdomain.ProgramFlowContinue:
+	case configdomain.ProgramFlowExit, configdomain.ProgramFlowRestart:
+		return emptyResult, flow, nil
+	}
+	if branchesSnapshot.DetachedHead {
+		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.RunlogRestoreDetachedHead)
+	}
+	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
+	if !hasInitialBranch {
+		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.CurrentBranchCannotDetermine)
+	}
+	localBranches := branchesSnapshot.Branches.LocalBranches().NamesLocalBranches()
+	branchesAndTypes := repo.UnvalidatedConfig.UnvalidatedBranchesAndTypes(localBranches)
+	remotes, err := repo.Git.Remotes(repo.Backend)
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
+		Backend:            repo.Backend,
+		BranchInfos:        branchesSnapshot.Branches,
+		BranchesAndTypes:   branchesAndTypes,
+		BranchesToValidate: gitdomain.LocalBranchNames{},
+		ConfigDir:          repo.ConfigDir,
+		ConfigSnapshot:     repo.ConfigSnapshot,
+		Connector:          connector,
+		Frontend:           repo.Frontend,
+		Git:                repo.Git,
+		Inputs:             inputs,
+		LocalBranches:      localBranches,
+		Remotes:            remotes,
+		RepoStatus:         repoStatus,
+		Unvalidated:        NewMutable(&repo.UnvalidatedConfig),
+	})
+	if err != nil || exit {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	localChanges := []runLogRestoreLocalChange{}
+	entryLocalBranches := entry.LocalBranches(remotes)
+	for _, branch := range slices.Sorted(maps.Keys(entryLocalBranches)) {
+		restoreSHA := entryLocalBranches[branch]
+		currentSHA := None[gitdomain.SHA]()
+		if branchInfo, hasBranchInfo := branchesSnapshot.Branches.FindByLocalName(branch).Get(); hasBranchInfo {
+			currentSHA = branchInfo.LocalSHA()
+		}
+		if currentSHA.EqualSome(restoreSHA) {
+			continue
+		}
+		localChanges = append(localChanges, runLogRestoreLocalChange{
+			branch:     branch,
+			currentSHA: currentSHA,
+			restoreSHA: restoreSHA,
+		})
+	}
+	remoteChanges := []runLogRestoreRemoteChange{}
+	if validatedConfig.NormalConfig.Offline.IsOnline() {
+		entryRemoteBranches := entry.RemoteBranches(remotes)
+		for _, branch := range slices.Sorted(maps.Keys(entryRemoteBranches)) {
+			if branch.Remote() != validatedConfig.NormalConfig.DevRemote {
+				continue
+			}
+			restoreSHA := entryRemoteBranches[branch]
+			currentSHA := None[gitdomain.SHA]()
+			if branchInfo, hasBranchInfo := branchesSnapshot.Branches.FindByRemoteName(branch).Get(); hasBranchInfo {
+				currentSHA = branchInfo.RemoteSHA
+			}
+			if currentSHA.EqualSome(restoreSHA) {
+				continue
+			}
+			// we can't force-push to perennial branches
+			if currentSHA.IsSome() && validatedConfig.IsMainOrPerennialBranch(branch.LocalBranchName()) {
+				continue
+			}
+			localSHA := None[gitdomain.SHA]()
+			if entryLocalSHA, hasEntryLocalSHA := entryLocalBranches[branch.LocalBranchName()]; hasEntryLocalSHA {
+				localSHA = Some(entryLocalSHA)
+			}
+			remoteChanges = append(remoteChanges, runLogRestoreRemoteChange{
+				branch:     branch,
+				currentSHA: currentSHA,
+				localSHA:   localSHA,
+				restoreSHA: restoreSHA,
+			})
+		}
+	}
+	return runLogRestoreData{
+		branchInfosLastRun: branchInfosLastRun,
+		branchesSnapshot:   branchesSnapshot,
+		config:             validatedConfig,
+		connector:          connector,
+		entry:              entry,
+		hasOpenChanges:     repoStatus.OpenChanges,
+		index:              index,
+		initialBranch:      initialBranch,
+		inputs:             inputs,
+		localChanges:       localChanges,
+		remoteChanges:      remoteChanges,
+		stashSize:          stashSize,
+	}, configdomain.ProgramFlowContinue, nil
+}
+
+func runLogRestoreProgram(data runLogRestoreData) program.Program {
+	prog := NewMutable(&program.Program{})
+	for _, change := range data.localChanges {
+		if change.currentSHA.IsSome() {
+			prog.Value.Add(
+				&opcodes.CheckoutIfNeeded{Branch: change.branch},
+				&opcodes.BranchCurrentResetToSHA{SHA: change.restoreSHA},
+			)
+		} else {
+			prog.Value.Add(&opcodes.BranchCreate{Branch: change.branch, StartingPoint: change.restoreSHA.Location()})
+		}
+	}
+	for _, change := range data.remoteChanges {
+		localBranch := change.branch.LocalBranchName()
+		switch {
+		case change.currentSHA.IsSome():
+			prog.Value.Add(&opcodes.BranchRemoteSetToSHA{Branch: change.branch, SetToSHA: change.restoreSHA})
+		case change.localSHA.EqualSome(change.restoreSHA):
+			prog.Value.Add(&opcodes.BranchTrackingCreate{Branch: localBranch})
+		default:
+			prog.Value.Add(&opcodes.BranchRemoteCreate{Branch: localBranch, SHA: change.restoreSHA})
+		}
+	}
+	prog.Value.Add(&opcodes.CheckoutIfNeeded{Branch: data.initialBranch})
+	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
+		DryRun:                   data.config.NormalConfig.DryRun,
+		InitialStashSize:         data.stashSize,
+		RunInGitRoot:             true,
+		StashOpenChanges:         data.hasOpenChanges,
+		PreviousBranchCandidates: []Option[gitdomain.LocalBranchName]{Some(data.initialBranch)},
+	})
+	return prog.Immutable()
+}
+
+// runLogRestoreTouchedBranches provides all branches that restoring the given data changes
+func runLogRestoreTouchedBranches(data runLogRestoreData) gitdomain.BranchNames {
+	result := gitdomain.BranchNames{}
+	for _, change := range data.localChanges {
+		result = append(result, change.branch.BranchName())
+	}
+	for _, change := range data.remoteChanges {
+		result = append(result, change.branch.BranchName())
+	}
+	return result
+}
+
+// showRunLogEntries prints the given runlog entries, most recent first
+func showRunLogEntries(entries []runlog.Entry) {
+	if len(entries) == 0 {
+		fmt.Println(messages.RunLogDoesntExist)
+		return
+	}
+	fmt.Print(messages.RunlogEntries)
+	for e := range entries {
+		entry := entries[len(entries)-1-e]
+		fmt.Printf(messages.RunlogEntry, e+1, entry.Time.Format("2006-01-02 15:04:05"), entry.Event, entry.Command)
+	}
+}
+
+// showRunLogRestorePreview prints which branches the restore will move
+func showRunLogRestorePreview(data runLogRestoreData) {
+	fmt.Printf(messages.RunlogRestorePreview, data.index, data.entry.Event, data.entry.Command)
+	for _, change := range data.localChanges {
+		printRunLogRestoreChange(change.branch.String(), change.currentSHA, change.restoreSHA)
+	}
+	for _, change := range data.remoteChanges {
+		printRunLogRestoreChange(change.branch.String(), change.currentSHA, change.restoreSHA)
+	}
+	fmt.Println()
+}
+
+func printRunLogRestoreChange(branch string, currentSHA Option[gitdomain.SHA], restoreSHA gitdomain.SHA) {
+	if current, hasCurrent := currentSHA.Get(); hasCurrent {
+		fmt.Printf(messages.RunlogRestoreBranchChange, branch, current.Truncate(7), restoreSHA.Truncate(7))
+	} else {
+		fmt.Printf(messages.RunlogRestoreBranchCreate, branch, restoreSHA.Truncate(7))
+	}
+}
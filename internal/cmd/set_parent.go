@@ -249,6 +249,7 @@ func determineSetParentData(repo execute.OpenRepoResult) (setParentData, configd
 	}
 	config := repo.UnvalidatedConfig.NormalConfig
 	connector, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
 		Backend:              repo.Backend,
 		BitbucketAppPassword: config.BitbucketAppPassword,
 		BitbucketUsername:    config.BitbucketUsername,
@@ -380,7 +381,7 @@ func setParentProgram(newParentOpt Option[gitdomain.LocalBranchName], data setPa
 			})
 		}
 		// update commits
-		switch data.config.NormalConfig.SyncFeatureStrategy {
+		switch data.config.NormalConfig.SyncFeatureStrategyFor(data.initialBranch) {
 		case configdomain.SyncFeatureStrategyMerge:
 			// don't update commits when using the "merge" sync strategy
 		case configdomain.SyncFeatureStrategyCompress, configdomain.SyncFeatureStrategyRebase:
@@ -1,7 +1,6 @@
 package ship
 
 import (
-	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v22/internal/execute"
 	"github.com/git-town/git-town/v22/internal/git/gitdomain"
 	"github.com/git-town/git-town/v22/internal/vm/opcodes"
@@ -41,16 +40,10 @@ func shipProgramAlwaysMerge(repo execute.OpenRepoResult, args shipProgramAlwaysM
 		args.prog.Value.Add(&opcodes.LineageParentSetToGrandParent{Branch: child})
 	}
 	args.prog.Value.Add(&opcodes.LineageParentRemove{Branch: args.sharedData.branchToShip})
+	removeBranchOverrides(args.prog, args.sharedData)
 	if !args.sharedData.isShippingInitialBranch {
 		args.prog.Value.Add(&opcodes.CheckoutIfNeeded{Branch: args.sharedData.initialBranch})
 	}
+	removeWorktree(args.prog, args.sharedData)
 	args.prog.Value.Add(&opcodes.BranchLocalDelete{Branch: args.sharedData.branchToShip})
-	previousBranchCandidates := []Option[gitdomain.LocalBranchName]{args.sharedData.previousBranch}
-	cmdhelpers.Wrap(args.prog, cmdhelpers.WrapOptions{
-		DryRun:                   repo.UnvalidatedConfig.NormalConfig.DryRun,
-		InitialStashSize:         args.sharedData.stashSize,
-		RunInGitRoot:             true,
-		StashOpenChanges:         !args.sharedData.isShippingInitialBranch && args.sharedData.hasOpenChanges,
-		PreviousBranchCandidates: previousBranchCandidates,
-	})
 }
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v22/internal/execute"
 	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
 	"github.com/git-town/git-town/v22/internal/git/gitdomain"
@@ -34,7 +33,7 @@ func determineAPIData(sharedData sharedShipData) (shipDataAPI, error) {
 	if !canFindProposals {
 		return shipDataAPI{}, errors.New(messages.ShipAPIConnectorUnsupported)
 	}
-	proposalOpt, err := proposalFinder.FindProposal(sharedData.branchToShip, sharedData.targetBranchName)
+	proposalOpt, err := proposalFinder.FindProposal(sharedData.branchToShip, sharedData.parentBranchName)
 	proposal, hasProposal := proposalOpt.Get()
 	if !hasProposal {
 		return shipDataAPI{}, fmt.Errorf(messages.ShipAPINoProposal, sharedData.branchToShip)
@@ -60,13 +59,15 @@ func shipAPIProgram(prog Mutable[program.Program], repo execute.OpenRepoResult,
 	}
 	prog.Value.Add(&opcodes.ConnectorProposalMerge{
 		Branch:        branchToShipLocal,
-		Proposal:      apiData.proposal,
 		CommitMessage: commitMessage,
+		MergeMethod:   sharedData.config.NormalConfig.ShipAPIMergeMethod,
+		Proposal:      apiData.proposal,
 	})
 	if sharedData.config.NormalConfig.ShipDeleteTrackingBranch {
 		prog.Value.Add(&opcodes.BranchTrackingDelete{Branch: apiData.branchToShipRemoteName})
 	}
 	if hasLocalBranchToShip {
+		removeWorktree(prog, sharedData)
 		prog.Value.Add(&opcodes.BranchLocalDelete{Branch: branchToShipLocal})
 	}
 	for _, child := range sharedData.childBranches {
@@ -74,14 +75,23 @@ func shipAPIProgram(prog Mutable[program.Program], repo execute.OpenRepoResult,
 	}
 	if !repo.UnvalidatedConfig.NormalConfig.DryRun {
 		prog.Value.Add(&opcodes.LineageParentRemove{Branch: branchToShipLocal})
+		removeBranchOverrides(prog, sharedData)
+	}
+	return nil
+}
+
+// shipAPIAutoMergeProgram lets the forge merge the proposal of the branch to ship once it is ready.
+// The branch remains in place until the forge merges it, "git town sync" removes it afterwards.
+func shipAPIAutoMergeProgram(prog Mutable[program.Program], sharedData sharedShipData, apiData shipDataAPI, commitMessage Option[gitdomain.CommitMessage]) error {
+	_, canAutoMergeProposals := apiData.connector.(forgedomain.ProposalAutoMerger)
+	if !canAutoMergeProposals {
+		return errors.New(messages.ShipAPIAutoMergeUnsupported)
 	}
-	previousBranchCandidates := []Option[gitdomain.LocalBranchName]{sharedData.previousBranch}
-	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
-		DryRun:                   repo.UnvalidatedConfig.NormalConfig.DryRun,
-		InitialStashSize:         sharedData.stashSize,
-		RunInGitRoot:             true,
-		StashOpenChanges:         !sharedData.isShippingInitialBranch && sharedData.hasOpenChanges,
-		PreviousBranchCandidates: previousBranchCandidates,
+	prog.Value.Add(&opcodes.ConnectorProposalAutoMerge{
+		Branch:        sharedData.branchToShip,
+		CommitMessage: commitMessage,
+		MergeMethod:   sharedData.config.NormalConfig.ShipAPIMergeMethod,
+		Proposal:      apiData.proposal,
 	})
 	return nil
 }
@@ -38,6 +38,8 @@ How exactly this happen depends on the configured ship-strategy.
 Ships only direct children of the main branch.
 To ship a child branch, ship or delete all ancestor branches first
 or ship with the "--to-parent" flag.
+To ship the current branch together with all its ancestors,
+starting at the bottom of the stack, use the "--stack" flag.
 
 To use the online functionality,
 configure a personal access token with the "repo" scope
@@ -53,6 +55,7 @@ func Cmd() *cobra.Command {
 	addMessageFileFlag, readMessageFileFlag := flags.CommitMessageFile()
 	addMessageFlag, readMessageFlag := flags.CommitMessage("specify the commit message for the squash commit")
 	addShipStrategyFlag, readShipStrategyFlag := flags.ShipStrategy()
+	addStackFlag, readStackFlag := flags.ShipStack()
 	addToParentFlag, readToParentFlag := flags.ShipIntoNonPerennialParent()
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	cmd := cobra.Command{
@@ -66,9 +69,10 @@ func Cmd() *cobra.Command {
 			message, errMessage := readMessageFlag(cmd)
 			messageFile, errMessageFile := readMessageFileFlag(cmd)
 			shipStrategy, errShipStrategy := readShipStrategyFlag(cmd)
+			stack, errStack := readStackFlag(cmd)
 			toParent, errToParent := readToParentFlag(cmd)
 			verbose, errVerbose := readVerboseFlag(cmd)
-			if err := cmp.Or(errDryRun, errIgnoreUncommitted, errMessage, errMessageFile, errShipStrategy, errToParent, errVerbose); err != nil {
+			if err := cmp.Or(errDryRun, errIgnoreUncommitted, errMessage, errMessageFile, errShipStrategy, errStack, errToParent, errVerbose); err != nil {
 				return err
 			}
 			cliConfig := cliconfig.New(cliconfig.NewArgs{
@@ -89,6 +93,7 @@ func Cmd() *cobra.Command {
 				message:      message,
 				messageFile:  messageFile,
 				shipStrategy: shipStrategy,
+				stack:        stack,
 				toParent:     toParent,
 			})
 		},
@@ -98,6 +103,7 @@ func Cmd() *cobra.Command {
 	addIgnoreUncommittedFlag(&cmd)
 	addMessageFlag(&cmd)
 	addShipStrategyFlag(&cmd)
+	addStackFlag(&cmd)
 	addToParentFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
@@ -109,6 +115,7 @@ type executeShipArgs struct {
 	message      Option[gitdomain.CommitMessage]
 	messageFile  Option[gitdomain.CommitMessageFile]
 	shipStrategy Option[configdomain.ShipStrategy]
+	stack        configdomain.FullStack
 	toParent     configdomain.ShipIntoNonperennialParent
 }
 
@@ -144,51 +151,65 @@ Start:
 	if err != nil {
 		return err
 	}
-	if err = validateSharedData(sharedData, args.toParent, message); err != nil {
-		return err
-	}
-	oldClan := sharedData.config.NormalConfig.Lineage.Clan(gitdomain.LocalBranchNames{sharedData.initialBranch}, sharedData.config.MainAndPerennials())
-	prog := NewMutable(&program.Program{})
-	switch sharedData.config.NormalConfig.ShipStrategy {
-	case configdomain.ShipStrategyAPI:
-		apiData, err := determineAPIData(sharedData)
-		if err != nil {
-			return err
+	branchesToShip := []sharedShipData{sharedData}
+	if args.stack.Enabled() {
+		if len(args.args) > 0 {
+			return errors.New(messages.ShipStackWithBranch)
 		}
-		if err = shipAPIProgram(prog, repo, sharedData, apiData, message); err != nil {
-			return err
+		if message.IsSome() {
+			return errors.New(messages.ShipStackWithMessage)
 		}
-	case configdomain.ShipStrategyAlwaysMerge:
-		mergeData, err := determineMergeData(repo, sharedData.branchToShip, sharedData.targetBranchName)
+		if sharedData.config.NormalConfig.ShipStrategyFor(sharedData.branchToShip) == configdomain.ShipStrategyAPIAutoMerge {
+			return errors.New(messages.ShipStackWithAutoMerge)
+		}
+		branchesToShip, err = determineStackShipData(repo, sharedData)
 		if err != nil {
 			return err
 		}
-		shipProgramAlwaysMerge(repo, shipProgramAlwaysMergeArgs{
-			commitMessage: message,
-			mergeData:     mergeData,
-			prog:          prog,
-			sharedData:    sharedData,
-		})
-	case configdomain.ShipStrategyFastForward:
-		mergeData, err := determineMergeData(repo, sharedData.branchToShip, sharedData.targetBranchName)
-		if err != nil {
+		// other branches in the stack might override the ship strategy
+		for _, branchData := range branchesToShip {
+			if branchData.config.NormalConfig.ShipStrategyFor(branchData.branchToShip) == configdomain.ShipStrategyAPIAutoMerge {
+				return errors.New(messages.ShipStackWithAutoMerge)
+			}
+		}
+	}
+	for _, branchData := range branchesToShip {
+		if err = validateSharedData(branchData, args.toParent, message); err != nil {
 			return err
 		}
-		shipProgramFastForward(prog, repo, sharedData, mergeData)
-	case configdomain.ShipStrategySquashMerge:
-		squashMergeData, err := determineMergeData(repo, sharedData.branchToShip, sharedData.targetBranchName)
-		if err != nil {
+	}
+	oldClan := sharedData.config.NormalConfig.Lineage.Clan(gitdomain.LocalBranchNames{sharedData.initialBranch}, sharedData.config.MainAndPerennials())
+	prog := NewMutable(&program.Program{})
+	shippedBranches := make(gitdomain.LocalBranchNames, 0, len(branchesToShip))
+	for _, branchData := range branchesToShip {
+		if err = shipProgram(prog, repo, branchData, message); err != nil {
 			return err
 		}
-		shipProgramSquashMerge(prog, repo, sharedData, squashMergeData, message)
+		if args.stack.Enabled() {
+			prog.Value.Add(&opcodes.ProgramEndOfBranch{})
+		}
+		shippedBranches = append(shippedBranches, branchData.branchToShip)
 	}
+	if args.stack.Enabled() {
+		// return to the initial branch if skipping a branch left it unshipped
+		prog.Value.Add(&opcodes.CheckoutIfExists{Branch: sharedData.initialBranch})
+	}
+	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
+		DryRun:                   repo.UnvalidatedConfig.NormalConfig.DryRun,
+		InitialStashSize:         sharedData.stashSize,
+		RunInGitRoot:             true,
+		StashOpenChanges:         !sharedData.isShippingInitialBranch && sharedData.hasOpenChanges,
+		PreviousBranchCandidates: []Option[gitdomain.LocalBranchName]{sharedData.previousBranch},
+	})
 	updateBreadcrumb := sharedData.config.NormalConfig.ProposalBreadcrumb.Enabled()
 	isOnline := sharedData.config.NormalConfig.Offline.IsOnline()
-	if updateBreadcrumb && isOnline {
+	// when auto-merging, the shipped branches remain in the lineage until the forge merges them
+	isAutoMerge := sharedData.config.NormalConfig.ShipStrategyFor(sharedData.branchToShip) == configdomain.ShipStrategyAPIAutoMerge
+	if updateBreadcrumb && isOnline && !isAutoMerge {
 		programs.UpdateBreadcrumbsProgram(programs.UpdateBreadcrumbsArgs{
 			Config:          sharedData.config,
 			Program:         prog,
-			TouchedBranches: oldClan.Remove(sharedData.initialBranch),
+			TouchedBranches: oldClan.Remove(shippedBranches...),
 		})
 	}
 	optimizedProgram := optimizer.Optimize(prog.Immutable())
@@ -206,6 +227,11 @@ Start:
 		TouchedBranches:       optimizedProgram.TouchedBranches(),
 		UndoAPIProgram:        program.Program{},
 	}
+	for _, branchData := range branchesToShip {
+		if worktreeToRemove, hasWorktreeToRemove := branchData.worktreeToRemove.Get(); hasWorktreeToRemove {
+			runState.Worktrees = append(runState.Worktrees, worktreeToRemove)
+		}
+	}
 	return fullinterpreter.Execute(fullinterpreter.ExecuteArgs{
 		Backend:                 repo.Backend,
 		CommandsCounter:         repo.CommandsCounter,
@@ -227,6 +253,52 @@ Start:
 	})
 }
 
+// shipProgram adds the opcodes to ship the given branch using the configured ship strategy to the given program.
+func shipProgram(prog Mutable[program.Program], repo execute.OpenRepoResult, branchData sharedShipData, message Option[gitdomain.CommitMessage]) error {
+	switch branchData.config.NormalConfig.ShipStrategyFor(branchData.branchToShip) {
+	case configdomain.ShipStrategyAPI:
+		apiData, err := determineAPIData(branchData)
+		if err != nil {
+			return err
+		}
+		if err = shipAPIProgram(prog, repo, branchData, apiData, message); err != nil {
+			return err
+		}
+	case configdomain.ShipStrategyAPIAutoMerge:
+		apiData, err := determineAPIData(branchData)
+		if err != nil {
+			return err
+		}
+		if err = shipAPIAutoMergeProgram(prog, branchData, apiData, message); err != nil {
+			return err
+		}
+	case configdomain.ShipStrategyAlwaysMerge:
+		mergeData, err := determineMergeData(repo, branchData.branchToShip, branchData.parentBranchName)
+		if err != nil {
+			return err
+		}
+		shipProgramAlwaysMerge(repo, shipProgramAlwaysMergeArgs{
+			commitMessage: message,
+			mergeData:     mergeData,
+			prog:          prog,
+			sharedData:    branchData,
+		})
+	case configdomain.ShipStrategyFastForward:
+		mergeData, err := determineMergeData(repo, branchData.branchToShip, branchData.parentBranchName)
+		if err != nil {
+			return err
+		}
+		shipProgramFastForward(prog, repo, branchData, mergeData)
+	case configdomain.ShipStrategySquashMerge:
+		squashMergeData, err := determineMergeData(repo, branchData.branchToShip, branchData.parentBranchName)
+		if err != nil {
+			return err
+		}
+		shipProgramSquashMerge(prog, repo, branchData, squashMergeData, message)
+	}
+	return nil
+}
+
 func UpdateChildBranchProposalsToGrandParent(prog *program.Program, proposals []forgedomain.Proposal) {
 	for _, childProposal := range proposals {
 		data := childProposal.Data.Data()
@@ -239,7 +311,7 @@ func UpdateChildBranchProposalsToGrandParent(prog *program.Program, proposals []
 }
 
 func validateSharedData(data sharedShipData, toParent configdomain.ShipIntoNonperennialParent, message Option[gitdomain.CommitMessage]) error {
-	if data.config.NormalConfig.ShipStrategy == configdomain.ShipStrategyFastForward && message.IsSome() {
+	if data.config.NormalConfig.ShipStrategyFor(data.branchToShip) == configdomain.ShipStrategyFastForward && message.IsSome() {
 		return errors.New(messages.ShipMessageWithFastForward)
 	}
 	if !toParent {
@@ -1,9 +1,7 @@
 package ship
 
 import (
-	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v22/internal/execute"
-	"github.com/git-town/git-town/v22/internal/git/gitdomain"
 	"github.com/git-town/git-town/v22/internal/vm/opcodes"
 	"github.com/git-town/git-town/v22/internal/vm/program"
 	. "github.com/git-town/git-town/v22/pkg/prelude"
@@ -35,17 +33,11 @@ func shipProgramFastForward(prog Mutable[program.Program], repo execute.OpenRepo
 	}
 	if !repo.UnvalidatedConfig.NormalConfig.DryRun {
 		prog.Value.Add(&opcodes.LineageParentRemove{Branch: sharedData.branchToShip})
+		removeBranchOverrides(prog, sharedData)
 	}
 	if !sharedData.isShippingInitialBranch {
 		prog.Value.Add(&opcodes.CheckoutIfNeeded{Branch: sharedData.initialBranch})
 	}
+	removeWorktree(prog, sharedData)
 	prog.Value.Add(&opcodes.BranchLocalDelete{Branch: sharedData.branchToShip})
-	previousBranchCandidates := []Option[gitdomain.LocalBranchName]{sharedData.previousBranch}
-	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
-		DryRun:                   repo.UnvalidatedConfig.NormalConfig.DryRun,
-		InitialStashSize:         sharedData.stashSize,
-		RunInGitRoot:             true,
-		StashOpenChanges:         !sharedData.isShippingInitialBranch && sharedData.hasOpenChanges,
-		PreviousBranchCandidates: previousBranchCandidates,
-	})
 }
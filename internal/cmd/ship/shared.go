@@ -7,6 +7,7 @@ import (
 
 	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogcomponents"
 	"github.com/git-town/git-town/v22/internal/cli/print"
+	"github.com/git-town/git-town/v22/internal/cmd/worktree"
 	"github.com/git-town/git-town/v22/internal/config"
 	"github.com/git-town/git-town/v22/internal/config/configdomain"
 	"github.com/git-town/git-town/v22/internal/execute"
@@ -14,12 +15,16 @@ import (
 	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
 	"github.com/git-town/git-town/v22/internal/git/gitdomain"
 	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/programs"
 	"github.com/git-town/git-town/v22/internal/validate"
+	"github.com/git-town/git-town/v22/internal/vm/opcodes"
+	"github.com/git-town/git-town/v22/internal/vm/program"
 	. "github.com/git-town/git-town/v22/pkg/prelude"
 )
 
 // data that all ship strategies use
 type sharedShipData struct {
+	branchOverrides          configdomain.BranchOverrides // the settings specific to branches stored in the local Git metadata
 	branchToShip             gitdomain.LocalBranchName
 	branchToShipInfo         gitdomain.BranchInfo
 	branchesSnapshot         gitdomain.BranchesSnapshot
@@ -30,12 +35,14 @@ type sharedShipData struct {
 	initialBranch            gitdomain.LocalBranchName
 	inputs                   dialogcomponents.Inputs
 	isShippingInitialBranch  bool
+	parentBranchName         gitdomain.LocalBranchName // the parent of the branch to ship, differs from targetBranchName when shipping a stack
 	previousBranch           Option[gitdomain.LocalBranchName]
 	previousBranchInfos      Option[gitdomain.BranchInfos]
 	proposalsOfChildBranches []forgedomain.Proposal
 	stashSize                gitdomain.StashSize
 	targetBranch             gitdomain.BranchInfo
 	targetBranchName         gitdomain.LocalBranchName
+	worktreeToRemove         Option[gitdomain.Worktree] // the worktree that Git Town manages for the branch to ship
 }
 
 type determineSharedShipDataArgs struct {
@@ -53,6 +60,7 @@ func determineSharedShipData(args determineSharedShipDataArgs) (sharedShipData,
 	}
 	config := args.repo.UnvalidatedConfig.NormalConfig
 	connector, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
 		Backend:              args.repo.Backend,
 		BitbucketAppPassword: config.BitbucketAppPassword,
 		BitbucketUsername:    config.BitbucketUsername,
@@ -116,12 +124,21 @@ func determineSharedShipData(args determineSharedShipDataArgs) (sharedShipData,
 	} else {
 		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.ShipNoBranchToShip)
 	}
-	branchToShipInfo, hasBranchToShipInfo := branchesSnapshot.Branches.FindByLocalName(branchToShip).Get()
+	branchToShipInfoPtr, hasBranchToShipInfo := branchesSnapshot.Branches.FindByLocalName(branchToShip).Get()
 	if !hasBranchToShipInfo {
 		return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.BranchDoesntExist, branchToShip)
 	}
+	branchToShipInfo := *branchToShipInfoPtr
+	worktreeToRemove := None[gitdomain.Worktree]()
 	if branchToShipInfo.SyncStatus == gitdomain.SyncStatusOtherWorktree {
-		return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.ShipBranchOtherWorktree, branchToShip)
+		worktreeToRemove, err = worktree.RemovableWorktree(args.repo, branchToShip)
+		if err != nil {
+			return emptyResult, configdomain.ProgramFlowExit, err
+		}
+		if worktreeToRemove.IsNone() {
+			return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.ShipBranchOtherWorktree, branchToShip)
+		}
+		branchToShipInfo.SyncStatus = worktree.SyncStatus(branchToShipInfo)
 	}
 	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
 	if !hasInitialBranch {
@@ -155,20 +172,10 @@ func determineSharedShipData(args determineSharedShipDataArgs) (sharedShipData,
 	}
 	if shipStrategyOverride, hasShipStrategyOverride := args.shipStrategyOverride.Get(); hasShipStrategyOverride {
 		validatedConfig.NormalConfig.ShipStrategy = shipStrategyOverride
+		validatedConfig.NormalConfig.BranchOverrides = validatedConfig.NormalConfig.BranchOverrides.Without(configdomain.KeyShipStrategy)
 	}
-	switch validatedConfig.BranchType(branchToShip) {
-	case configdomain.BranchTypeContributionBranch:
-		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.ContributionBranchCannotShip)
-	case configdomain.BranchTypeMainBranch:
-		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.MainBranchCannotShip)
-	case configdomain.BranchTypeObservedBranch:
-		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.ObservedBranchCannotShip)
-	case configdomain.BranchTypePerennialBranch:
-		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.PerennialBranchCannotShip)
-	case
-		configdomain.BranchTypeFeatureBranch,
-		configdomain.BranchTypeParkedBranch,
-		configdomain.BranchTypePrototypeBranch:
+	if err := validateShippableBranchType(validatedConfig.BranchType(branchToShip)); err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
 	}
 	targetBranchName, hasTargetBranch := validatedConfig.NormalConfig.Lineage.Parent(branchToShip).Get()
 	if !hasTargetBranch {
@@ -188,8 +195,9 @@ func determineSharedShipData(args determineSharedShipDataArgs) (sharedShipData,
 		Order:                      validatedConfig.NormalConfig.Order,
 	})
 	return sharedShipData{
+		branchOverrides:          args.repo.UnvalidatedConfig.GitLocal.BranchOverrides,
 		branchToShip:             branchToShip,
-		branchToShipInfo:         *branchToShipInfo,
+		branchToShipInfo:         branchToShipInfo,
 		branchesSnapshot:         branchesSnapshot,
 		childBranches:            childBranches,
 		config:                   validatedConfig,
@@ -198,12 +206,14 @@ func determineSharedShipData(args determineSharedShipDataArgs) (sharedShipData,
 		initialBranch:            initialBranch,
 		inputs:                   inputs,
 		isShippingInitialBranch:  isShippingInitialBranch,
+		parentBranchName:         targetBranchName,
 		previousBranch:           previousBranch,
 		previousBranchInfos:      previousBranchInfos,
 		proposalsOfChildBranches: proposalsOfChildBranches,
 		stashSize:                stashSize,
 		targetBranch:             *targetBranch,
 		targetBranchName:         targetBranchName,
+		worktreeToRemove:         worktreeToRemove,
 	}, configdomain.ProgramFlowContinue, nil
 }
 
@@ -268,3 +278,34 @@ func FindProposal(connectorOpt Option[forgedomain.Connector], sourceBranch gitdo
 	}
 	return proposal
 }
+
+// validateShippableBranchType ensures that branches of the given type can be shipped.
+func validateShippableBranchType(branchType configdomain.BranchType) error {
+	switch branchType {
+	case configdomain.BranchTypeContributionBranch:
+		return errors.New(messages.ContributionBranchCannotShip)
+	case configdomain.BranchTypeMainBranch:
+		return errors.New(messages.MainBranchCannotShip)
+	case configdomain.BranchTypeObservedBranch:
+		return errors.New(messages.ObservedBranchCannotShip)
+	case configdomain.BranchTypePerennialBranch:
+		return errors.New(messages.PerennialBranchCannotShip)
+	case
+		configdomain.BranchTypeFeatureBranch,
+		configdomain.BranchTypeParkedBranch,
+		configdomain.BranchTypePrototypeBranch:
+	}
+	return nil
+}
+
+// removeWorktree removes the worktree that Git Town manages for the branch to ship.
+// removeBranchOverrides adds opcodes that remove the settings specific to the shipped branch.
+func removeBranchOverrides(prog Mutable[program.Program], sharedData sharedShipData) {
+	programs.RemoveBranchOverridesProgram(prog, sharedData.branchOverrides, sharedData.branchToShip)
+}
+
+func removeWorktree(prog Mutable[program.Program], sharedData sharedShipData) {
+	if worktreeToRemove, hasWorktreeToRemove := sharedData.worktreeToRemove.Get(); hasWorktreeToRemove {
+		prog.Value.Add(&opcodes.WorktreeRemove{Path: worktreeToRemove.Path})
+	}
+}
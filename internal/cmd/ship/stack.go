@@ -0,0 +1,67 @@
+package ship
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v22/internal/cmd/worktree"
+	"github.com/git-town/git-town/v22/internal/execute"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/messages"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// determineStackShipData provides the data to ship each branch of the stack that ends at the branch to ship,
+// starting at the bottom of the stack.
+// All branches get shipped into the root of the stack.
+func determineStackShipData(repo execute.OpenRepoResult, sharedData sharedShipData) ([]sharedShipData, error) {
+	lineage := sharedData.config.NormalConfig.Lineage
+	targetBranchName := lineage.Root(sharedData.branchToShip)
+	targetBranch, hasTargetBranch := sharedData.branchesSnapshot.Branches.FindByLocalName(targetBranchName).Get()
+	if !hasTargetBranch {
+		return []sharedShipData{}, fmt.Errorf(messages.BranchDoesntExist, targetBranchName)
+	}
+	stack := lineage.BranchAndAncestorsWithoutRoot(sharedData.branchToShip)
+	result := make([]sharedShipData, 0, len(stack))
+	for _, branch := range stack {
+		branchData := sharedData
+		branchData.targetBranch = *targetBranch
+		branchData.targetBranchName = targetBranchName
+		if branch != sharedData.branchToShip {
+			if err := validateShippableBranchType(sharedData.config.BranchType(branch)); err != nil {
+				return []sharedShipData{}, err
+			}
+			branchInfo, hasBranchInfo := sharedData.branchesSnapshot.Branches.FindByLocalName(branch).Get()
+			if !hasBranchInfo {
+				return []sharedShipData{}, fmt.Errorf(messages.BranchDoesntExist, branch)
+			}
+			branchData.branchToShip = branch
+			branchData.branchToShipInfo = *branchInfo
+			branchData.worktreeToRemove = None[gitdomain.Worktree]()
+			if branchInfo.SyncStatus == gitdomain.SyncStatusOtherWorktree {
+				worktreeToRemove, err := worktree.RemovableWorktree(repo, branch)
+				if err != nil {
+					return []sharedShipData{}, err
+				}
+				if worktreeToRemove.IsNone() {
+					return []sharedShipData{}, fmt.Errorf(messages.ShipBranchOtherWorktree, branch)
+				}
+				branchData.worktreeToRemove = worktreeToRemove
+				branchData.branchToShipInfo.SyncStatus = worktree.SyncStatus(*branchInfo)
+			}
+			branchData.childBranches = lineage.Children(branch, sharedData.config.NormalConfig.Order)
+			branchData.parentBranchName = lineage.Parent(branch).GetOr(targetBranchName)
+			branchData.proposalsOfChildBranches = LoadProposalsOfChildBranches(LoadProposalsOfChildBranchesArgs{
+				ConnectorOpt:               sharedData.connector,
+				Lineage:                    lineage,
+				Offline:                    repo.IsOffline,
+				OldBranch:                  branch,
+				OldBranchHasTrackingBranch: branchInfo.HasTrackingBranch(),
+				Order:                      sharedData.config.NormalConfig.Order,
+			})
+			// stay on the branch that the stack gets shipped into until the entire stack is shipped
+			branchData.isShippingInitialBranch = true
+		}
+		result = append(result, branchData)
+	}
+	return result, nil
+}
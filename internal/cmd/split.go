@@ -0,0 +1,451 @@
+package cmd
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/git-town/git-town/v22/internal/cli/dialog"
+	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogcomponents"
+	"github.com/git-town/git-town/v22/internal/cli/flags"
+	"github.com/git-town/git-town/v22/internal/cli/print"
+	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v22/internal/cmd/ship"
+	"github.com/git-town/git-town/v22/internal/config"
+	"github.com/git-town/git-town/v22/internal/config/cliconfig"
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/execute"
+	"github.com/git-town/git-town/v22/internal/forge"
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/state/runstate"
+	"github.com/git-town/git-town/v22/internal/validate"
+	"github.com/git-town/git-town/v22/internal/vm/interpreter/fullinterpreter"
+	"github.com/git-town/git-town/v22/internal/vm/opcodes"
+	"github.com/git-town/git-town/v22/internal/vm/optimizer"
+	"github.com/git-town/git-town/v22/internal/vm/program"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const (
+	splitDesc = "Split the current branch into a stack of smaller branches"
+	splitHelp = `
+Displays the commits of the current branch
+and lets you select a contiguous range of them
+for each of the given new branches.
+Creates the new branches as a stack
+between the parent of the current branch and the current branch.
+The current branch keeps the commits that you didn't assign to a new branch.
+
+Consider this stack:
+
+main
+ \
+* feature
+
+We are on the "feature" branch.
+After running "git town split feature-1 feature-2"
+and selecting commits for both new branches,
+our repository has this stack:
+
+main
+ \
+  feature-1
+   \
+    feature-2
+     \
+*     feature
+
+Run "git town undo" to restore the original branch.
+`
+)
+
+func splitCommand() *cobra.Command {
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "split <branch>...",
+		GroupID: cmdhelpers.GroupIDStack,
+		Args:    cobra.MinimumNArgs(1),
+		Short:   splitDesc,
+		Long:    cmdhelpers.Long(splitDesc, splitHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dryRun, errDryRun := readDryRunFlag(cmd)
+			verbose, errVerbose := readVerboseFlag(cmd)
+			if err := cmp.Or(errDryRun, errVerbose); err != nil {
+				return err
+			}
+			cliConfig := cliconfig.New(cliconfig.NewArgs{
+				AutoResolve:       None[configdomain.AutoResolve](),
+				AutoSync:          None[configdomain.AutoSync](),
+				Detached:          None[configdomain.Detached](),
+				DisplayTypes:      None[configdomain.DisplayTypes](),
+				DryRun:            dryRun,
+				IgnoreUncommitted: None[configdomain.IgnoreUncommitted](),
+				Order:             None[configdomain.Order](),
+				PushBranches:      None[configdomain.PushBranches](),
+				Stash:             None[configdomain.Stash](),
+				Verbose:           verbose,
+			})
+			return executeSplit(args, cliConfig)
+		},
+	}
+	addDryRunFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeSplit(args []string, cliConfig configdomain.PartialConfig) error {
+Start:
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		CliConfig:        cliConfig,
+		IgnoreUnknown:    false,
+		PrintBranchNames: true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+	})
+	if err != nil {
+		return err
+	}
+	data, flow, err := determineSplitData(args, repo)
+	if err != nil {
+		return err
+	}
+	switch flow {
+	case configdomain.ProgramFlowContinue:
+	case configdomain.ProgramFlowExit:
+		return nil
+	case configdomain.ProgramFlowRestart:
+		goto Start
+	}
+	runProgram := splitProgram(repo, data)
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: data.branchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        data.stashSize,
+		BranchInfosLastRun:    data.branchInfosLastRun,
+		Command:               "split",
+		DryRun:                data.config.NormalConfig.DryRun,
+		EndBranchesSnapshot:   None[gitdomain.BranchesSnapshot](),
+		EndConfigSnapshot:     None[configdomain.EndConfigSnapshot](),
+		EndStashSize:          None[gitdomain.StashSize](),
+		RunProgram:            runProgram,
+		TouchedBranches:       runProgram.TouchedBranches(),
+		UndoAPIProgram:        program.Program{},
+	}
+	return fullinterpreter.Execute(fullinterpreter.ExecuteArgs{
+		Backend:                 repo.Backend,
+		CommandsCounter:         repo.CommandsCounter,
+		Config:                  data.config,
+		ConfigDir:               repo.ConfigDir,
+		Connector:               data.connector,
+		DryRun:                  data.config.NormalConfig.DryRun,
+		FinalMessages:           repo.FinalMessages,
+		Frontend:                repo.Frontend,
+		Git:                     repo.Git,
+		HasOpenChanges:          data.hasOpenChanges,
+		InitialBranch:           data.initialBranch,
+		InitialBranchesSnapshot: data.branchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        data.stashSize,
+		Inputs:                  data.inputs,
+		PendingCommand:          None[string](),
+		RunState:                runState,
+	})
+}
+
+type splitData struct {
+	branchInfosLastRun Option[gitdomain.BranchInfos]
+	branchesSnapshot   gitdomain.BranchesSnapshot
+	commits            gitdomain.Commits // all commits of the initial branch, oldest first
+	config             config.ValidatedConfig
+	connector          Option[forgedomain.Connector]
+	hasOpenChanges     bool
+	hasTrackingBranch  bool // whether the initial branch has a tracking branch
+	initialBranch      gitdomain.LocalBranchName
+	inputs             dialogcomponents.Inputs
+	parentBranch       gitdomain.LocalBranchName
+	previousBranch     Option[gitdomain.LocalBranchName]
+	proposal           Option[forgedomain.Proposal]
+	remainingCommits   gitdomain.Commits // the commits that stay in the initial branch
+	remotes            gitdomain.Remotes
+	splits             []splitBranch
+	stashSize          gitdomain.StashSize
+}
+
+// splitBranch describes a new branch to create and the commits it receives
+type splitBranch struct {
+	commits gitdomain.Commits
+	name    gitdomain.LocalBranchName
+}
+
+func determineSplitData(args []string, repo execute.OpenRepoResult) (splitData, configdomain.ProgramFlow, error) {
+	inputs := dialogcomponents.LoadInputs(os.Environ())
+	var emptyResult splitData
+	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	config := repo.UnvalidatedConfig.NormalConfig
+	connector, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
+		Backend:              repo.Backend,
+		BitbucketAppPassword: config.BitbucketAppPassword,
+		BitbucketUsername:    config.BitbucketUsername,
+		Browser:              config.Browser,
+		ConfigDir:            repo.ConfigDir,
+		ForgeType:            config.ForgeType,
+		ForgejoToken:         config.ForgejoToken,
+		Frontend:             repo.Frontend,
+		GiteaToken:           config.GiteaToken,
+		GithubConnectorType:  config.GithubConnectorType,
+		GithubToken:          config.GithubToken,
+		GitlabConnectorType:  config.GitlabConnectorType,
+		GitlabToken:          config.GitlabToken,
+		Log:                  print.Logger{},
+		RemoteURL:            config.DevURL(repo.Backend),
+	})
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	branchesSnapshot, stashSize, branchInfosLastRun, flow, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		Backend:               repo.Backend,
+		CommandsCounter:       repo.CommandsCounter,
+		ConfigSnapshot:        repo.ConfigSnapshot,
+		Connector:             connector,
+		Fetch:                 false,
+		FinalMessages:         repo.FinalMessages,
+		Frontend:              repo.Frontend,
+		Git:                   repo.Git,
+		HandleUnfinishedState: true,
+		Inputs:                inputs,
+		Repo:                  repo,
+		RepoStatus:            repoStatus,
+		RootDir:               repo.RootDir,
+		UnvalidatedConfig:     repo.UnvalidatedConfig,
+		ValidateNoOpenChanges: false,
+	})
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	switch flow {
+	case configdomain.ProgramFlowContinue:
+	case configdomain.ProgramFlowExit, configdomain.ProgramFlowRestart:
+		return emptyResult, flow, nil
+	}
+	if branchesSnapshot.DetachedHead {
+		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.SplitDetachedHead)
+	}
+	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
+	if !hasInitialBranch {
+		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.CurrentBranchCannotDetermine)
+	}
+	initialBranchInfo, hasInitialBranchInfo := branchesSnapshot.Branches.FindByLocalName(initialBranch).Get()
+	if !hasInitialBranchInfo {
+		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.CurrentBranchCannotDetermine)
+	}
+	newBranches := make(gitdomain.LocalBranchNames, 0, len(args))
+	for _, arg := range args {
+		newBranch := gitdomain.NewLocalBranchName(arg)
+		if prefix, hasPrefix := config.BranchPrefix.Get(); hasPrefix {
+			newBranch = prefix.Apply(newBranch)
+		}
+		if newBranches.Contains(newBranch) {
+			return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.SplitBranchListedTwice, newBranch)
+		}
+		if branchesSnapshot.Branches.HasLocalBranch(newBranch) {
+			return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.BranchAlreadyExistsLocally, newBranch)
+		}
+		if branchesSnapshot.Branches.HasMatchingTrackingBranchFor(newBranch) {
+			return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.BranchAlreadyExistsRemotely, newBranch, config.DevRemote)
+		}
+		newBranches = append(newBranches, newBranch)
+	}
+	remotes, err := repo.Git.Remotes(repo.Backend)
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	localBranches := branchesSnapshot.Branches.LocalBranches().NamesLocalBranches()
+	branchesAndTypes := repo.UnvalidatedConfig.UnvalidatedBranchesAndTypes(localBranches)
+	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
+		Backend:            repo.Backend,
+		BranchInfos:        branchesSnapshot.Branches,
+		BranchesAndTypes:   branchesAndTypes,
+		BranchesToValidate: gitdomain.LocalBranchNames{initialBranch},
+		ConfigDir:          repo.ConfigDir,
+		ConfigSnapshot:     repo.ConfigSnapshot,
+		Connector:          connector,
+		Frontend:           repo.Frontend,
+		Git:                repo.Git,
+		Inputs:             inputs,
+		LocalBranches:      localBranches,
+		Remotes:            remotes,
+		RepoStatus:         repoStatus,
+		Unvalidated:        NewMutable(&repo.UnvalidatedConfig),
+	})
+	if err != nil || exit {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	switch branchType := validatedConfig.BranchType(initialBranch); branchType {
+	case
+		configdomain.BranchTypeFeatureBranch,
+		configdomain.BranchTypeParkedBranch,
+		configdomain.BranchTypePrototypeBranch:
+	case
+		configdomain.BranchTypeContributionBranch,
+		configdomain.BranchTypeObservedBranch,
+		configdomain.BranchTypeMainBranch,
+		configdomain.BranchTypePerennialBranch:
+		return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.SplitUnsupportedBranchType, branchType)
+	}
+	parentBranch, hasParentBranch := validatedConfig.NormalConfig.Lineage.Parent(initialBranch).Get()
+	if !hasParentBranch {
+		return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.SplitNoParent, initialBranch)
+	}
+	branchHasMergeCommits, err := repo.Git.BranchContainsMerges(repo.Backend, initialBranch, parentBranch)
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	if branchHasMergeCommits {
+		return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.BranchContainsMergeCommits, initialBranch)
+	}
+	commits, err := repo.Git.CommitsInFeatureBranch(repo.Backend, initialBranch, parentBranch.BranchName())
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	if len(commits) == 0 {
+		return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.SplitNoCommits, initialBranch)
+	}
+	remainingCommits := commits
+	splits := make([]splitBranch, 0, len(newBranches))
+	for _, newBranch := range newBranches {
+		selectedCommits, exit, err := dialog.CommitsToBeam(remainingCommits, newBranch, repo.Git, repo.Backend, inputs, validatedConfig.NormalConfig.DisplayDialogs)
+		if err != nil || exit {
+			return emptyResult, configdomain.ProgramFlowExit, err
+		}
+		if len(selectedCommits) == 0 {
+			return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.SplitNoCommitsSelected, newBranch)
+		}
+		var isContiguous bool
+		remainingCommits, isContiguous = removeCommitRange(remainingCommits, selectedCommits)
+		if !isContiguous {
+			return emptyResult, configdomain.ProgramFlowExit, fmt.Errorf(messages.SplitNonContiguousCommits, newBranch)
+		}
+		splits = append(splits, splitBranch{
+			commits: selectedCommits,
+			name:    newBranch,
+		})
+	}
+	proposalOpt := None[forgedomain.Proposal]()
+	if !repo.IsOffline {
+		proposalOpt = ship.FindProposal(connector, initialBranch, Some(parentBranch))
+	}
+	return splitData{
+		branchInfosLastRun: branchInfosLastRun,
+		branchesSnapshot:   branchesSnapshot,
+		commits:            commits,
+		config:             validatedConfig,
+		connector:          connector,
+		hasOpenChanges:     repoStatus.OpenChanges,
+		hasTrackingBranch:  initialBranchInfo.HasTrackingBranch(),
+		initialBranch:      initialBranch,
+		inputs:             inputs,
+		parentBranch:       parentBranch,
+		previousBranch:     repo.Git.PreviouslyCheckedOutBranch(repo.Backend),
+		proposal:           proposalOpt,
+		remainingCommits:   remainingCommits,
+		remotes:            remotes,
+		splits:             splits,
+		stashSize:          stashSize,
+	}, configdomain.ProgramFlowContinue, nil
+}
+
+func splitProgram(repo execute.OpenRepoResult, data splitData) program.Program {
+	prog := NewMutable(&program.Program{})
+	proposal, hasProposal := data.proposal.Get()
+	canShareNewBranches := data.remotes.HasRemote(data.config.NormalConfig.DevRemote) && data.config.NormalConfig.Offline.IsOnline()
+	// As long as the new branches receive the oldest commits in their original order,
+	// they can point to the existing commits and the initial branch stays unchanged.
+	reusesCommits := true
+	reusedCommitsCount := 0
+	previous := data.parentBranch
+	for _, split := range data.splits {
+		if reusesCommits && split.commits[0].SHA == data.commits[reusedCommitsCount].SHA {
+			reusedCommitsCount += len(split.commits)
+			prog.Value.Add(&opcodes.BranchCreate{
+				Branch:        split.name,
+				StartingPoint: split.commits[len(split.commits)-1].SHA.Location(),
+			})
+		} else {
+			reusesCommits = false
+			prog.Value.Add(
+				&opcodes.BranchCreate{Branch: split.name, StartingPoint: previous.Location()},
+				&opcodes.Checkout{Branch: split.name},
+			)
+			for _, commit := range split.commits {
+				prog.Value.Add(&opcodes.CherryPick{SHA: commit.SHA})
+			}
+		}
+		prog.Value.Add(&opcodes.LineageParentSet{Branch: split.name, Parent: previous})
+		if newBranchType, hasNewBranchType := data.config.NormalConfig.NewBranchType.Get(); hasNewBranchType {
+			prog.Value.Add(&opcodes.BranchTypeOverrideSet{Branch: split.name, BranchType: newBranchType.BranchType()})
+		}
+		if canShareNewBranches && (data.config.NormalConfig.ShareNewBranchesFor(split.name) == configdomain.ShareNewBranchesPush || hasProposal) {
+			prog.Value.Add(&opcodes.BranchTrackingCreate{Branch: split.name})
+		}
+		previous = split.name
+	}
+	if !reusesCommits {
+		// rebuild the initial branch on top of the new branches
+		prog.Value.Add(
+			&opcodes.Checkout{Branch: data.initialBranch},
+			&opcodes.BranchCurrentResetToBranch{Branch: previous},
+		)
+		for _, commit := range data.remainingCommits {
+			prog.Value.Add(&opcodes.CherryPick{SHA: commit.SHA})
+		}
+		if data.hasTrackingBranch && data.config.NormalConfig.Offline.IsOnline() {
+			prog.Value.Add(&opcodes.PushCurrentBranchForce{ForceIfIncludes: true})
+		}
+	}
+	prog.Value.Add(&opcodes.LineageParentSet{Branch: data.initialBranch, Parent: previous})
+	connector, hasConnector := data.connector.Get()
+	_, canUpdateProposalTarget := connector.(forgedomain.ProposalTargetUpdater)
+	if hasProposal && hasConnector && canUpdateProposalTarget {
+		prog.Value.Add(&opcodes.ProposalUpdateTarget{
+			NewBranch: previous,
+			OldBranch: data.parentBranch,
+			Proposal:  proposal,
+		})
+	}
+	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
+		DryRun:                   repo.UnvalidatedConfig.NormalConfig.DryRun,
+		InitialStashSize:         data.stashSize,
+		RunInGitRoot:             true,
+		StashOpenChanges:         data.hasOpenChanges && data.config.NormalConfig.Stash.ShouldStash(),
+		PreviousBranchCandidates: []Option[gitdomain.LocalBranchName]{data.previousBranch},
+	})
+	return optimizer.Optimize(prog.Immutable())
+}
+
+// removeCommitRange provides the given commits without the given range of commits.
+// It also indicates whether the given range is a contiguous part of the given commits.
+func removeCommitRange(commits, commitRange gitdomain.Commits) (gitdomain.Commits, bool) {
+	start := slices.IndexFunc(commits, func(commit gitdomain.Commit) bool {
+		return commit.SHA == commitRange[0].SHA
+	})
+	if start < 0 || start+len(commitRange) > len(commits) {
+		return commits, false
+	}
+	for c, commit := range commitRange {
+		if commits[start+c].SHA != commit.SHA {
+			return commits, false
+		}
+	}
+	return slices.Concat(commits[:start], commits[start+len(commitRange):]), true
+}
@@ -0,0 +1,2 @@
+// Package stack implements Git Town's "stack" command.
+package stack
@@ -0,0 +1,20 @@
+package stack
+
+import (
+	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
+	"github.com/spf13/cobra"
+)
+
+const stackDesc = "Commands that operate on the current stack"
+
+func RootCommand() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "stack",
+		GroupID: cmdhelpers.GroupIDStack,
+		Args:    cobra.NoArgs,
+		Short:   stackDesc,
+		Long:    cmdhelpers.Long(stackDesc),
+	}
+	cmd.AddCommand(statusCommand())
+	return &cmd
+}
@@ -0,0 +1,374 @@
+package stack
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogcomponents"
+	"github.com/git-town/git-town/v22/internal/cli/flags"
+	"github.com/git-town/git-town/v22/internal/cli/print"
+	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v22/internal/config"
+	"github.com/git-town/git-town/v22/internal/config/cliconfig"
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/execute"
+	"github.com/git-town/git-town/v22/internal/forge"
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/proposallineage"
+	"github.com/git-town/git-town/v22/internal/state/runstate"
+	"github.com/git-town/git-town/v22/internal/validate"
+	"github.com/git-town/git-town/v22/internal/vm/interpreter/fullinterpreter"
+	"github.com/git-town/git-town/v22/internal/vm/opcodes"
+	"github.com/git-town/git-town/v22/internal/vm/program"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const (
+	stackStatusDesc = "Display the proposals of all branches in the current stack"
+	stackStatusHelp = `
+Prints every branch in the current stack
+together with the state of its proposal at your forge:
+whether it is open, merged, or closed, whether it is a draft,
+which branch it targets, and its URL.
+
+Highlights proposals that are out of sync with the branch lineage:
+open proposals that target a different branch than the parent branch,
+and merged proposals whose branch still exists locally.
+
+With --fix, updates the target branch of open proposals
+to the parent branch of their branch.`
+)
+
+func statusCommand() *cobra.Command {
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addFixFlag, readFixFlag := flags.Fix("update the target branch of proposals to match the branch lineage")
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:   "status",
+		Args:  cobra.NoArgs,
+		Short: stackStatusDesc,
+		Long:  cmdhelpers.Long(stackStatusDesc, stackStatusHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dryRun, errDryRun := readDryRunFlag(cmd)
+			fix, errFix := readFixFlag(cmd)
+			verbose, errVerbose := readVerboseFlag(cmd)
+			if err := cmp.Or(errDryRun, errFix, errVerbose); err != nil {
+				return err
+			}
+			cliConfig := cliconfig.New(cliconfig.NewArgs{
+				AutoResolve:       None[configdomain.AutoResolve](),
+				AutoSync:          None[configdomain.AutoSync](),
+				Detached:          None[configdomain.Detached](),
+				DisplayTypes:      None[configdomain.DisplayTypes](),
+				DryRun:            dryRun,
+				IgnoreUncommitted: None[configdomain.IgnoreUncommitted](),
+				Order:             None[configdomain.Order](),
+				PushBranches:      None[configdomain.PushBranches](),
+				Stash:             None[configdomain.Stash](),
+				Verbose:           verbose,
+			})
+			return executeStackStatus(cliConfig, fix)
+		},
+	}
+	addDryRunFlag(&cmd)
+	addFixFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeStackStatus(cliConfig configdomain.PartialConfig, fix configdomain.Fix) error {
+Start:
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		CliConfig:        cliConfig,
+		IgnoreUnknown:    false,
+		PrintBranchNames: true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: true,
+	})
+	if err != nil {
+		return err
+	}
+	data, flow, err := determineStackStatusData(repo)
+	if err != nil {
+		return err
+	}
+	switch flow {
+	case configdomain.ProgramFlowContinue:
+	case configdomain.ProgramFlowExit:
+		return nil
+	case configdomain.ProgramFlowRestart:
+		goto Start
+	}
+	fmt.Print(renderStackStatus(data.entries))
+	runProgram := stackStatusFixProgram(data.entries)
+	if !fix {
+		if !runProgram.IsEmpty() {
+			fmt.Print(messages.StackStatusFixHint)
+		}
+		print.Footer(repo.UnvalidatedConfig.NormalConfig.Verbose, repo.CommandsCounter.Immutable(), repo.FinalMessages.Result())
+		return nil
+	}
+	if runProgram.IsEmpty() {
+		fmt.Println()
+		fmt.Println(messages.StackStatusNothingToFix)
+		print.Footer(repo.UnvalidatedConfig.NormalConfig.Verbose, repo.CommandsCounter.Immutable(), repo.FinalMessages.Result())
+		return nil
+	}
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: data.branchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        data.stashSize,
+		BranchInfosLastRun:    data.branchInfosLastRun,
+		Command:               "stack status",
+		DryRun:                data.config.NormalConfig.DryRun,
+		EndBranchesSnapshot:   None[gitdomain.BranchesSnapshot](),
+		EndConfigSnapshot:     None[configdomain.EndConfigSnapshot](),
+		EndStashSize:          None[gitdomain.StashSize](),
+		RunProgram:            runProgram,
+		TouchedBranches:       runProgram.TouchedBranches(),
+		UndoAPIProgram:        program.Program{},
+	}
+	return fullinterpreter.Execute(fullinterpreter.ExecuteArgs{
+		Backend:                 repo.Backend,
+		CommandsCounter:         repo.CommandsCounter,
+		Config:                  data.config,
+		ConfigDir:               repo.ConfigDir,
+		Connector:               Some(data.connector),
+		DryRun:                  data.config.NormalConfig.DryRun,
+		FinalMessages:           repo.FinalMessages,
+		Frontend:                repo.Frontend,
+		Git:                     repo.Git,
+		HasOpenChanges:          data.hasOpenChanges,
+		InitialBranch:           data.initialBranch,
+		InitialBranchesSnapshot: data.branchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        data.stashSize,
+		Inputs:                  data.inputs,
+		PendingCommand:          None[string](),
+		RunState:                runState,
+	})
+}
+
+type stackStatusData struct {
+	branchInfosLastRun Option[gitdomain.BranchInfos]
+	branchesSnapshot   gitdomain.BranchesSnapshot
+	config             config.ValidatedConfig
+	connector          forgedomain.Connector
+	entries            []proposallineage.StackStatusEntry
+	hasOpenChanges     bool
+	initialBranch      gitdomain.LocalBranchName
+	inputs             dialogcomponents.Inputs
+	stashSize          gitdomain.StashSize
+}
+
+func determineStackStatusData(repo execute.OpenRepoResult) (stackStatusData, configdomain.ProgramFlow, error) {
+	inputs := dialogcomponents.LoadInputs(os.Environ())
+	var emptyResult stackStatusData
+	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	config := repo.UnvalidatedConfig.NormalConfig
+	connectorOpt, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
+		Backend:              repo.Backend,
+		BitbucketAppPassword: config.BitbucketAppPassword,
+		BitbucketUsername:    config.BitbucketUsername,
+		Browser:              config.Browser,
+		ConfigDir:            repo.ConfigDir,
+		ForgeType:            config.ForgeType,
+		ForgejoToken:         config.ForgejoToken,
+		Frontend:             repo.Frontend,
+		GiteaToken:           config.GiteaToken,
+		GithubConnectorType:  config.GithubConnectorType,
+		GithubToken:          config.GithubToken,
+		GitlabConnectorType:  config.GitlabConnectorType,
+		GitlabToken:          config.GitlabToken,
+		Log:                  print.Logger{},
+		RemoteURL:            config.DevURL(repo.Backend),
+	})
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	connector, hasConnector := connectorOpt.Get()
+	if !hasConnector {
+		return emptyResult, configdomain.ProgramFlowExit, forgedomain.UnsupportedServiceError()
+	}
+	branchesSnapshot, stashSize, branchInfosLastRun, flow, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		Backend:               repo.Backend,
+		CommandsCounter:       repo.CommandsCounter,
+		ConfigSnapshot:        repo.ConfigSnapshot,
+		Connector:             connectorOpt,
+		Fetch:                 false,
+		FinalMessages:         repo.FinalMessages,
+		Frontend:              repo.Frontend,
+		Git:                   repo.Git,
+		HandleUnfinishedState: true,
+		Inputs:                inputs,
+		Repo:                  repo,
+		RepoStatus:            repoStatus,
+		RootDir:               repo.RootDir,
+		UnvalidatedConfig:     repo.UnvalidatedConfig,
+		ValidateNoOpenChanges: false,
+	})
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	switch flow {
+	case configdomain.ProgramFlowContinue:
+	case configdomain.ProgramFlowExit, configdomain.ProgramFlowRestart:
+		return emptyResult, flow, nil
+	}
+	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
+	if !hasInitialBranch {
+		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.StackStatusDetachedHead)
+	}
+	localBranches := branchesSnapshot.Branches.LocalBranches().NamesLocalBranches()
+	branchesAndTypes := repo.UnvalidatedConfig.UnvalidatedBranchesAndTypes(localBranches)
+	remotes, err := repo.Git.Remotes(repo.Backend)
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
+		Backend:            repo.Backend,
+		BranchInfos:        branchesSnapshot.Branches,
+		BranchesAndTypes:   branchesAndTypes,
+		BranchesToValidate: gitdomain.LocalBranchNames{initialBranch},
+		ConfigDir:          repo.ConfigDir,
+		ConfigSnapshot:     repo.ConfigSnapshot,
+		Connector:          connectorOpt,
+		Frontend:           repo.Frontend,
+		Git:                repo.Git,
+		Inputs:             inputs,
+		LocalBranches:      localBranches,
+		Remotes:            remotes,
+		RepoStatus:         repoStatus,
+		Unvalidated:        NewMutable(&repo.UnvalidatedConfig),
+	})
+	if err != nil || exit {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	tree := proposallineage.CalculateTree(initialBranch, validatedConfig.NormalConfig.Lineage, validatedConfig.NormalConfig.Order)
+	proposals, err := loadStackProposals(tree, connector)
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	return stackStatusData{
+		branchInfosLastRun: branchInfosLastRun,
+		branchesSnapshot:   branchesSnapshot,
+		config:             validatedConfig,
+		connector:          connector,
+		entries:            proposallineage.CalculateStackStatus(tree, proposals, localBranches),
+		hasOpenChanges:     repoStatus.OpenChanges,
+		initialBranch:      initialBranch,
+		inputs:             inputs,
+		stashSize:          stashSize,
+	}, configdomain.ProgramFlowContinue, nil
+}
+
+// loadStackProposals loads the proposals of all branches below the root of the given tree.
+// It includes merged and closed proposals if the connector supports finding them.
+func loadStackProposals(tree proposallineage.TreeNode, connector forgedomain.Connector) (map[gitdomain.LocalBranchName][]forgedomain.Proposal, error) {
+	result := map[gitdomain.LocalBranchName][]forgedomain.Proposal{}
+	historySearcher, canSearchHistory := connector.(forgedomain.ProposalHistorySearcher)
+	searcher, canSearch := connector.(forgedomain.ProposalSearcher)
+	if !canSearchHistory && !canSearch {
+		return result, forgedomain.UnsupportedServiceError()
+	}
+	var load func(node proposallineage.TreeNode) error
+	load = func(node proposallineage.TreeNode) error {
+		for _, child := range node.Children {
+			var proposals []forgedomain.Proposal
+			var err error
+			if canSearchHistory {
+				proposals, err = historySearcher.SearchProposalHistory(child.Branch)
+			} else {
+				proposals, err = searcher.SearchProposals(child.Branch)
+			}
+			if err != nil {
+				return err
+			}
+			result[child.Branch] = proposals
+			if err = load(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return result, load(tree)
+}
+
+func renderStackStatus(entries []proposallineage.StackStatusEntry) string {
+	result := strings.Builder{}
+	result.WriteString("\n")
+	for _, entry := range entries {
+		indent := strings.Repeat("  ", entry.Depth)
+		result.WriteString(indent)
+		result.WriteString(entry.Branch.String())
+		parent, hasParent := entry.Parent.Get()
+		if !hasParent {
+			result.WriteString("\n")
+			continue
+		}
+		result.WriteString(": ")
+		proposal, hasProposal := entry.Proposal.Get()
+		if !hasProposal {
+			result.WriteString(messages.StackStatusNoProposal)
+			result.WriteString("\n")
+			continue
+		}
+		data := proposal.Data.Data()
+		state := data.State().String()
+		if data.Draft {
+			state += ", " + messages.StackStatusDraft
+		}
+		result.WriteString(fmt.Sprintf(messages.StackStatusProposal, data.Number.String(), state, data.Target))
+		if data.URL != "" {
+			result.WriteString(" ")
+			result.WriteString(data.URL)
+		}
+		result.WriteString("\n")
+		if entry.TargetMismatch {
+			result.WriteString(indent)
+			result.WriteString("  ! ")
+			result.WriteString(fmt.Sprintf(messages.StackStatusTargetMismatch, data.Target, parent))
+			result.WriteString("\n")
+		}
+		if entry.MergedButExists {
+			result.WriteString(indent)
+			result.WriteString("  ! ")
+			result.WriteString(messages.StackStatusMergedButExists)
+			result.WriteString("\n")
+		}
+	}
+	return result.String()
+}
+
+// stackStatusFixProgram provides the program that updates the targets of all open proposals
+// that don't target the parent branch of their branch.
+func stackStatusFixProgram(entries []proposallineage.StackStatusEntry) program.Program {
+	result := program.Program{}
+	for _, entry := range entries {
+		if !entry.TargetMismatch {
+			continue
+		}
+		parent, hasParent := entry.Parent.Get()
+		proposal, hasProposal := entry.Proposal.Get()
+		if !hasParent || !hasProposal {
+			continue
+		}
+		result.Add(&opcodes.ProposalUpdateTarget{
+			NewBranch: parent,
+			OldBranch: proposal.Data.Data().Target,
+			Proposal:  proposal,
+		})
+	}
+	return result
+}
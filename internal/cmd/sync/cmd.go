@@ -21,6 +21,7 @@ import (
 	"github.com/git-town/git-town/v22/internal/messages"
 	"github.com/git-town/git-town/v22/internal/programs"
 	"github.com/git-town/git-town/v22/internal/state/runstate"
+	"github.com/git-town/git-town/v22/internal/subshell"
 	"github.com/git-town/git-town/v22/internal/validate"
 	"github.com/git-town/git-town/v22/internal/vm/interpreter/fullinterpreter"
 	"github.com/git-town/git-town/v22/internal/vm/opcodes"
@@ -54,6 +55,7 @@ If the repository contains an "upstream" remote, syncs the main branch with its
 func Cmd() *cobra.Command {
 	addAllFlag, readAllFlag := flags.All("sync all local branches")
 	addAutoResolveFlag, readAutoResolveFlag := flags.AutoResolve()
+	addBatchPushFlag, readBatchPushFlag := flags.BatchPush()
 	addDetachedFlag, readDetachedFlag := flags.Detached()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
 	addGoneFlag, readGoneFlag := flags.Gone()
@@ -61,6 +63,7 @@ func Cmd() *cobra.Command {
 	addPushFlag, readPushFlag := flags.Push()
 	addStackFlag, readStackFlag := flags.Stack("sync the stack that the current branch belongs to")
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addWorktreesFlag, readWorktreesFlag := flags.SyncWorktrees()
 	cmd := cobra.Command{
 		Use:     syncCommand,
 		GroupID: cmdhelpers.GroupIDBasic,
@@ -70,6 +73,7 @@ func Cmd() *cobra.Command {
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			allBranches, errAllBranches := readAllFlag(cmd)
 			autoResolve, errAutoResolve := readAutoResolveFlag(cmd)
+			batchPush, errBatchPush := readBatchPushFlag(cmd)
 			detached, errDetached := readDetachedFlag(cmd)
 			dryRun, errDryRun := readDryRunFlag(cmd)
 			gone, errGone := readGoneFlag(cmd)
@@ -77,7 +81,8 @@ func Cmd() *cobra.Command {
 			pushBranches, errPushBranches := readPushFlag(cmd)
 			stack, errStack := readStackFlag(cmd)
 			verbose, errVerbose := readVerboseFlag(cmd)
-			if err := cmp.Or(errAllBranches, errDetached, errDryRun, errAutoResolve, errGone, errPushBranches, errPrune, errStack, errVerbose); err != nil {
+			worktrees, errWorktrees := readWorktreesFlag(cmd)
+			if err := cmp.Or(errAllBranches, errAutoResolve, errBatchPush, errDetached, errDryRun, errGone, errPushBranches, errPrune, errStack, errVerbose, errWorktrees); err != nil {
 				return err
 			}
 			cliConfig := cliconfig.New(cliconfig.NewArgs{
@@ -93,16 +98,19 @@ func Cmd() *cobra.Command {
 				Verbose:           verbose,
 			})
 			return executeSync(executeSyncArgs{
+				batchPush:       batchPush,
 				cliConfig:       cliConfig,
 				gone:            gone,
 				prune:           prune,
 				stack:           stack,
 				syncAllBranches: allBranches,
+				syncWorktrees:   worktrees,
 			})
 		},
 	}
 	addAllFlag(&cmd)
 	addAutoResolveFlag(&cmd)
+	addBatchPushFlag(&cmd)
 	addDetachedFlag(&cmd)
 	addDryRunFlag(&cmd)
 	addGoneFlag(&cmd)
@@ -110,15 +118,18 @@ func Cmd() *cobra.Command {
 	addPushFlag(&cmd)
 	addStackFlag(&cmd)
 	addVerboseFlag(&cmd)
+	addWorktreesFlag(&cmd)
 	return &cmd
 }
 
 type executeSyncArgs struct {
+	batchPush       configdomain.BatchPush
 	cliConfig       configdomain.PartialConfig
 	gone            configdomain.Gone
 	prune           configdomain.Prune
 	stack           configdomain.FullStack
 	syncAllBranches configdomain.AllBranches
+	syncWorktrees   configdomain.SyncWorktrees
 }
 
 func executeSync(args executeSyncArgs) error {
@@ -138,6 +149,7 @@ Start:
 		gone:            args.gone,
 		syncAllBranches: args.syncAllBranches,
 		syncStack:       args.stack,
+		syncWorktrees:   args.syncWorktrees,
 	})
 	if err != nil {
 		return err
@@ -156,16 +168,20 @@ Start:
 	runProgram := NewMutable(&program.Program{})
 	branchesToDelete := set.New[gitdomain.LocalBranchName]()
 	BranchesProgram(data.branchesToSync, BranchProgramArgs{
-		BranchInfos:         data.branchInfos,
-		BranchInfosPrevious: data.previousBranchInfos,
-		BranchesToDelete:    NewMutable(&branchesToDelete),
-		Config:              data.config,
-		InitialBranch:       data.initialBranch,
-		PrefetchBranchInfos: data.prefetchBranchesSnapshot.Branches,
-		Program:             runProgram,
-		Prune:               args.prune,
-		PushBranches:        data.config.NormalConfig.PushBranches,
-		Remotes:             data.remotes,
+		BranchInfos:                       data.branchInfos,
+		BranchInfosPrevious:               data.previousBranchInfos,
+		BranchesChangedSinceMergedAtForge: data.branchesChangedSinceMergedAtForge,
+		BranchesMergedAtForge:             data.branchesMergedAtForge,
+		BranchesToDelete:                  NewMutable(&branchesToDelete),
+		Config:                            data.config,
+		InitialBranch:                     data.initialBranch,
+		LocalBranchOverrides:              repo.UnvalidatedConfig.GitLocal.BranchOverrides,
+		PrefetchBranchInfos:               data.prefetchBranchesSnapshot.Branches,
+		Program:                           runProgram,
+		Prune:                             args.prune,
+		PushBranches:                      None[configdomain.PushBranches](),
+		Remotes:                           data.remotes,
+		Worktrees:                         data.worktrees,
 	})
 	previousbranchCandidates := []Option[gitdomain.LocalBranchName]{data.previousBranch}
 	finalBranchCandidates := gitdomain.LocalBranchNames{data.initialBranch}
@@ -198,7 +214,11 @@ Start:
 		StashOpenChanges:         data.hasOpenChanges,
 		PreviousBranchCandidates: previousbranchCandidates,
 	})
-	optimizedProgram := optimizer.Optimize(runProgram.Immutable())
+	finalProgram := runProgram.Immutable()
+	if args.batchPush.Enabled() {
+		finalProgram = optimizer.BatchPushes(finalProgram)
+	}
+	optimizedProgram := optimizer.Optimize(finalProgram)
 	runState := runstate.RunState{
 		BeginBranchesSnapshot: data.branchesSnapshot,
 		BeginConfigSnapshot:   repo.ConfigSnapshot,
@@ -212,6 +232,7 @@ Start:
 		RunProgram:            optimizedProgram,
 		TouchedBranches:       optimizedProgram.TouchedBranches(),
 		UndoAPIProgram:        program.Program{},
+		Worktrees:             data.worktrees,
 	}
 	return fullinterpreter.Execute(fullinterpreter.ExecuteArgs{
 		Backend:                 repo.Backend,
@@ -235,27 +256,31 @@ Start:
 }
 
 type syncData struct {
-	branchInfos              gitdomain.BranchInfos
-	branchesSnapshot         gitdomain.BranchesSnapshot
-	branchesToSync           configdomain.BranchesToSync
-	config                   config.ValidatedConfig
-	connector                Option[forgedomain.Connector]
-	hasOpenChanges           bool
-	initialBranch            gitdomain.LocalBranchName
-	inputs                   dialogcomponents.Inputs
-	nonExistingBranches      gitdomain.LocalBranchNames
-	prefetchBranchesSnapshot gitdomain.BranchesSnapshot
-	previousBranch           Option[gitdomain.LocalBranchName]
-	previousBranchInfos      Option[gitdomain.BranchInfos]
-	remotes                  gitdomain.Remotes
-	shouldPushTags           bool
-	stashSize                gitdomain.StashSize
+	branchInfos                       gitdomain.BranchInfos
+	branchesChangedSinceMergedAtForge set.Set[gitdomain.LocalBranchName]
+	branchesMergedAtForge             set.Set[gitdomain.LocalBranchName]
+	branchesSnapshot                  gitdomain.BranchesSnapshot
+	branchesToSync                    configdomain.BranchesToSync
+	config                            config.ValidatedConfig
+	connector                         Option[forgedomain.Connector]
+	hasOpenChanges                    bool
+	initialBranch                     gitdomain.LocalBranchName
+	inputs                            dialogcomponents.Inputs
+	nonExistingBranches               gitdomain.LocalBranchNames
+	prefetchBranchesSnapshot          gitdomain.BranchesSnapshot
+	previousBranch                    Option[gitdomain.LocalBranchName]
+	previousBranchInfos               Option[gitdomain.BranchInfos]
+	remotes                           gitdomain.Remotes
+	shouldPushTags                    bool
+	stashSize                         gitdomain.StashSize
+	worktrees                         gitdomain.Worktrees // the other worktrees in which to sync branches
 }
 
 type determineSyncDataArgs struct {
 	gone            configdomain.Gone
 	syncAllBranches configdomain.AllBranches
 	syncStack       configdomain.FullStack
+	syncWorktrees   configdomain.SyncWorktrees
 }
 
 func determineSyncData(repo execute.OpenRepoResult, args determineSyncDataArgs) (syncData, configdomain.ProgramFlow, error) {
@@ -271,6 +296,7 @@ func determineSyncData(repo execute.OpenRepoResult, args determineSyncDataArgs)
 	}
 	config := repo.UnvalidatedConfig.NormalConfig
 	connector, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
 		Backend:              repo.Backend,
 		BitbucketAppPassword: config.BitbucketAppPassword,
 		BitbucketUsername:    config.BitbucketUsername,
@@ -421,22 +447,33 @@ func determineSyncData(repo execute.OpenRepoResult, args determineSyncDataArgs)
 	if err != nil {
 		return emptyResult, configdomain.ProgramFlowExit, err
 	}
+	worktrees := gitdomain.Worktrees{}
+	if args.syncWorktrees.Enabled() {
+		worktrees, err = determineWorktreesToSync(repo, branchesToSync)
+		if err != nil {
+			return emptyResult, configdomain.ProgramFlowExit, err
+		}
+	}
+	branchesMergedAtForge, branchesChangedSinceMergedAtForge := BranchesMergedAtForge(branchesToSync, validatedConfig, connector)
 	return syncData{
-		branchInfos:              branchesSnapshot.Branches,
-		branchesSnapshot:         branchesSnapshot,
-		branchesToSync:           branchesToSync,
-		config:                   validatedConfig,
-		connector:                connector,
-		hasOpenChanges:           repoStatus.OpenChanges,
-		initialBranch:            initialBranch,
-		inputs:                   inputs,
-		nonExistingBranches:      nonExistingBranches,
-		prefetchBranchesSnapshot: preFetchBranchesSnapshot,
-		previousBranch:           previousBranchOpt,
-		previousBranchInfos:      previousBranchInfos,
-		remotes:                  remotes,
-		shouldPushTags:           shouldPushTags,
-		stashSize:                stashSize,
+		branchInfos:                       branchesSnapshot.Branches,
+		branchesChangedSinceMergedAtForge: branchesChangedSinceMergedAtForge,
+		branchesMergedAtForge:             branchesMergedAtForge,
+		branchesSnapshot:                  branchesSnapshot,
+		branchesToSync:                    branchesToSync,
+		config:                            validatedConfig,
+		connector:                         connector,
+		hasOpenChanges:                    repoStatus.OpenChanges,
+		initialBranch:                     initialBranch,
+		inputs:                            inputs,
+		nonExistingBranches:               nonExistingBranches,
+		prefetchBranchesSnapshot:          preFetchBranchesSnapshot,
+		previousBranch:                    previousBranchOpt,
+		previousBranchInfos:               previousBranchInfos,
+		remotes:                           remotes,
+		shouldPushTags:                    shouldPushTags,
+		stashSize:                         stashSize,
+		worktrees:                         worktrees,
 	}, configdomain.ProgramFlowContinue, err
 }
 
@@ -476,6 +513,37 @@ func BranchesToSync(branchInfosToSync gitdomain.BranchInfos, allBranchInfos gitd
 	return result, nil
 }
 
+// determineWorktreesToSync provides the other worktrees that contain branches to sync.
+func determineWorktreesToSync(repo execute.OpenRepoResult, branchesToSync configdomain.BranchesToSync) (gitdomain.Worktrees, error) {
+	result := gitdomain.Worktrees{}
+	allWorktrees, err := repo.Git.Worktrees(repo.Backend)
+	if err != nil {
+		return result, err
+	}
+	for _, branchToSync := range branchesToSync {
+		if branchToSync.BranchInfo.SyncStatus != gitdomain.SyncStatusOtherWorktree {
+			continue
+		}
+		localName, hasLocalName := branchToSync.BranchInfo.LocalName().Get()
+		if !hasLocalName {
+			continue
+		}
+		worktree, hasWorktree := allWorktrees.FindByBranch(localName).Get()
+		if !hasWorktree {
+			continue
+		}
+		worktreeStatus, err := repo.Git.RepoStatus(subshell.BackendInDir(repo.Backend, worktree.Path.String()))
+		if err != nil {
+			return result, err
+		}
+		if worktreeStatus.OpenChanges {
+			return result, fmt.Errorf(messages.SyncWorktreeHasOpenChanges, localName, worktree.Path)
+		}
+		result = append(result, worktree)
+	}
+	return result, nil
+}
+
 func validateSyncData(data syncData) error {
 	// ensure any branch that uses the ff-only sync strategy does not have unpushed local commits
 	if data.config.NormalConfig.SyncPerennialStrategy == configdomain.SyncPerennialStrategyFFOnly {
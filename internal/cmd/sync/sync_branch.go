@@ -20,7 +20,7 @@ func BranchProgram(localName gitdomain.LocalBranchName, branchInfo gitdomain.Bra
 			parentSHAInitial = parentBranchInfo.LocalSHA().Or(parentBranchInfo.RemoteSHA)
 		}
 	}
-	usesRebaseSyncStrategy := args.Config.NormalConfig.SyncFeatureStrategy == configdomain.SyncFeatureStrategyRebase
+	usesRebaseSyncStrategy := args.Config.NormalConfig.SyncFeatureStrategyFor(localName) == configdomain.SyncFeatureStrategyRebase
 	ancestorToRemove, hasAncestorToRemove := args.Config.NormalConfig.Lineage.YoungestAncestorWithin(localName, args.BranchesToDelete.Value.Values()).Get()
 	parentSHAPrevious := None[gitdomain.SHA]()
 	if parent, has := parentNameOpt.Get(); has {
@@ -32,9 +32,21 @@ func BranchProgram(localName gitdomain.LocalBranchName, branchInfo gitdomain.Bra
 	}
 	trackingBranchGone := branchInfo.SyncStatus == gitdomain.SyncStatusDeletedAtRemote
 	hasDescendents := args.Config.NormalConfig.Lineage.HasDescendents(localName)
+	worktree, syncInWorktree := args.Worktrees.FindByBranch(localName).Get()
 	switch {
+	case args.BranchesMergedAtForge.Contains(localName):
+		mergedBranchProgram(localName, branchInfo, args)
 	case hasAncestorToRemove && ancestorToRemove == parentName && trackingBranchGone && hasDescendents:
 		args.BranchesToDelete.Value.Add(localName)
+	case hasAncestorToRemove && ancestorToRemove == parentName && args.BranchesMergedAtForge.Contains(parentName):
+		// the forge has squash-merged the parent branch into the grandparent branch
+		RemoveAncestorCommits(RemoveAncestorCommitsArgs{
+			Ancestor:          parentName.BranchName(),
+			Branch:            localName,
+			HasTrackingBranch: branchInfo.HasTrackingBranch(),
+			Program:           args.Program,
+			RebaseOnto:        args.Config.NormalConfig.Lineage.Parent(parentName).GetOr(args.Config.ValidatedConfigData.MainBranch),
+		})
 	case hasAncestorToRemove && ancestorToRemove == parentName:
 		if usesRebaseSyncStrategy {
 			RemoveAncestorCommits(RemoveAncestorCommitsArgs{
@@ -49,9 +61,12 @@ func BranchProgram(localName gitdomain.LocalBranchName, branchInfo gitdomain.Bra
 		args.BranchesToDelete.Value.Add(localName)
 	case trackingBranchGone:
 		deletedBranchProgram(localName, parentNameOpt, parentSHAInitial, parentSHAPrevious, args)
-	case branchInfo.SyncStatus == gitdomain.SyncStatusOtherWorktree:
+	case branchInfo.SyncStatus == gitdomain.SyncStatusOtherWorktree && !syncInWorktree:
 		// cannot sync branches that are active in another worktree
 	default:
+		if syncInWorktree {
+			args.Program.Value.Add(&opcodes.WorktreeEnter{Path: worktree.Path})
+		}
 		if hasAncestorToRemove && usesRebaseSyncStrategy {
 			RemoveAncestorCommits(RemoveAncestorCommitsArgs{
 				Ancestor:          ancestorToRemove.BranchName(),
@@ -70,21 +85,28 @@ func BranchProgram(localName gitdomain.LocalBranchName, branchInfo gitdomain.Bra
 			parentSHAInitial:   parentSHAInitial,
 			parentSHAPrevious:  parentSHAPrevious,
 		})
+		if syncInWorktree {
+			args.Program.Value.Add(&opcodes.WorktreeLeave{})
+		}
 	}
 	args.Program.Value.Add(&opcodes.ProgramEndOfBranch{})
 }
 
 type BranchProgramArgs struct {
-	BranchInfos         gitdomain.BranchInfos                       // the initial BranchInfos, after "git fetch" ran
-	BranchInfosPrevious Option[gitdomain.BranchInfos]               // the BranchInfos at the end of the previous Git Town command
-	BranchesToDelete    Mutable[set.Set[gitdomain.LocalBranchName]] // branches that should be deleted after the branches are all synced
-	Config              config.ValidatedConfig
-	InitialBranch       gitdomain.LocalBranchName
-	PrefetchBranchInfos gitdomain.BranchInfos // BranchInfos before "git fetch" ran
-	Program             Mutable[program.Program]
-	Prune               configdomain.Prune
-	PushBranches        configdomain.PushBranches
-	Remotes             gitdomain.Remotes
+	BranchInfos                       gitdomain.BranchInfos                       // the initial BranchInfos, after "git fetch" ran
+	BranchInfosPrevious               Option[gitdomain.BranchInfos]               // the BranchInfos at the end of the previous Git Town command
+	BranchesChangedSinceMergedAtForge set.Set[gitdomain.LocalBranchName]          // branches that received commits after the forge merged their proposal
+	BranchesMergedAtForge             set.Set[gitdomain.LocalBranchName]          // branches whose proposal the forge has merged
+	BranchesToDelete                  Mutable[set.Set[gitdomain.LocalBranchName]] // branches that should be deleted after the branches are all synced
+	Config                            config.ValidatedConfig
+	InitialBranch                     gitdomain.LocalBranchName
+	LocalBranchOverrides              configdomain.BranchOverrides // the settings specific to branches stored in the local Git metadata
+	PrefetchBranchInfos               gitdomain.BranchInfos        // BranchInfos before "git fetch" ran
+	Program                           Mutable[program.Program]
+	Prune                             configdomain.Prune
+	PushBranches                      Option[configdomain.PushBranches] // None = push according to the configuration of each branch
+	Remotes                           gitdomain.Remotes
+	Worktrees                         gitdomain.Worktrees // the other worktrees in which to sync the branches checked out there
 }
 
 type localBranchProgramArgs struct {
@@ -106,9 +128,11 @@ func localBranchProgram(args localBranchProgramArgs) {
 		return
 	}
 	args.Program.Value.Add(&opcodes.CheckoutIfNeeded{Branch: args.localName})
+	pushBranches := args.PushBranches.GetOr(args.Config.NormalConfig.PushBranchesFor(args.localName))
+	syncFeatureStrategy := args.Config.NormalConfig.SyncFeatureStrategyFor(args.localName)
 	switch branchType {
 	case configdomain.BranchTypeFeatureBranch:
-		FeatureBranchProgram(args.Config.NormalConfig.SyncFeatureStrategy.SyncStrategy(), featureBranchArgs{
+		FeatureBranchProgram(syncFeatureStrategy.SyncStrategy(), featureBranchArgs{
 			firstCommitMessage:   args.firstCommitMessage,
 			initialParentName:    args.parentNameInitial,
 			initialParentSHA:     args.parentSHAInitial,
@@ -116,14 +140,14 @@ func localBranchProgram(args localBranchProgramArgs) {
 			offline:              args.Config.NormalConfig.Offline,
 			parentSHAPreviousRun: args.parentSHAPrevious,
 			program:              args.Program,
-			prune:                args.Prune,
-			pushBranches:         args.PushBranches,
+			prune:                args.Prune || configdomain.Prune(args.BranchesChangedSinceMergedAtForge.Contains(args.localName)),
+			pushBranches:         pushBranches,
 			trackingBranch:       args.branchInfo.RemoteName,
 		})
 	case configdomain.BranchTypePerennialBranch, configdomain.BranchTypeMainBranch:
 		PerennialBranchProgram(args.branchInfo, args.BranchProgramArgs)
 	case configdomain.BranchTypeParkedBranch:
-		ParkedBranchProgram(args.Config.NormalConfig.SyncFeatureStrategy.SyncStrategy(), args.InitialBranch, featureBranchArgs{
+		ParkedBranchProgram(syncFeatureStrategy.SyncStrategy(), args.InitialBranch, featureBranchArgs{
 			firstCommitMessage:   args.firstCommitMessage,
 			initialParentName:    args.parentNameInitial,
 			initialParentSHA:     args.parentSHAInitial,
@@ -132,7 +156,7 @@ func localBranchProgram(args localBranchProgramArgs) {
 			parentSHAPreviousRun: args.parentSHAPrevious,
 			program:              args.Program,
 			prune:                args.Prune,
-			pushBranches:         args.PushBranches,
+			pushBranches:         pushBranches,
 			trackingBranch:       args.branchInfo.RemoteName,
 		})
 	case configdomain.BranchTypeContributionBranch:
@@ -153,7 +177,7 @@ func localBranchProgram(args localBranchProgramArgs) {
 			trackingBranch:       args.branchInfo.RemoteName,
 		})
 	}
-	if args.PushBranches.ShouldPush() && args.Remotes.HasRemote(args.Config.NormalConfig.DevRemote) && args.Config.NormalConfig.Offline.IsOnline() && branchType.ShouldPush(args.localName == args.InitialBranch) {
+	if pushBranches.ShouldPush() && args.Remotes.HasRemote(args.Config.NormalConfig.DevRemote) && args.Config.NormalConfig.Offline.IsOnline() && branchType.ShouldPush(args.localName == args.InitialBranch) {
 		isMainBranch := branchType == configdomain.BranchTypeMainBranch
 		trackingBranch, hasTrackingBranch := args.branchInfo.RemoteName.Get()
 		switch {
@@ -171,7 +195,7 @@ func localBranchProgram(args localBranchProgramArgs) {
 			}
 		default:
 			if hasTrackingBranch {
-				pushFeatureBranchProgram(args.Program, args.localName, trackingBranch, args.Config.NormalConfig.SyncFeatureStrategy)
+				pushFeatureBranchProgram(args.Program, args.localName, trackingBranch, syncFeatureStrategy)
 			}
 		}
 	}
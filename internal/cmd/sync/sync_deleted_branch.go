@@ -3,6 +3,7 @@ package sync
 import (
 	"github.com/git-town/git-town/v22/internal/config/configdomain"
 	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/programs"
 	"github.com/git-town/git-town/v22/internal/vm/opcodes"
 	. "github.com/git-town/git-town/v22/pkg/prelude"
 )
@@ -26,6 +27,7 @@ func deletedBranchProgram(branch gitdomain.LocalBranchName, initialParentName Op
 			Branch: branch,
 		})
 	}
+	programs.RemoveBranchOverridesProgram(args.Program, args.LocalBranchOverrides, branch)
 }
 
 // syncDeletedFeatureBranchProgram syncs a feare branch whose remote has been deleted.
@@ -58,7 +60,7 @@ func syncDeletedFeatureBranchProgram(branch gitdomain.LocalBranchName, initialPa
 			parentSHAInitial:  initialParentSHA,
 			parentSHAPrevious: parentSHAPreviousRun,
 			program:           args.Program,
-			syncStrategy:      args.Config.NormalConfig.SyncFeatureStrategy,
+			syncStrategy:      args.Config.NormalConfig.SyncFeatureStrategyFor(branch),
 			// this function syncs a branch whose remote was deleted --> we know for sure there is no tracking branch
 			trackingBranch: None[gitdomain.RemoteBranchName](),
 		})
@@ -0,0 +1,107 @@
+package sync
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/git-town/git-town/v22/internal/config"
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/programs"
+	"github.com/git-town/git-town/v22/internal/vm/opcodes"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/git-town/git-town/v22/pkg/set"
+)
+
+// BranchesMergedAtForge provides the feature branches among the given branches to sync
+// whose proposal into their parent branch the forge has merged although their tracking branch still exists.
+// The first result contains the branches whose current commit the forge has merged.
+// These branches are safe to remove.
+// The second result contains the branches that received commits after the forge merged their proposal.
+// These branches get synced normally and removed only if they end up empty.
+// Only connectors that implement forgedomain.ProposalHistorySearcher support this,
+// i.e. GitHub, gh, and GitLab. For all other forges this finds no branches.
+func BranchesMergedAtForge(branchesToSync configdomain.BranchesToSync, validatedConfig config.ValidatedConfig, connector Option[forgedomain.Connector]) (merged, changedSinceMerge set.Set[gitdomain.LocalBranchName]) {
+	merged = set.New[gitdomain.LocalBranchName]()
+	changedSinceMerge = set.New[gitdomain.LocalBranchName]()
+	if validatedConfig.NormalConfig.Offline.IsOffline() {
+		return merged, changedSinceMerge
+	}
+	connectorValue, hasConnector := connector.Get()
+	if !hasConnector {
+		return merged, changedSinceMerge
+	}
+	historySearcher, canSearchHistory := connectorValue.(forgedomain.ProposalHistorySearcher)
+	if !canSearchHistory {
+		return merged, changedSinceMerge
+	}
+	for _, branchToSync := range branchesToSync {
+		branch, hasLocalBranch := branchToSync.BranchInfo.LocalName().Get()
+		if !hasLocalBranch || validatedConfig.BranchType(branch) != configdomain.BranchTypeFeatureBranch {
+			continue
+		}
+		// only branches without unpushed local commits are safe to remove
+		if branchToSync.BranchInfo.SyncStatus != gitdomain.SyncStatusUpToDate {
+			continue
+		}
+		parent, hasParent := validatedConfig.NormalConfig.Lineage.Parent(branch).Get()
+		if !hasParent {
+			continue
+		}
+		proposals, err := historySearcher.SearchProposalHistory(branch)
+		if err != nil {
+			// the connector has already printed the problem, this branch gets synced normally
+			continue
+		}
+		mergedSHAs := mergedProposalSHAs(proposals, branch, parent)
+		switch {
+		case len(mergedSHAs) == 0:
+		case slices.Contains(mergedSHAs, branchToSync.BranchInfo.LocalSHA().GetOrZero()):
+			merged.Add(branch)
+		default:
+			changedSinceMerge.Add(branch)
+		}
+	}
+	return merged, changedSinceMerge
+}
+
+// mergedBranchProgram adds opcodes that remove the given branch, whose proposal the forge has merged, to the given program.
+// The children of this branch get rebased onto its parent while syncing them.
+// The branch gets deleted once all branches are synced.
+func mergedBranchProgram(branch gitdomain.LocalBranchName, branchInfo gitdomain.BranchInfo, args BranchProgramArgs) {
+	args.Program.Value.Add(
+		&opcodes.MessageQueue{
+			Message: fmt.Sprintf(messages.SyncBranchMergedAtForge, branch),
+		},
+		&opcodes.CheckoutDescendentOrOtherIfNeeded{
+			Branch: branch,
+		},
+	)
+	if trackingBranch, hasTrackingBranch := branchInfo.RemoteName.Get(); hasTrackingBranch && args.Config.NormalConfig.ShipDeleteTrackingBranch.ShouldDeleteTrackingBranch() {
+		args.Program.Value.Add(&opcodes.BranchTrackingDelete{Branch: trackingBranch})
+	}
+	if _, hasOverride := args.Config.NormalConfig.BranchTypeOverrides[branch]; hasOverride {
+		args.Program.Value.Add(&opcodes.BranchTypeOverrideRemove{
+			Branch: branch,
+		})
+	}
+	programs.RemoveBranchOverridesProgram(args.Program, args.LocalBranchOverrides, branch)
+	args.BranchesToDelete.Value.Add(branch)
+}
+
+// mergedProposalSHAs provides the head SHAs of the given merged proposals from the given source into the given target branch.
+func mergedProposalSHAs(proposals []forgedomain.Proposal, source, target gitdomain.LocalBranchName) gitdomain.SHAs {
+	result := gitdomain.SHAs{}
+	for _, proposal := range proposals {
+		data := proposal.Data.Data()
+		if data.State() != forgedomain.ProposalStateMerged || data.Source != source || data.Target != target {
+			continue
+		}
+		if headSHA, hasHeadSHA := data.HeadSHA.Get(); hasHeadSHA {
+			result = append(result, headSHA)
+		}
+	}
+	return result
+}
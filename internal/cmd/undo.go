@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
 	"os"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
 	"github.com/git-town/git-town/v22/internal/git/gitdomain"
 	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/state/runhistory"
 	"github.com/git-town/git-town/v22/internal/state/runstate"
 	"github.com/git-town/git-town/v22/internal/undo"
 	"github.com/git-town/git-town/v22/internal/validate"
@@ -23,19 +26,29 @@ import (
 	"github.com/spf13/cobra"
 )
 
-const undoDesc = "Undo the most recent Git Town command"
+const (
+	undoDesc = "Undo the most recent Git Town command"
+	undoHelp = `
+Reverts the changes that the most recent Git Town command made.
+
+Use "--steps" to undo several of the most recent Git Town commands at once,
+most recent first.
+Run "git town history" to see which commands Git Town can undo.`
+)
 
 func undoCmd() *cobra.Command {
+	addStepsFlag, readStepsFlag := flags.Steps()
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	cmd := cobra.Command{
 		Use:     "undo",
 		GroupID: cmdhelpers.GroupIDErrors,
 		Args:    cobra.NoArgs,
 		Short:   undoDesc,
-		Long:    cmdhelpers.Long(undoDesc),
+		Long:    cmdhelpers.Long(undoDesc, undoHelp),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			verbose, err := readVerboseFlag(cmd)
-			if err != nil {
+			steps, errSteps := readStepsFlag(cmd)
+			verbose, errVerbose := readVerboseFlag(cmd)
+			if err := cmp.Or(errSteps, errVerbose); err != nil {
 				return err
 			}
 			cliConfig := cliconfig.New(cliconfig.NewArgs{
@@ -50,14 +63,19 @@ func undoCmd() *cobra.Command {
 				Stash:             None[configdomain.Stash](),
 				Verbose:           verbose,
 			})
-			return executeUndo(cliConfig)
+			return executeUndo(cliConfig, steps)
 		},
 	}
+	addStepsFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeUndo(cliConfig configdomain.PartialConfig) error {
+func executeUndo(cliConfig configdomain.PartialConfig, stepsOpt Option[configdomain.UndoSteps]) error {
+	steps := stepsOpt.GetOr(1)
+	if steps.Value() == 0 {
+		return errors.New(messages.UndoStepsZero)
+	}
 Start:
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		CliConfig:        cliConfig,
@@ -87,9 +105,12 @@ Start:
 		return fmt.Errorf(messages.RunstateLoadProblem, err)
 	}
 	runState, hasRunState := runStateOpt.Get()
+	// the most recent command has already been undone --> only undo older commands when the user asks for it explicitly
+	if steps.Value() > 1 || (!hasRunState && stepsOpt.IsSome()) {
+		return undoHistory(repo, data, runStateOpt, steps)
+	}
 	if !hasRunState {
-		fmt.Println(messages.UndoNothingToDo)
-		return nil
+		return printNothingToUndo(repo)
 	}
 	return undo.Execute(undo.ExecuteArgs{
 		Backend:          repo.Backend,
@@ -125,6 +146,7 @@ func determineUndoData(repo execute.OpenRepoResult) (undoData, configdomain.Prog
 	}
 	config := repo.UnvalidatedConfig.NormalConfig
 	connector, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     config.AzuredevopsToken,
 		Backend:              repo.Backend,
 		BitbucketAppPassword: config.BitbucketAppPassword,
 		BitbucketUsername:    config.BitbucketUsername,
@@ -205,3 +227,50 @@ func determineUndoData(repo execute.OpenRepoResult) (undoData, configdomain.Prog
 		stashSize:               stashSize,
 	}, configdomain.ProgramFlowContinue, nil
 }
+
+// printNothingToUndo tells the user that the most recent command has already been undone
+// and how to undo older commands if there are any.
+func printNothingToUndo(repo execute.OpenRepoResult) error {
+	history, err := runhistory.Load(runhistory.NewRunhistoryPath(repo.ConfigDir))
+	if err != nil {
+		return fmt.Errorf(messages.RunhistoryLoadProblem, err)
+	}
+	if len(history) == 0 {
+		fmt.Println(messages.UndoNothingToDo)
+	} else {
+		fmt.Println(messages.UndoNothingToDoOlderCommands)
+	}
+	return nil
+}
+
+// undoHistory undoes the given number of most recent Git Town commands.
+func undoHistory(repo execute.OpenRepoResult, data undoData, runStateOpt Option[runstate.RunState], steps configdomain.UndoSteps) error {
+	if runState, hasRunState := runStateOpt.Get(); hasRunState && !runState.IsFinished() {
+		return fmt.Errorf(messages.UndoStepsUnfinished, runState.Command)
+	}
+	history, err := runhistory.Load(runhistory.NewRunhistoryPath(repo.ConfigDir))
+	if err != nil {
+		return fmt.Errorf(messages.RunhistoryLoadProblem, err)
+	}
+	if len(history) == 0 {
+		fmt.Println(messages.UndoNothingToDo)
+		return nil
+	}
+	stepCount := int(steps.Value())
+	if stepCount > len(history) {
+		return fmt.Errorf(messages.UndoStepsTooMany, stepCount, len(history))
+	}
+	return undo.ExecuteHistory(undo.ExecuteHistoryArgs{
+		Backend:                 repo.Backend,
+		CommandsCounter:         repo.CommandsCounter,
+		Config:                  data.config,
+		ConfigDir:               repo.ConfigDir,
+		Connector:               data.connector,
+		CurrentBranchesSnapshot: data.initialBranchesSnapshot,
+		Entries:                 history.Newest(stepCount),
+		FinalMessages:           repo.FinalMessages,
+		Frontend:                repo.Frontend,
+		Git:                     repo.Git,
+		HasOpenChanges:          data.hasOpenChanges,
+	})
+}
@@ -0,0 +1,2 @@
+// Package worktree implements Git Town's "worktree" command.
+package worktree
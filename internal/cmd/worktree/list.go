@@ -0,0 +1,171 @@
+package worktree
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/git-town/git-town/v22/internal/cli/dialog"
+	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogcomponents"
+	"github.com/git-town/git-town/v22/internal/cli/flags"
+	"github.com/git-town/git-town/v22/internal/cli/print"
+	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v22/internal/config/cliconfig"
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/execute"
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const (
+	worktreeListDesc = "Display the worktrees alongside the branch lineage"
+	worktreeListHelp = `
+Prints the local branch hierarchy like "git town branch",
+together with the location of the worktree
+in which each branch is checked out.
+Locations are relative to the current worktree.
+
+Worktrees with a detached HEAD appear at the end.`
+)
+
+func listCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:   "list",
+		Args:  cobra.NoArgs,
+		Short: worktreeListDesc,
+		Long:  cmdhelpers.Long(worktreeListDesc, worktreeListHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			verbose, err := readVerboseFlag(cmd)
+			if err != nil {
+				return err
+			}
+			cliConfig := cliconfig.New(cliconfig.NewArgs{
+				AutoResolve:       None[configdomain.AutoResolve](),
+				AutoSync:          None[configdomain.AutoSync](),
+				Detached:          None[configdomain.Detached](),
+				DisplayTypes:      None[configdomain.DisplayTypes](),
+				DryRun:            None[configdomain.DryRun](),
+				IgnoreUncommitted: None[configdomain.IgnoreUncommitted](),
+				Order:             None[configdomain.Order](),
+				PushBranches:      None[configdomain.PushBranches](),
+				Stash:             None[configdomain.Stash](),
+				Verbose:           verbose,
+			})
+			return executeWorktreeList(cliConfig)
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeWorktreeList(cliConfig configdomain.PartialConfig) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		CliConfig:        cliConfig,
+		IgnoreUnknown:    false,
+		PrintBranchNames: true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+	})
+	if err != nil {
+		return err
+	}
+	inputs := dialogcomponents.LoadInputs(os.Environ())
+	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
+	if err != nil {
+		return err
+	}
+	branchesSnapshot, _, _, flow, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		Backend:               repo.Backend,
+		CommandsCounter:       repo.CommandsCounter,
+		ConfigSnapshot:        repo.ConfigSnapshot,
+		Connector:             None[forgedomain.Connector](),
+		Fetch:                 false,
+		FinalMessages:         repo.FinalMessages,
+		Frontend:              repo.Frontend,
+		Git:                   repo.Git,
+		HandleUnfinishedState: false,
+		Inputs:                inputs,
+		Repo:                  repo,
+		RepoStatus:            repoStatus,
+		RootDir:               repo.RootDir,
+		UnvalidatedConfig:     repo.UnvalidatedConfig,
+		ValidateNoOpenChanges: false,
+	})
+	if err != nil || flow != configdomain.ProgramFlowContinue {
+		return err
+	}
+	worktrees, err := repo.Git.Worktrees(repo.Backend)
+	if err != nil {
+		return err
+	}
+	branchInfos := branchesSnapshot.Branches
+	if branchesSnapshot.DetachedHead {
+		if activeBranch, hasActiveBranch := branchesSnapshot.Active.Get(); hasActiveBranch {
+			branchInfos = branchInfos.Remove(activeBranch)
+		}
+	}
+	entries := dialog.NewSwitchBranchEntries(dialog.NewSwitchBranchEntriesArgs{
+		BranchInfos:       branchInfos,
+		BranchTypes:       []configdomain.BranchType{},
+		BranchesAndTypes:  repo.UnvalidatedConfig.UnvalidatedBranchesAndTypes(branchInfos.NamesLocalBranches()),
+		ExcludeBranches:   gitdomain.LocalBranchNames{},
+		Lineage:           repo.UnvalidatedConfig.NormalConfig.Lineage,
+		MainBranch:        repo.UnvalidatedConfig.UnvalidatedConfig.MainBranch,
+		Order:             repo.UnvalidatedConfig.NormalConfig.Order,
+		Regexes:           []*regexp.Regexp{},
+		ShowAllBranches:   false,
+		UnknownBranchType: repo.UnvalidatedConfig.NormalConfig.UnknownBranchType,
+	})
+	fmt.Print(worktreeListLayout(entries, worktrees, branchesSnapshot.Active, repo.RootDir))
+	print.Footer(repo.UnvalidatedConfig.NormalConfig.Verbose, repo.CommandsCounter.Immutable(), repo.FinalMessages.Result())
+	return nil
+}
+
+func worktreeListLayout(entries dialog.SwitchBranchEntries, worktrees gitdomain.Worktrees, initialBranchOpt Option[gitdomain.LocalBranchName], rootDir gitdomain.RepoRootDir) string {
+	type line struct {
+		marker string
+		path   Option[gitdomain.WorktreePath]
+		text   string
+	}
+	lines := make([]line, 0, len(entries))
+	initialBranch, hasInitialBranch := initialBranchOpt.Get()
+	for _, entry := range entries {
+		marker := "  "
+		switch {
+		case hasInitialBranch && entry.Branch == initialBranch:
+			marker = "* "
+		case entry.OtherWorktree:
+			marker = "+ "
+		}
+		path := None[gitdomain.WorktreePath]()
+		if worktree, hasWorktree := worktrees.FindByBranch(entry.Branch).Get(); hasWorktree {
+			path = Some(worktree.Path.RelativeTo(rootDir))
+		}
+		lines = append(lines, line{marker: marker, path: path, text: entry.String()})
+	}
+	for _, worktree := range worktrees {
+		if worktree.Branch.IsNone() {
+			lines = append(lines, line{marker: "  ", path: Some(worktree.Path.RelativeTo(rootDir)), text: "(detached HEAD)"})
+		}
+	}
+	width := 0
+	for _, line := range lines {
+		width = max(width, len(line.text))
+	}
+	s := strings.Builder{}
+	for _, line := range lines {
+		s.WriteString(line.marker)
+		s.WriteString(line.text)
+		if path, hasPath := line.path.Get(); hasPath {
+			s.WriteString(strings.Repeat(" ", width-len(line.text)+2))
+			s.WriteString(path.String())
+		}
+		s.WriteRune('\n')
+	}
+	return s.String()
+}
@@ -0,0 +1,91 @@
+package worktree
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/execute"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/subshell"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// Root provides the configured folder that contains the worktrees that Git Town manages,
+// or the default folder if the user hasn't configured one.
+func Root(configured Option[configdomain.WorktreeRoot], mainWorktree gitdomain.Worktree) configdomain.WorktreeRoot {
+	return configured.GetOr(configdomain.DefaultWorktreeRoot(mainWorktree.Path))
+}
+
+// Managed provides the worktrees that "git town worktree add" created,
+// i.e. the worktrees inside the given worktree root.
+func Managed(worktrees gitdomain.Worktrees, root configdomain.WorktreeRoot) gitdomain.Worktrees {
+	result := gitdomain.Worktrees{}
+	mainWorktree, hasMainWorktree := worktrees.Main().Get()
+	if !hasMainWorktree {
+		return result
+	}
+	rootDir := root.Resolve(mainWorktree.Path)
+	for _, worktree := range worktrees[1:] {
+		if worktree.Path.IsInside(rootDir) {
+			result = append(result, worktree)
+		}
+	}
+	return result
+}
+
+// RemovableWorktree provides the worktree that Git Town manages for the given branch,
+// with its path relative to the root directory of the current worktree.
+// Returns an error if this worktree contains uncommitted changes.
+func RemovableWorktree(repo execute.OpenRepoResult, branch gitdomain.LocalBranchName) (Option[gitdomain.Worktree], error) {
+	worktrees, err := repo.Git.Worktrees(repo.Backend)
+	if err != nil {
+		return None[gitdomain.Worktree](), err
+	}
+	mainWorktree, hasMainWorktree := worktrees.Main().Get()
+	if !hasMainWorktree {
+		return None[gitdomain.Worktree](), errors.New(messages.WorktreeMainNotFound)
+	}
+	root := Root(repo.UnvalidatedConfig.NormalConfig.WorktreeRoot, mainWorktree)
+	worktree, hasWorktree := Managed(worktrees, root).FindByBranch(branch).Get()
+	if !hasWorktree {
+		return None[gitdomain.Worktree](), nil
+	}
+	worktree, err = removable(repo, worktree)
+	if err != nil {
+		return None[gitdomain.Worktree](), err
+	}
+	return Some(worktree), nil
+}
+
+// removable provides the given worktree with its path relative to the root directory of the current worktree.
+// Returns an error if the given worktree contains uncommitted changes.
+func removable(repo execute.OpenRepoResult, worktree gitdomain.Worktree) (gitdomain.Worktree, error) {
+	worktree.Path = worktree.Path.RelativeTo(repo.RootDir)
+	status, err := repo.Git.RepoStatus(subshell.BackendInDir(repo.Backend, worktree.Path.String()))
+	if err != nil {
+		return worktree, err
+	}
+	if status.OpenChanges {
+		return worktree, fmt.Errorf(messages.WorktreeHasOpenChanges, worktree.Path, worktree.Branch.GetOrZero())
+	}
+	return worktree, nil
+}
+
+// SyncStatus provides the sync status of the given branch, which is checked out in a worktree that Git Town manages.
+// Git reports such branches as active in another worktree, which doesn't say whether they are in sync with their tracking branch.
+func SyncStatus(branchInfo gitdomain.BranchInfo) gitdomain.SyncStatus {
+	remoteSHA, hasRemoteSHA := branchInfo.RemoteSHA.Get()
+	localSHA, hasLocalSHA := branchInfo.LocalSHA().Get()
+	switch {
+	case branchInfo.RemoteName.IsNone():
+		return gitdomain.SyncStatusLocalOnly
+	case !hasRemoteSHA:
+		return gitdomain.SyncStatusDeletedAtRemote
+	case hasLocalSHA && localSHA == remoteSHA:
+		return gitdomain.SyncStatusUpToDate
+	default:
+		return gitdomain.SyncStatusNotInSync
+	}
+}
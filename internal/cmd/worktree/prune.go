@@ -0,0 +1,294 @@
+package worktree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogcomponents"
+	"github.com/git-town/git-town/v22/internal/cli/flags"
+	"github.com/git-town/git-town/v22/internal/cli/print"
+	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v22/internal/cmd/sync"
+	"github.com/git-town/git-town/v22/internal/config"
+	"github.com/git-town/git-town/v22/internal/config/cliconfig"
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/execute"
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/programs"
+	"github.com/git-town/git-town/v22/internal/state/runstate"
+	"github.com/git-town/git-town/v22/internal/validate"
+	"github.com/git-town/git-town/v22/internal/vm/interpreter/fullinterpreter"
+	"github.com/git-town/git-town/v22/internal/vm/opcodes"
+	"github.com/git-town/git-town/v22/internal/vm/program"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const (
+	worktreePruneDesc = "Remove the worktrees of shipped and deleted branches"
+	worktreePruneHelp = `
+Removes the worktrees created by "git town worktree add"
+whose branch was deleted at the remote,
+for example because it got shipped via the web UI of your forge.
+Also deletes the local branch of these worktrees
+and removes it from the branch lineage.
+
+Worktrees with uncommitted changes are never removed.
+Branches that contain changes that were never shipped
+keep their worktree.`
+)
+
+func pruneCommand() *cobra.Command {
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:   "prune",
+		Args:  cobra.NoArgs,
+		Short: worktreePruneDesc,
+		Long:  cmdhelpers.Long(worktreePruneDesc, worktreePruneHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dryRun, errDryRun := readDryRunFlag(cmd)
+			verbose, errVerbose := readVerboseFlag(cmd)
+			if err := cmp.Or(errDryRun, errVerbose); err != nil {
+				return err
+			}
+			cliConfig := cliconfig.New(cliconfig.NewArgs{
+				AutoResolve:       None[configdomain.AutoResolve](),
+				AutoSync:          None[configdomain.AutoSync](),
+				Detached:          None[configdomain.Detached](),
+				DisplayTypes:      None[configdomain.DisplayTypes](),
+				DryRun:            dryRun,
+				IgnoreUncommitted: None[configdomain.IgnoreUncommitted](),
+				Order:             None[configdomain.Order](),
+				PushBranches:      None[configdomain.PushBranches](),
+				Stash:             None[configdomain.Stash](),
+				Verbose:           verbose,
+			})
+			return executeWorktreePrune(cliConfig)
+		},
+	}
+	addDryRunFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeWorktreePrune(cliConfig configdomain.PartialConfig) error {
+Start:
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		CliConfig:        cliConfig,
+		IgnoreUnknown:    false,
+		PrintBranchNames: true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+	})
+	if err != nil {
+		return err
+	}
+	data, flow, err := determineWorktreePruneData(repo)
+	if err != nil {
+		return err
+	}
+	switch flow {
+	case configdomain.ProgramFlowContinue:
+	case configdomain.ProgramFlowExit:
+		return nil
+	case configdomain.ProgramFlowRestart:
+		goto Start
+	}
+	if len(data.worktreesToPrune) == 0 {
+		fmt.Println(messages.WorktreePruneNothingToDo)
+		print.Footer(repo.UnvalidatedConfig.NormalConfig.Verbose, repo.CommandsCounter.Immutable(), repo.FinalMessages.Result())
+		return nil
+	}
+	runProgram := worktreePruneProgram(repo, data)
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: data.branchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        data.stashSize,
+		BranchInfosLastRun:    data.branchInfosLastRun,
+		Command:               "worktree prune",
+		DryRun:                data.config.NormalConfig.DryRun,
+		EndBranchesSnapshot:   None[gitdomain.BranchesSnapshot](),
+		EndConfigSnapshot:     None[configdomain.EndConfigSnapshot](),
+		EndStashSize:          None[gitdomain.StashSize](),
+		RunProgram:            runProgram,
+		TouchedBranches:       runProgram.TouchedBranches(),
+		UndoAPIProgram:        program.Program{},
+		Worktrees:             data.worktreesToPrune,
+	}
+	return fullinterpreter.Execute(fullinterpreter.ExecuteArgs{
+		Backend:                 repo.Backend,
+		CommandsCounter:         repo.CommandsCounter,
+		Config:                  data.config,
+		ConfigDir:               repo.ConfigDir,
+		Connector:               None[forgedomain.Connector](),
+		DryRun:                  data.config.NormalConfig.DryRun,
+		FinalMessages:           repo.FinalMessages,
+		Frontend:                repo.Frontend,
+		Git:                     repo.Git,
+		HasOpenChanges:          data.hasOpenChanges,
+		InitialBranch:           data.initialBranch,
+		InitialBranchesSnapshot: data.branchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        data.stashSize,
+		Inputs:                  data.inputs,
+		PendingCommand:          None[string](),
+		RunState:                runState,
+	})
+}
+
+type worktreePruneData struct {
+	branchInfosLastRun Option[gitdomain.BranchInfos]
+	branchesSnapshot   gitdomain.BranchesSnapshot
+	config             config.ValidatedConfig
+	hasOpenChanges     bool
+	initialBranch      gitdomain.LocalBranchName
+	inputs             dialogcomponents.Inputs
+	previousBranch     Option[gitdomain.LocalBranchName]
+	stashSize          gitdomain.StashSize
+	worktreesToPrune   gitdomain.Worktrees // paths are relative to the root directory of the current worktree
+}
+
+func determineWorktreePruneData(repo execute.OpenRepoResult) (worktreePruneData, configdomain.ProgramFlow, error) {
+	inputs := dialogcomponents.LoadInputs(os.Environ())
+	var emptyResult worktreePruneData
+	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	branchesSnapshot, stashSize, branchInfosLastRun, flow, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		Backend:               repo.Backend,
+		CommandsCounter:       repo.CommandsCounter,
+		ConfigSnapshot:        repo.ConfigSnapshot,
+		Connector:             None[forgedomain.Connector](),
+		Fetch:                 true,
+		FinalMessages:         repo.FinalMessages,
+		Frontend:              repo.Frontend,
+		Git:                   repo.Git,
+		HandleUnfinishedState: true,
+		Inputs:                inputs,
+		Repo:                  repo,
+		RepoStatus:            repoStatus,
+		RootDir:               repo.RootDir,
+		UnvalidatedConfig:     repo.UnvalidatedConfig,
+		ValidateNoOpenChanges: false,
+	})
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	switch flow {
+	case configdomain.ProgramFlowContinue:
+	case configdomain.ProgramFlowExit, configdomain.ProgramFlowRestart:
+		return emptyResult, flow, nil
+	}
+	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
+	if !hasInitialBranch {
+		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.CurrentBranchCannotDetermine)
+	}
+	localBranches := branchesSnapshot.Branches.LocalBranches().NamesLocalBranches()
+	branchesAndTypes := repo.UnvalidatedConfig.UnvalidatedBranchesAndTypes(localBranches)
+	remotes, err := repo.Git.Remotes(repo.Backend)
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
+		Backend:            repo.Backend,
+		BranchInfos:        branchesSnapshot.Branches,
+		BranchesAndTypes:   branchesAndTypes,
+		BranchesToValidate: gitdomain.LocalBranchNames{},
+		ConfigDir:          repo.ConfigDir,
+		ConfigSnapshot:     repo.ConfigSnapshot,
+		Connector:          None[forgedomain.Connector](),
+		Frontend:           repo.Frontend,
+		Git:                repo.Git,
+		Inputs:             inputs,
+		LocalBranches:      localBranches,
+		Remotes:            remotes,
+		RepoStatus:         repoStatus,
+		Unvalidated:        NewMutable(&repo.UnvalidatedConfig),
+	})
+	if err != nil || exit {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	worktrees, err := repo.Git.Worktrees(repo.Backend)
+	if err != nil {
+		return emptyResult, configdomain.ProgramFlowExit, err
+	}
+	mainWorktree, hasMainWorktree := worktrees.Main().Get()
+	if !hasMainWorktree {
+		return emptyResult, configdomain.ProgramFlowExit, errors.New(messages.WorktreeMainNotFound)
+	}
+	root := Root(validatedConfig.NormalConfig.WorktreeRoot, mainWorktree)
+	worktreesToPrune := gitdomain.Worktrees{}
+	for _, worktree := range Managed(worktrees, root) {
+		branch, hasBranch := worktree.Branch.Get()
+		if !hasBranch || branch == initialBranch {
+			continue
+		}
+		branchInfo, hasBranchInfo := branchesSnapshot.Branches.FindByLocalName(branch).Get()
+		if !hasBranchInfo || SyncStatus(*branchInfo) != gitdomain.SyncStatusDeletedAtRemote {
+			continue
+		}
+		if parent, hasParent := validatedConfig.NormalConfig.Lineage.Parent(branch).Get(); hasParent {
+			hasUnmergedChanges, err := repo.Git.BranchHasUnmergedChanges(repo.Backend, branch, parent)
+			if err != nil {
+				return emptyResult, configdomain.ProgramFlowExit, err
+			}
+			if hasUnmergedChanges {
+				repo.FinalMessages.Addf(messages.WorktreePruneUnmergedChanges, branch, worktree.Path.RelativeTo(repo.RootDir))
+				continue
+			}
+		}
+		worktree, err = removable(repo, worktree)
+		if err != nil {
+			return emptyResult, configdomain.ProgramFlowExit, err
+		}
+		worktreesToPrune = append(worktreesToPrune, worktree)
+	}
+	return worktreePruneData{
+		branchInfosLastRun: branchInfosLastRun,
+		branchesSnapshot:   branchesSnapshot,
+		config:             validatedConfig,
+		hasOpenChanges:     repoStatus.OpenChanges,
+		initialBranch:      initialBranch,
+		inputs:             inputs,
+		previousBranch:     repo.Git.PreviouslyCheckedOutBranch(repo.Backend),
+		stashSize:          stashSize,
+		worktreesToPrune:   worktreesToPrune,
+	}, configdomain.ProgramFlowContinue, nil
+}
+
+func worktreePruneProgram(repo execute.OpenRepoResult, data worktreePruneData) program.Program {
+	prog := NewMutable(&program.Program{})
+	for _, worktree := range data.worktreesToPrune {
+		branch := worktree.Branch.GetOrPanic()
+		prog.Value.Add(&opcodes.WorktreeRemove{Path: worktree.Path})
+		prog.Value.Add(&opcodes.BranchLocalDelete{Branch: branch})
+		if data.config.NormalConfig.DryRun {
+			continue
+		}
+		sync.RemoveBranchConfiguration(sync.RemoveBranchConfigurationArgs{
+			Branch:  branch,
+			Lineage: data.config.NormalConfig.Lineage,
+			Order:   data.config.NormalConfig.Order,
+			Program: prog,
+		})
+		if _, hasOverride := data.config.NormalConfig.BranchTypeOverrides[branch]; hasOverride {
+			prog.Value.Add(&opcodes.BranchTypeOverrideRemove{Branch: branch})
+		}
+		programs.RemoveBranchOverridesProgram(prog, repo.UnvalidatedConfig.GitLocal.BranchOverrides, branch)
+	}
+	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
+		DryRun:                   data.config.NormalConfig.DryRun,
+		InitialStashSize:         data.stashSize,
+		RunInGitRoot:             true,
+		StashOpenChanges:         false,
+		PreviousBranchCandidates: []Option[gitdomain.LocalBranchName]{data.previousBranch, Some(data.initialBranch)},
+	})
+	return prog.Immutable()
+}
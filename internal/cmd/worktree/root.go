@@ -0,0 +1,22 @@
+package worktree
+
+import (
+	"github.com/git-town/git-town/v22/internal/cmd/cmdhelpers"
+	"github.com/spf13/cobra"
+)
+
+const worktreeDesc = "Commands that manage a worktree for each branch"
+
+func RootCommand() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "worktree",
+		GroupID: cmdhelpers.GroupIDBasic,
+		Args:    cobra.NoArgs,
+		Short:   worktreeDesc,
+		Long:    cmdhelpers.Long(worktreeDesc),
+	}
+	cmd.AddCommand(addCommand())
+	cmd.AddCommand(listCommand())
+	cmd.AddCommand(pruneCommand())
+	return &cmd
+}
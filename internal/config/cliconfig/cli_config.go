@@ -25,8 +25,10 @@ func New(args NewArgs) configdomain.PartialConfig {
 		Aliases:                     configdomain.Aliases{},
 		AutoResolve:                 args.AutoResolve,
 		AutoSync:                    args.AutoSync,
+		AzuredevopsToken:            None[forgedomain.AzuredevopsToken](),
 		BitbucketAppPassword:        None[forgedomain.BitbucketAppPassword](),
 		BitbucketUsername:           None[forgedomain.BitbucketUsername](),
+		BranchOverrides:             configdomain.BranchOverrides{},
 		BranchPrefix:                None[configdomain.BranchPrefix](),
 		BranchTypeOverrides:         configdomain.BranchTypeOverrides{},
 		Browser:                     None[configdomain.Browser](),
@@ -57,8 +59,14 @@ func New(args NewArgs) configdomain.PartialConfig {
 		PerennialRegex:              None[configdomain.PerennialRegex](),
 		ProposalBreadcrumb:          None[configdomain.ProposalBreadcrumb](),
 		ProposalBreadcrumbDirection: None[configdomain.ProposalBreadcrumbDirection](),
+		ProposeAssignees:            gitdomain.ProposalMetadataNames{},
+		ProposeDraft:                None[configdomain.ProposeDraft](),
+		ProposeLabels:               gitdomain.ProposalMetadataNames{},
+		ProposeReviewers:            gitdomain.ProposalMetadataNames{},
+		ProposeTemplate:             None[configdomain.ProposeTemplate](),
 		PushHook:                    None[configdomain.PushHook](),
 		ShareNewBranches:            None[configdomain.ShareNewBranches](),
+		ShipAPIMergeMethod:          None[forgedomain.ProposalMergeMethod](),
 		ShipDeleteTrackingBranch:    None[configdomain.ShipDeleteTrackingBranch](),
 		IgnoreUncommitted:           args.IgnoreUncommitted,
 		ShipStrategy:                None[configdomain.ShipStrategy](),
@@ -71,5 +79,6 @@ func New(args NewArgs) configdomain.PartialConfig {
 		SyncUpstream:                None[configdomain.SyncUpstream](),
 		UnknownBranchType:           None[configdomain.UnknownBranchType](),
 		Verbose:                     args.Verbose,
+		WorktreeRoot:                None[configdomain.WorktreeRoot](),
 	}
 }
@@ -4,6 +4,16 @@ import . "github.com/git-town/git-town/v22/pkg/prelude"
 
 type AliasableCommands []AliasableCommand
 
+// Lookup provides the AliasableCommand with the given name.
+func (self AliasableCommands) Lookup(name string) Option[AliasableCommand] {
+	for _, aliasableCommand := range self {
+		if aliasableCommand.String() == name {
+			return Some(aliasableCommand)
+		}
+	}
+	return None[AliasableCommand]()
+}
+
 // LookupKey provides the AliasKey matching the given key name.
 func (self AliasableCommands) LookupKey(name string) Option[AliasKey] {
 	for _, aliasableCommand := range self {
@@ -1,5 +1,7 @@
 package configdomain
 
+import "strconv"
+
 // AutoResolve indicates whether a Git Town command should not auto-resolve phantom merge conflicts.
 type AutoResolve bool
 
@@ -10,3 +12,7 @@ func (self AutoResolve) NoAutoResolve() bool {
 func (self AutoResolve) ShouldAutoResolve() bool {
 	return bool(self)
 }
+
+func (self AutoResolve) String() string {
+	return strconv.FormatBool(self.ShouldAutoResolve())
+}
@@ -0,0 +1,9 @@
+package configdomain
+
+// BatchPush indicates whether "git town sync" should push all synced branches
+// using as few "git push" invocations as possible.
+type BatchPush bool
+
+func (self BatchPush) Enabled() bool {
+	return bool(self)
+}
@@ -0,0 +1,94 @@
+package configdomain
+
+import (
+	"github.com/git-town/git-town/v22/internal/gohacks"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// BranchOverridableKeys contains the keys of the settings that can be configured per branch.
+var BranchOverridableKeys = []Key{
+	KeyPushBranches,
+	KeyShareNewBranches,
+	KeyShipStrategy,
+	KeySyncFeatureStrategy,
+}
+
+// BranchOverride contains the settings that apply to particular branches
+// instead of the repo-wide settings.
+type BranchOverride struct {
+	PushBranches        Option[PushBranches]
+	ShareNewBranches    Option[ShareNewBranches]
+	ShipStrategy        Option[ShipStrategy]
+	SyncFeatureStrategy Option[SyncFeatureStrategy]
+}
+
+func EmptyBranchOverride() BranchOverride {
+	return BranchOverride{
+		PushBranches:        None[PushBranches](),
+		ShareNewBranches:    None[ShareNewBranches](),
+		ShipStrategy:        None[ShipStrategy](),
+		SyncFeatureStrategy: None[SyncFeatureStrategy](),
+	}
+}
+
+// IsEmpty indicates whether this BranchOverride overrides no settings.
+func (self BranchOverride) IsEmpty() bool {
+	return self.PushBranches.IsNone() && self.ShareNewBranches.IsNone() && self.ShipStrategy.IsNone() && self.SyncFeatureStrategy.IsNone()
+}
+
+// Merge combines the settings of the given BranchOverride with this BranchOverride,
+// favoring the settings of the given BranchOverride.
+func (self BranchOverride) Merge(other BranchOverride) BranchOverride {
+	return BranchOverride{
+		PushBranches:        other.PushBranches.Or(self.PushBranches),
+		ShareNewBranches:    other.ShareNewBranches.Or(self.ShareNewBranches),
+		ShipStrategy:        other.ShipStrategy.Or(self.ShipStrategy),
+		SyncFeatureStrategy: other.SyncFeatureStrategy.Or(self.SyncFeatureStrategy),
+	}
+}
+
+// Set provides a copy of this BranchOverride that contains the given serialized value for the setting with the given key.
+func (self BranchOverride) Set(key Key, value string, source string) (BranchOverride, error) {
+	var err error
+	switch key {
+	case KeyPushBranches:
+		self.PushBranches, err = gohacks.ParseBoolOpt[PushBranches](value, source)
+	case KeyShareNewBranches:
+		self.ShareNewBranches, err = ParseShareNewBranches(value, source)
+	case KeyShipStrategy:
+		self.ShipStrategy, err = ParseShipStrategy(value, source)
+	case KeySyncFeatureStrategy:
+		self.SyncFeatureStrategy, err = ParseSyncFeatureStrategy(value, source)
+	}
+	return self, err
+}
+
+// Value provides the serialized value of the setting with the given key in this BranchOverride.
+func (self BranchOverride) Value(key Key) Option[string] {
+	switch key {
+	case KeyPushBranches:
+		return stringerOpt(self.PushBranches)
+	case KeyShareNewBranches:
+		return stringerOpt(self.ShareNewBranches)
+	case KeyShipStrategy:
+		return stringerOpt(self.ShipStrategy)
+	case KeySyncFeatureStrategy:
+		return stringerOpt(self.SyncFeatureStrategy)
+	}
+	return None[string]()
+}
+
+// Without provides a copy of this BranchOverride that doesn't override the setting with the given key.
+func (self BranchOverride) Without(key Key) BranchOverride {
+	switch key {
+	case KeyPushBranches:
+		self.PushBranches = None[PushBranches]()
+	case KeyShareNewBranches:
+		self.ShareNewBranches = None[ShareNewBranches]()
+	case KeyShipStrategy:
+		self.ShipStrategy = None[ShipStrategy]()
+	case KeySyncFeatureStrategy:
+		self.SyncFeatureStrategy = None[SyncFeatureStrategy]()
+	}
+	return self
+}
@@ -0,0 +1,65 @@
+package configdomain
+
+import (
+	"strings"
+
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// BranchOverrideKey is a Key that contains a setting for a particular branch,
+// for example "git-town-branch.foo.sync-feature-strategy".
+type BranchOverrideKey struct {
+	BranchSpecificKey
+}
+
+// Branch provides the name of the branch encoded in this BranchOverrideKey.
+func (self BranchOverrideKey) Branch() gitdomain.LocalBranchName {
+	text := strings.TrimPrefix(self.String(), BranchSpecificKeyPrefix)
+	text = strings.TrimSuffix(text, branchOverrideSuffix(self.Setting()))
+	return gitdomain.NewLocalBranchName(text)
+}
+
+// Setting provides the key of the repo-wide setting that this BranchOverrideKey overrides.
+func (self BranchOverrideKey) Setting() Key {
+	setting, _ := branchOverrideSetting(self.String()).Get()
+	return setting
+}
+
+func IsBranchOverrideKey(key string) bool {
+	return isBranchSpecificKey(key) && branchOverrideSetting(key).IsSome()
+}
+
+func NewBranchOverrideKey(branch gitdomain.LocalBranchName, setting Key) BranchOverrideKey {
+	return BranchOverrideKey{
+		BranchSpecificKey: BranchSpecificKey{
+			Key: Key(BranchSpecificKeyPrefix + branch.String() + branchOverrideSuffix(setting)),
+		},
+	}
+}
+
+func ParseBranchOverrideKey(key Key) Option[BranchOverrideKey] {
+	if IsBranchOverrideKey(key.String()) {
+		return Some(BranchOverrideKey{
+			BranchSpecificKey: BranchSpecificKey{
+				Key: key,
+			},
+		})
+	}
+	return None[BranchOverrideKey]()
+}
+
+// provides the key of the repo-wide setting that the given branch override key overrides
+func branchOverrideSetting(key string) Option[Key] {
+	for _, setting := range BranchOverridableKeys {
+		if strings.HasSuffix(key, branchOverrideSuffix(setting)) {
+			return Some(setting)
+		}
+	}
+	return None[Key]()
+}
+
+// provides the suffix of branch override keys for the given setting, for example ".sync-feature-strategy"
+func branchOverrideSuffix(setting Key) string {
+	return "." + strings.TrimPrefix(setting.String(), "git-town.")
+}
@@ -0,0 +1,77 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestBranchOverrideKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Branch", func(t *testing.T) {
+		t.Parallel()
+		branch := gitdomain.LocalBranchName("feature/my-branch")
+		key := configdomain.NewBranchOverrideKey(branch, configdomain.KeySyncFeatureStrategy)
+		have := key.Branch()
+		must.EqOp(t, branch, have)
+	})
+
+	t.Run("IsBranchOverrideKey", func(t *testing.T) {
+		t.Parallel()
+		tests := map[string]bool{
+			"git-town-branch.foo.branchtype":            false,
+			"git-town-branch.foo.parent":                false,
+			"git-town-branch.foo.push-branches":         true,
+			"git-town-branch.foo.share-new-branches":    true,
+			"git-town-branch.foo.ship-strategy":         true,
+			"git-town-branch.foo.sync-feature-strategy": true,
+			"git-town.sync-feature-strategy":            false,
+		}
+		for give, want := range tests {
+			have := configdomain.IsBranchOverrideKey(give)
+			must.EqOp(t, want, have)
+		}
+	})
+
+	t.Run("NewBranchOverrideKey", func(t *testing.T) {
+		t.Parallel()
+		have := configdomain.NewBranchOverrideKey("my-branch", configdomain.KeyShipStrategy)
+		want := configdomain.BranchOverrideKey{
+			BranchSpecificKey: configdomain.BranchSpecificKey{
+				Key: "git-town-branch.my-branch.ship-strategy",
+			},
+		}
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("ParseBranchOverrideKey", func(t *testing.T) {
+		t.Parallel()
+		t.Run("is branch override", func(t *testing.T) {
+			t.Parallel()
+			have := configdomain.ParseBranchOverrideKey("git-town-branch.my-branch.push-branches")
+			want := Some(configdomain.BranchOverrideKey{
+				BranchSpecificKey: configdomain.BranchSpecificKey{
+					Key: "git-town-branch.my-branch.push-branches",
+				},
+			})
+			must.Eq(t, want, have)
+		})
+		t.Run("is branch type override", func(t *testing.T) {
+			t.Parallel()
+			have := configdomain.ParseBranchOverrideKey("git-town-branch.my-branch.branchtype")
+			want := None[configdomain.BranchOverrideKey]()
+			must.Eq(t, want, have)
+		})
+	})
+
+	t.Run("Setting", func(t *testing.T) {
+		t.Parallel()
+		key := configdomain.NewBranchOverrideKey("my-branch", configdomain.KeyShareNewBranches)
+		have := key.Setting()
+		must.EqOp(t, configdomain.KeyShareNewBranches, have)
+	})
+}
@@ -0,0 +1,55 @@
+package configdomain
+
+import (
+	"maps"
+
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/gohacks/mapstools"
+)
+
+// BranchOverrides contains the settings that apply to particular branches, keyed by branch name or glob pattern.
+// Git metadata stores them like this: "git-town-branch.<name>.sync-feature-strategy".
+// The configuration file stores them in "[branches.<glob>]" tables.
+type BranchOverrides map[BranchPattern]BranchOverride
+
+// Concat adds the given BranchOverrides to this BranchOverrides.
+// Settings in the given BranchOverrides override the same settings for the same pattern in this BranchOverrides.
+func (self BranchOverrides) Concat(other BranchOverrides) BranchOverrides {
+	result := make(BranchOverrides, len(self)+len(other))
+	maps.Copy(result, self)
+	for pattern, override := range other { // okay to iterate the map in random order because we assign to a new map
+		result[pattern] = result.lookup(pattern).Merge(override)
+	}
+	return result
+}
+
+// For provides the overridden settings that apply to the given branch.
+// Entries for the exact branch name take precedence over glob patterns.
+// If several glob patterns match, the alphabetically later pattern wins.
+func (self BranchOverrides) For(branch gitdomain.LocalBranchName) BranchOverride {
+	result := EmptyBranchOverride()
+	for pattern, override := range mapstools.SortedKeyValues(self) {
+		if !pattern.IsBranchName(branch) && pattern.Matches(branch) {
+			result = result.Merge(override)
+		}
+	}
+	return result.Merge(self.lookup(BranchPattern(branch)))
+}
+
+// Without provides a copy of this BranchOverrides that doesn't override the setting with the given key.
+func (self BranchOverrides) Without(key Key) BranchOverrides {
+	result := make(BranchOverrides, len(self))
+	for pattern, override := range self { // okay to iterate the map in random order because we assign to a new map
+		if reduced := override.Without(key); !reduced.IsEmpty() {
+			result[pattern] = reduced
+		}
+	}
+	return result
+}
+
+func (self BranchOverrides) lookup(pattern BranchPattern) BranchOverride {
+	if override, has := self[pattern]; has {
+		return override
+	}
+	return EmptyBranchOverride()
+}
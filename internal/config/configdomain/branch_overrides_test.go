@@ -0,0 +1,128 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestBranchOverrides(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Concat", func(t *testing.T) {
+		t.Parallel()
+		data1 := configdomain.BranchOverrides{
+			"branch-1": configdomain.BranchOverride{
+				PushBranches:        Some(configdomain.PushBranches(false)),
+				ShareNewBranches:    None[configdomain.ShareNewBranches](),
+				ShipStrategy:        None[configdomain.ShipStrategy](),
+				SyncFeatureStrategy: Some(configdomain.SyncFeatureStrategyMerge),
+			},
+		}
+		data2 := configdomain.BranchOverrides{
+			"branch-1": configdomain.BranchOverride{
+				PushBranches:        None[configdomain.PushBranches](),
+				ShareNewBranches:    None[configdomain.ShareNewBranches](),
+				ShipStrategy:        None[configdomain.ShipStrategy](),
+				SyncFeatureStrategy: Some(configdomain.SyncFeatureStrategyRebase),
+			},
+			"release/*": configdomain.BranchOverride{
+				PushBranches:        None[configdomain.PushBranches](),
+				ShareNewBranches:    None[configdomain.ShareNewBranches](),
+				ShipStrategy:        Some(configdomain.ShipStrategyFastForward),
+				SyncFeatureStrategy: None[configdomain.SyncFeatureStrategy](),
+			},
+		}
+		have := data1.Concat(data2)
+		want := configdomain.BranchOverrides{
+			"branch-1": configdomain.BranchOverride{
+				PushBranches:        Some(configdomain.PushBranches(false)),
+				ShareNewBranches:    None[configdomain.ShareNewBranches](),
+				ShipStrategy:        None[configdomain.ShipStrategy](),
+				SyncFeatureStrategy: Some(configdomain.SyncFeatureStrategyRebase),
+			},
+			"release/*": configdomain.BranchOverride{
+				PushBranches:        None[configdomain.PushBranches](),
+				ShareNewBranches:    None[configdomain.ShareNewBranches](),
+				ShipStrategy:        Some(configdomain.ShipStrategyFastForward),
+				SyncFeatureStrategy: None[configdomain.SyncFeatureStrategy](),
+			},
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("For", func(t *testing.T) {
+		t.Parallel()
+		overrides := configdomain.BranchOverrides{
+			"release/*": configdomain.BranchOverride{
+				PushBranches:        Some(configdomain.PushBranches(false)),
+				ShareNewBranches:    None[configdomain.ShareNewBranches](),
+				ShipStrategy:        None[configdomain.ShipStrategy](),
+				SyncFeatureStrategy: Some(configdomain.SyncFeatureStrategyMerge),
+			},
+			"release/1": configdomain.BranchOverride{
+				PushBranches:        None[configdomain.PushBranches](),
+				ShareNewBranches:    None[configdomain.ShareNewBranches](),
+				ShipStrategy:        None[configdomain.ShipStrategy](),
+				SyncFeatureStrategy: Some(configdomain.SyncFeatureStrategyRebase),
+			},
+		}
+		t.Run("branch name and glob pattern match", func(t *testing.T) {
+			t.Parallel()
+			have := overrides.For("release/1")
+			want := configdomain.BranchOverride{
+				PushBranches:        Some(configdomain.PushBranches(false)),
+				ShareNewBranches:    None[configdomain.ShareNewBranches](),
+				ShipStrategy:        None[configdomain.ShipStrategy](),
+				SyncFeatureStrategy: Some(configdomain.SyncFeatureStrategyRebase),
+			}
+			must.Eq(t, want, have)
+		})
+		t.Run("glob pattern matches", func(t *testing.T) {
+			t.Parallel()
+			have := overrides.For("release/2")
+			want := configdomain.BranchOverride{
+				PushBranches:        Some(configdomain.PushBranches(false)),
+				ShareNewBranches:    None[configdomain.ShareNewBranches](),
+				ShipStrategy:        None[configdomain.ShipStrategy](),
+				SyncFeatureStrategy: Some(configdomain.SyncFeatureStrategyMerge),
+			}
+			must.Eq(t, want, have)
+		})
+		t.Run("nothing matches", func(t *testing.T) {
+			t.Parallel()
+			have := overrides.For("release/2/hotfix")
+			must.Eq(t, configdomain.EmptyBranchOverride(), have)
+		})
+	})
+
+	t.Run("Without", func(t *testing.T) {
+		t.Parallel()
+		overrides := configdomain.BranchOverrides{
+			"branch-1": configdomain.BranchOverride{
+				PushBranches:        Some(configdomain.PushBranches(false)),
+				ShareNewBranches:    None[configdomain.ShareNewBranches](),
+				ShipStrategy:        None[configdomain.ShipStrategy](),
+				SyncFeatureStrategy: Some(configdomain.SyncFeatureStrategyMerge),
+			},
+			"branch-2": configdomain.BranchOverride{
+				PushBranches:        Some(configdomain.PushBranches(true)),
+				ShareNewBranches:    None[configdomain.ShareNewBranches](),
+				ShipStrategy:        None[configdomain.ShipStrategy](),
+				SyncFeatureStrategy: None[configdomain.SyncFeatureStrategy](),
+			},
+		}
+		have := overrides.Without(configdomain.KeyPushBranches)
+		want := configdomain.BranchOverrides{
+			"branch-1": configdomain.BranchOverride{
+				PushBranches:        None[configdomain.PushBranches](),
+				ShareNewBranches:    None[configdomain.ShareNewBranches](),
+				ShipStrategy:        None[configdomain.ShipStrategy](),
+				SyncFeatureStrategy: Some(configdomain.SyncFeatureStrategyMerge),
+			},
+		}
+		must.Eq(t, want, have)
+	})
+}
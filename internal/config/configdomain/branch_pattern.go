@@ -0,0 +1,35 @@
+package configdomain
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/messages"
+)
+
+// BranchPattern is a branch name or a glob pattern like "release/*" that matches branch names.
+// Branch names cannot contain glob characters, so a branch name matches only itself.
+type BranchPattern string
+
+// IsBranchName indicates whether this pattern is the given branch name.
+func (self BranchPattern) IsBranchName(branch gitdomain.LocalBranchName) bool {
+	return self.String() == branch.String()
+}
+
+// Matches indicates whether the given branch name matches this pattern.
+func (self BranchPattern) Matches(branch gitdomain.LocalBranchName) bool {
+	matches, err := path.Match(self.String(), branch.String())
+	return err == nil && matches
+}
+
+func (self BranchPattern) String() string {
+	return string(self)
+}
+
+func ParseBranchPattern(value string, source string) (BranchPattern, error) {
+	if _, err := path.Match(value, ""); err != nil || value == "" {
+		return "", fmt.Errorf(messages.BranchPatternInvalid, source, value)
+	}
+	return BranchPattern(value), nil
+}
@@ -0,0 +1,48 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestBranchPattern(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Matches", func(t *testing.T) {
+		t.Parallel()
+		tests := []struct {
+			pattern configdomain.BranchPattern
+			branch  gitdomain.LocalBranchName
+			want    bool
+		}{
+			{pattern: "feature", branch: "feature", want: true},
+			{pattern: "feature", branch: "feature-2", want: false},
+			{pattern: "release/*", branch: "release/1", want: true},
+			{pattern: "release/*", branch: "release/1/hotfix", want: false},
+			{pattern: "release-*", branch: "release-1", want: true},
+			{pattern: "v?", branch: "v1", want: true},
+		}
+		for _, test := range tests {
+			have := test.pattern.Matches(test.branch)
+			must.EqOp(t, test.want, have)
+		}
+	})
+
+	t.Run("ParseBranchPattern", func(t *testing.T) {
+		t.Parallel()
+		t.Run("valid pattern", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.ParseBranchPattern("release/*", "test")
+			must.NoError(t, err)
+			must.EqOp(t, "release/*", have)
+		})
+		t.Run("invalid pattern", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.ParseBranchPattern("release/[", "test")
+			must.Error(t, err)
+		})
+	})
+}
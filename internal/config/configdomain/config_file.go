@@ -0,0 +1,22 @@
+package configdomain
+
+// ConfigFile is a configuration file that Git Town has loaded.
+type ConfigFile struct {
+	Config PartialConfig  // the settings that this file contains, without the settings of the files it includes
+	Kind   ConfigFileKind // the role this file plays in the configuration
+	Path   string         // the location of this file, for displaying to the user
+}
+
+// ConfigFileKind describes the role that a configuration file plays in the configuration.
+type ConfigFileKind string
+
+const (
+	ConfigFileKindIncluded  ConfigFileKind = "included"  // a file that another configuration file includes
+	ConfigFileKindRepo      ConfigFileKind = "repo"      // the configuration file in the root directory of the repository
+	ConfigFileKindSubfolder ConfigFileKind = "subfolder" // a configuration file in a subfolder of the repository
+	ConfigFileKindUser      ConfigFileKind = "user"      // the user-wide configuration file
+)
+
+func (self ConfigFileKind) String() string {
+	return string(self)
+}
@@ -0,0 +1,25 @@
+package configdomain
+
+// ConfigFiles contains the configuration files that Git Town has loaded,
+// ordered from lowest to highest precedence.
+type ConfigFiles []ConfigFile
+
+// Merge provides the effective settings of all configuration files.
+func (self ConfigFiles) Merge() PartialConfig {
+	result := EmptyPartialConfig()
+	for _, file := range self {
+		result = result.Merge(file.Config)
+	}
+	return result
+}
+
+// Repo provides the settings of the configuration file in the root directory of the repository.
+// This is the configuration file that Git Town updates.
+func (self ConfigFiles) Repo() PartialConfig {
+	for _, file := range self {
+		if file.Kind == ConfigFileKindRepo {
+			return file.Config
+		}
+	}
+	return EmptyPartialConfig()
+}
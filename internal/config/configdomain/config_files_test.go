@@ -0,0 +1,57 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestConfigFiles(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Merge", func(t *testing.T) {
+		t.Parallel()
+		user := configdomain.EmptyPartialConfig()
+		user.Browser = Some(configdomain.Browser("firefox"))
+		user.Order = Some(configdomain.OrderDesc)
+		repo := configdomain.EmptyPartialConfig()
+		repo.Order = Some(configdomain.OrderAsc)
+		files := configdomain.ConfigFiles{
+			{Config: user, Kind: configdomain.ConfigFileKindUser, Path: "~/.config/git-town/config.toml"},
+			{Config: repo, Kind: configdomain.ConfigFileKindRepo, Path: "git-town.toml"},
+		}
+		have := files.Merge()
+		must.Eq(t, Some(configdomain.Browser("firefox")), have.Browser)
+		must.Eq(t, Some(configdomain.OrderAsc), have.Order)
+	})
+
+	t.Run("Repo", func(t *testing.T) {
+		t.Parallel()
+		t.Run("has a repo config file", func(t *testing.T) {
+			t.Parallel()
+			user := configdomain.EmptyPartialConfig()
+			user.Browser = Some(configdomain.Browser("firefox"))
+			repo := configdomain.EmptyPartialConfig()
+			repo.Order = Some(configdomain.OrderAsc)
+			files := configdomain.ConfigFiles{
+				{Config: user, Kind: configdomain.ConfigFileKindUser, Path: "~/.config/git-town/config.toml"},
+				{Config: repo, Kind: configdomain.ConfigFileKindRepo, Path: "git-town.toml"},
+			}
+			have := files.Repo()
+			must.Eq(t, None[configdomain.Browser](), have.Browser)
+			must.Eq(t, Some(configdomain.OrderAsc), have.Order)
+		})
+		t.Run("no repo config file", func(t *testing.T) {
+			t.Parallel()
+			user := configdomain.EmptyPartialConfig()
+			user.Browser = Some(configdomain.Browser("firefox"))
+			files := configdomain.ConfigFiles{
+				{Config: user, Kind: configdomain.ConfigFileKindUser, Path: "~/.config/git-town/config.toml"},
+			}
+			have := files.Repo()
+			must.Eq(t, None[configdomain.Browser](), have.Browser)
+		})
+	})
+}
@@ -0,0 +1,18 @@
+package configdomain
+
+// ConfigOrigin describes the configuration source that provides a configuration value.
+type ConfigOrigin string
+
+const (
+	ConfigOriginCLI     ConfigOrigin = "cli"     // CLI flags
+	ConfigOriginDefault ConfigOrigin = "default" // Git Town's default values
+	ConfigOriginEnv     ConfigOrigin = "env"     // environment variables
+	ConfigOriginFile    ConfigOrigin = "file"    // the configuration file
+	ConfigOriginGlobal  ConfigOrigin = "global"  // global Git metadata
+	ConfigOriginLocal   ConfigOrigin = "local"   // local Git metadata
+	ConfigOriginSystem  ConfigOrigin = "system"  // properties of the system Git Town runs on
+)
+
+func (self ConfigOrigin) String() string {
+	return string(self)
+}
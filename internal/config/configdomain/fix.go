@@ -0,0 +1,4 @@
+package configdomain
+
+// Fix indicates whether "git town stack status" should reconcile the proposals it finds out of sync with the lineage.
+type Fix bool
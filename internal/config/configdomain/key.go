@@ -2,6 +2,7 @@ package configdomain
 
 import (
 	"encoding/json"
+	"slices"
 
 	"github.com/git-town/git-town/v22/internal/git/gitdomain"
 	"github.com/git-town/git-town/v22/pkg"
@@ -23,6 +24,25 @@ type ConfigUpdate struct {
 	Before ConfigSetting
 }
 
+// IsDeprecated indicates whether Git Town replaces this key with a different key when encountering it.
+func (self Key) IsDeprecated() bool {
+	if _, isDeprecated := DeprecatedKeys[self]; isDeprecated {
+		return true
+	}
+	if _, isObsoleteBranchList := ObsoleteBranchLists[self]; isObsoleteBranchList {
+		return true
+	}
+	if slices.Contains(ObsoleteKeys, self) {
+		return true
+	}
+	for _, update := range ConfigUpdates {
+		if update.Before.Key == self && update.After.Key != self {
+			return true
+		}
+	}
+	return false
+}
+
 // MarshalJSON is used when serializing this LocalBranchName to JSON.
 func (self Key) MarshalJSON() ([]byte, error) {
 	return json.Marshal(self.String())
@@ -56,6 +76,7 @@ const (
 	KeyAliasSync                           = Key("alias.sync")
 	KeyAutoResolve                         = Key("git-town.auto-resolve")
 	KeyAutoSync                            = Key("git-town.auto-sync")
+	KeyAzuredevopsToken                    = Key("git-town.azuredevops-token")
 	KeyBitbucketAppPassword                = Key("git-town.bitbucket-app-password")
 	KeyBitbucketUsername                   = Key("git-town.bitbucket-username")
 	KeyBranchPrefix                        = Key("git-town.branch-prefix")
@@ -106,9 +127,12 @@ const (
 	KeyPerennialRegex                      = Key("git-town.perennial-regex")
 	KeyProposalBreadcrumb                  = Key("git-town.proposal-breadcrumb")
 	KeyProposalBreadcrumbDirection         = Key("git-town.proposal-breadcrumb-direction")
+	KeyProposeDraft                        = Key("git-town.propose-draft")
+	KeyProposeTemplate                     = Key("git-town.propose-template")
 	KeyPushBranches                        = Key("git-town.push-branches")
 	KeyPushHook                            = Key("git-town.push-hook")
 	KeyShareNewBranches                    = Key("git-town.share-new-branches")
+	KeyShipAPIMergeMethod                  = Key("git-town.ship-api-merge-method")
 	KeyShipDeleteTrackingBranch            = Key("git-town.ship-delete-tracking-branch")
 	KeyShipStrategy                        = Key("git-town.ship-strategy")
 	KeyStash                               = Key("git-town.stash")
@@ -118,6 +142,7 @@ const (
 	KeySyncTags                            = Key("git-town.sync-tags")
 	KeySyncUpstream                        = Key("git-town.sync-upstream")
 	KeyUnknownBranchType                   = Key("git-town.unknown-branch-type")
+	KeyWorktreeRoot                        = Key("git-town.worktree-root")
 	KeyGitUserEmail                        = Key("user.email")
 	KeyGitUserName                         = Key("user.name")
 )
@@ -125,6 +150,7 @@ const (
 var keys = []Key{
 	KeyAutoResolve,
 	KeyAutoSync,
+	KeyAzuredevopsToken,
 	KeyBitbucketAppPassword,
 	KeyBitbucketUsername,
 	KeyBranchPrefix,
@@ -176,9 +202,12 @@ var keys = []Key{
 	KeyPerennialRegex,
 	KeyProposalBreadcrumb,
 	KeyProposalBreadcrumbDirection,
+	KeyProposeDraft,
+	KeyProposeTemplate,
 	KeyPushBranches,
 	KeyPushHook,
 	KeyShareNewBranches,
+	KeyShipAPIMergeMethod,
 	KeyShipDeleteTrackingBranch,
 	KeyIgnoreUncommitted,
 	KeyShipStrategy,
@@ -189,6 +218,7 @@ var keys = []Key{
 	KeySyncTags,
 	KeySyncUpstream,
 	KeyUnknownBranchType,
+	KeyWorktreeRoot,
 }
 
 func NewParentKey(branch gitdomain.LocalBranchName) Key {
@@ -201,7 +231,7 @@ func ParseKey(name string) Option[Key] {
 			return Some(configKey)
 		}
 	}
-	if isLineageKey(name) || IsBranchTypeOverrideKey(name) {
+	if isLineageKey(name) || IsBranchTypeOverrideKey(name) || IsBranchOverrideKey(name) {
 		return Some(Key(name))
 	}
 	if aliasKey, isAliasKey := AllAliasableCommands().LookupKey(name).Get(); isAliasKey {
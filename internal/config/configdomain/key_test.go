@@ -10,6 +10,22 @@ import (
 func TestKey(t *testing.T) {
 	t.Parallel()
 
+	t.Run("IsDeprecated", func(t *testing.T) {
+		t.Parallel()
+		tests := map[configdomain.Key]bool{
+			configdomain.KeyDeprecatedSyncStrategy:            true,
+			configdomain.KeyDeprecatedCreatePrototypeBranches: true,
+			configdomain.KeyDeprecatedObservedBranches:        true,
+			configdomain.KeyObsoleteSyncBeforeShip:            true,
+			configdomain.KeyForgeType:                         false,
+			configdomain.KeySyncFeatureStrategy:               false,
+		}
+		for give, want := range tests {
+			have := give.IsDeprecated()
+			must.EqOp(t, want, have)
+		}
+	})
+
 	t.Run("ParseKey", func(t *testing.T) {
 		t.Parallel()
 		t.Run("normal config key", func(t *testing.T) {
@@ -0,0 +1,9 @@
+package configdomain
+
+// MergeDown indicates whether "git town merge" should merge the current branch into its child branch.
+type MergeDown bool
+
+// Enabled indicates whether merging down is enabled.
+func (self MergeDown) Enabled() bool {
+	return bool(self)
+}
@@ -0,0 +1,39 @@
+package configdomain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v22/internal/messages"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// OutputFormat describes the format in which Git Town prints information.
+type OutputFormat string
+
+const (
+	OutputFormatJSON OutputFormat = "json" // machine-readable JSON
+	OutputFormatText OutputFormat = "text" // human-readable text
+)
+
+var OutputFormatValues = []OutputFormat{
+	OutputFormatJSON,
+	OutputFormatText,
+}
+
+func (self OutputFormat) String() string {
+	return string(self)
+}
+
+func ParseOutputFormat(value string, source string) (Option[OutputFormat], error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "":
+		return None[OutputFormat](), nil
+	case "json":
+		return Some(OutputFormatJSON), nil
+	case "text":
+		return Some(OutputFormatText), nil
+	default:
+		return None[OutputFormat](), fmt.Errorf(messages.OutputFormatInvalid, source, value)
+	}
+}
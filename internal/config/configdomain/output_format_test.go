@@ -0,0 +1,36 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestParseOutputFormat(t *testing.T) {
+	t.Parallel()
+
+	t.Run("acceptable content", func(t *testing.T) {
+		t.Parallel()
+		tests := map[string]Option[configdomain.OutputFormat]{
+			"":      None[configdomain.OutputFormat](),
+			"json":  Some(configdomain.OutputFormatJSON),
+			"JSON":  Some(configdomain.OutputFormatJSON),
+			" json": Some(configdomain.OutputFormatJSON),
+			"text":  Some(configdomain.OutputFormatText),
+			"Text":  Some(configdomain.OutputFormatText),
+		}
+		for give, want := range tests {
+			have, err := configdomain.ParseOutputFormat(give, "test")
+			must.NoError(t, err)
+			must.Eq(t, want, have)
+		}
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		t.Parallel()
+		_, err := configdomain.ParseOutputFormat("zonk", "test")
+		must.EqError(t, err, `invalid output format defined in test: "zonk"`)
+	})
+}
@@ -1,6 +1,8 @@
 package configdomain
 
 import (
+	"fmt"
+
 	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
 	"github.com/git-town/git-town/v22/internal/git/gitdomain"
 	"github.com/git-town/git-town/v22/internal/gohacks/mapstools"
@@ -19,8 +21,10 @@ type PartialConfig struct {
 	Aliases                     Aliases
 	AutoResolve                 Option[AutoResolve]
 	AutoSync                    Option[AutoSync]
+	AzuredevopsToken            Option[forgedomain.AzuredevopsToken]
 	BitbucketAppPassword        Option[forgedomain.BitbucketAppPassword]
 	BitbucketUsername           Option[forgedomain.BitbucketUsername]
+	BranchOverrides             BranchOverrides
 	BranchPrefix                Option[BranchPrefix]
 	BranchTypeOverrides         BranchTypeOverrides
 	Browser                     Option[Browser]
@@ -52,9 +56,15 @@ type PartialConfig struct {
 	PerennialRegex              Option[PerennialRegex]
 	ProposalBreadcrumb          Option[ProposalBreadcrumb]
 	ProposalBreadcrumbDirection Option[ProposalBreadcrumbDirection]
+	ProposeAssignees            gitdomain.ProposalMetadataNames
+	ProposeDraft                Option[ProposeDraft]
+	ProposeLabels               gitdomain.ProposalMetadataNames
+	ProposeReviewers            gitdomain.ProposalMetadataNames
+	ProposeTemplate             Option[ProposeTemplate]
 	PushBranches                Option[PushBranches]
 	PushHook                    Option[PushHook]
 	ShareNewBranches            Option[ShareNewBranches]
+	ShipAPIMergeMethod          Option[forgedomain.ProposalMergeMethod]
 	ShipDeleteTrackingBranch    Option[ShipDeleteTrackingBranch]
 	ShipStrategy                Option[ShipStrategy]
 	Stash                       Option[Stash]
@@ -65,12 +75,14 @@ type PartialConfig struct {
 	SyncUpstream                Option[SyncUpstream]
 	UnknownBranchType           Option[UnknownBranchType]
 	Verbose                     Option[Verbose]
+	WorktreeRoot                Option[WorktreeRoot]
 }
 
 func EmptyPartialConfig() PartialConfig {
 	return PartialConfig{
-		Aliases: Aliases{},
-		Lineage: NewLineage(),
+		Aliases:         Aliases{},
+		BranchOverrides: BranchOverrides{},
+		Lineage:         NewLineage(),
 	} //exhaustruct:ignore
 }
 
@@ -81,8 +93,10 @@ func (self PartialConfig) Merge(other PartialConfig) PartialConfig {
 		Aliases:                     mapstools.Merge(other.Aliases, self.Aliases),
 		AutoResolve:                 other.AutoResolve.Or(self.AutoResolve),
 		AutoSync:                    other.AutoSync.Or(self.AutoSync),
+		AzuredevopsToken:            other.AzuredevopsToken.Or(self.AzuredevopsToken),
 		BitbucketAppPassword:        other.BitbucketAppPassword.Or(self.BitbucketAppPassword),
 		BitbucketUsername:           other.BitbucketUsername.Or(self.BitbucketUsername),
+		BranchOverrides:             self.BranchOverrides.Concat(other.BranchOverrides),
 		BranchPrefix:                other.BranchPrefix.Or(self.BranchPrefix),
 		BranchTypeOverrides:         other.BranchTypeOverrides.Concat(self.BranchTypeOverrides),
 		Browser:                     other.Browser.Or(self.Browser),
@@ -114,9 +128,15 @@ func (self PartialConfig) Merge(other PartialConfig) PartialConfig {
 		PerennialRegex:              other.PerennialRegex.Or(self.PerennialRegex),
 		ProposalBreadcrumb:          other.ProposalBreadcrumb.Or(self.ProposalBreadcrumb),
 		ProposalBreadcrumbDirection: other.ProposalBreadcrumbDirection.Or(self.ProposalBreadcrumbDirection),
+		ProposeAssignees:            append(other.ProposeAssignees, self.ProposeAssignees...),
+		ProposeDraft:                other.ProposeDraft.Or(self.ProposeDraft),
+		ProposeLabels:               append(other.ProposeLabels, self.ProposeLabels...),
+		ProposeReviewers:            append(other.ProposeReviewers, self.ProposeReviewers...),
+		ProposeTemplate:             other.ProposeTemplate.Or(self.ProposeTemplate),
 		PushBranches:                other.PushBranches.Or(self.PushBranches),
 		PushHook:                    other.PushHook.Or(self.PushHook),
 		ShareNewBranches:            other.ShareNewBranches.Or(self.ShareNewBranches),
+		ShipAPIMergeMethod:          other.ShipAPIMergeMethod.Or(self.ShipAPIMergeMethod),
 		ShipDeleteTrackingBranch:    other.ShipDeleteTrackingBranch.Or(self.ShipDeleteTrackingBranch),
 		ShipStrategy:                other.ShipStrategy.Or(self.ShipStrategy),
 		Stash:                       other.Stash.Or(self.Stash),
@@ -127,7 +147,27 @@ func (self PartialConfig) Merge(other PartialConfig) PartialConfig {
 		SyncUpstream:                other.SyncUpstream.Or(self.SyncUpstream),
 		UnknownBranchType:           other.UnknownBranchType.Or(self.UnknownBranchType),
 		Verbose:                     other.Verbose.Or(self.Verbose),
+		WorktreeRoot:                other.WorktreeRoot.Or(self.WorktreeRoot),
+	}
+}
+
+// Snapshot provides the repo-wide settings and branch overrides in this PartialConfig,
+// serialized the way Git metadata stores them.
+func (self PartialConfig) Snapshot() SingleSnapshot {
+	result := SingleSnapshot{}
+	for _, key := range keys {
+		if value, hasValue := self.Value(key).Get(); hasValue {
+			result[key] = value
+		}
 	}
+	for pattern, override := range self.BranchOverrides { // okay to iterate the map in random order because we assign to a new map
+		for _, setting := range BranchOverridableKeys {
+			if value, hasValue := override.Value(setting).Get(); hasValue {
+				result[NewBranchOverrideKey(gitdomain.NewLocalBranchName(pattern.String()), setting).Key] = value
+			}
+		}
+	}
+	return result
 }
 
 func (self PartialConfig) ToUnvalidatedConfig() UnvalidatedConfigData {
@@ -137,3 +177,145 @@ func (self PartialConfig) ToUnvalidatedConfig() UnvalidatedConfigData {
 		MainBranch:   self.MainBranch,
 	}
 }
+
+// Value provides the value of the setting with the given key in this PartialConfig,
+// serialized the way Git metadata stores it.
+func (self PartialConfig) Value(key Key) Option[string] {
+	if lineageKey, isLineageKey := ParseLineageKey(key).Get(); isLineageKey {
+		return stringerOpt(self.Lineage.Parent(lineageKey.ChildBranch()))
+	}
+	if overrideKey, isOverrideKey := ParseBranchOverrideKey(key).Get(); isOverrideKey {
+		override, hasOverride := self.BranchOverrides[BranchPattern(overrideKey.Branch())]
+		if !hasOverride {
+			return None[string]()
+		}
+		return override.Value(overrideKey.Setting())
+	}
+	if overrideKey, isOverrideKey := ParseBranchTypeOverrideKey(key).Get(); isOverrideKey {
+		branchType, hasBranchType := self.BranchTypeOverrides[overrideKey.Branch()]
+		if !hasBranchType {
+			return None[string]()
+		}
+		return Some(branchType.String())
+	}
+	if aliasKey, isAliasKey := AllAliasableCommands().LookupKey(key.String()).Get(); isAliasKey {
+		alias, hasAlias := self.Aliases[aliasKey.AliasableCommand()]
+		if !hasAlias {
+			return None[string]()
+		}
+		return Some(alias)
+	}
+	switch key {
+	case KeyAutoResolve:
+		return stringerOpt(self.AutoResolve)
+	case KeyAutoSync:
+		return stringerOpt(self.AutoSync)
+	case KeyAzuredevopsToken:
+		return stringerOpt(self.AzuredevopsToken)
+	case KeyBitbucketAppPassword:
+		return stringerOpt(self.BitbucketAppPassword)
+	case KeyBitbucketUsername:
+		return stringerOpt(self.BitbucketUsername)
+	case KeyBranchPrefix:
+		return stringerOpt(self.BranchPrefix)
+	case KeyBrowser:
+		return stringerOpt(self.Browser)
+	case KeyContributionRegex:
+		return stringerOpt(self.ContributionRegex)
+	case KeyDetached:
+		return stringerOpt(self.Detached)
+	case KeyDevRemote:
+		return stringerOpt(self.DevRemote)
+	case KeyDisplayTypes:
+		if displayTypes, hasDisplayTypes := self.DisplayTypes.Get(); hasDisplayTypes {
+			return Some(displayTypes.Serialize(" "))
+		}
+		return None[string]()
+	case KeyFeatureRegex:
+		return stringerOpt(self.FeatureRegex)
+	case KeyForgeType:
+		return stringerOpt(self.ForgeType)
+	case KeyForgejoToken:
+		return stringerOpt(self.ForgejoToken)
+	case KeyGitUserEmail:
+		return stringerOpt(self.GitUserEmail)
+	case KeyGitUserName:
+		return stringerOpt(self.GitUserName)
+	case KeyGiteaToken:
+		return stringerOpt(self.GiteaToken)
+	case KeyGithubConnectorType:
+		return stringerOpt(self.GithubConnectorType)
+	case KeyGithubToken:
+		return stringerOpt(self.GithubToken)
+	case KeyGitlabConnectorType:
+		return stringerOpt(self.GitlabConnectorType)
+	case KeyGitlabToken:
+		return stringerOpt(self.GitlabToken)
+	case KeyHostingOriginHostname:
+		return stringerOpt(self.HostingOriginHostname)
+	case KeyIgnoreUncommitted:
+		return stringerOpt(self.IgnoreUncommitted)
+	case KeyMainBranch:
+		return stringerOpt(self.MainBranch)
+	case KeyNewBranchType:
+		return stringerOpt(self.NewBranchType)
+	case KeyObservedRegex:
+		return stringerOpt(self.ObservedRegex)
+	case KeyOffline:
+		return stringerOpt(self.Offline)
+	case KeyOrder:
+		return stringerOpt(self.Order)
+	case KeyPerennialBranches:
+		if len(self.PerennialBranches) == 0 {
+			return None[string]()
+		}
+		return Some(self.PerennialBranches.Join(" "))
+	case KeyPerennialRegex:
+		return stringerOpt(self.PerennialRegex)
+	case KeyProposalBreadcrumb:
+		return stringerOpt(self.ProposalBreadcrumb)
+	case KeyProposalBreadcrumbDirection:
+		return stringerOpt(self.ProposalBreadcrumbDirection)
+	case KeyProposeDraft:
+		return stringerOpt(self.ProposeDraft)
+	case KeyProposeTemplate:
+		return stringerOpt(self.ProposeTemplate)
+	case KeyPushBranches:
+		return stringerOpt(self.PushBranches)
+	case KeyPushHook:
+		return stringerOpt(self.PushHook)
+	case KeyShareNewBranches:
+		return stringerOpt(self.ShareNewBranches)
+	case KeyShipAPIMergeMethod:
+		return stringerOpt(self.ShipAPIMergeMethod)
+	case KeyShipDeleteTrackingBranch:
+		return stringerOpt(self.ShipDeleteTrackingBranch)
+	case KeyShipStrategy:
+		return stringerOpt(self.ShipStrategy)
+	case KeyStash:
+		return stringerOpt(self.Stash)
+	case KeySyncFeatureStrategy:
+		return stringerOpt(self.SyncFeatureStrategy)
+	case KeySyncPerennialStrategy:
+		return stringerOpt(self.SyncPerennialStrategy)
+	case KeySyncPrototypeStrategy:
+		return stringerOpt(self.SyncPrototypeStrategy)
+	case KeySyncTags:
+		return stringerOpt(self.SyncTags)
+	case KeySyncUpstream:
+		return stringerOpt(self.SyncUpstream)
+	case KeyUnknownBranchType:
+		return stringerOpt(self.UnknownBranchType)
+	case KeyWorktreeRoot:
+		return stringerOpt(self.WorktreeRoot)
+	}
+	return None[string]()
+}
+
+// stringerOpt provides the string representation of the given optional value.
+func stringerOpt[T fmt.Stringer](value Option[T]) Option[string] {
+	if content, hasContent := value.Get(); hasContent {
+		return Some(content.String())
+	}
+	return None[string]()
+}
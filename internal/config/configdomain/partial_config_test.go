@@ -0,0 +1,43 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestPartialConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("empty", func(t *testing.T) {
+			t.Parallel()
+			have := configdomain.EmptyPartialConfig().Snapshot()
+			must.MapEmpty(t, have)
+		})
+
+		t.Run("settings and branch overrides", func(t *testing.T) {
+			t.Parallel()
+			override := configdomain.EmptyBranchOverride()
+			override.SyncFeatureStrategy = Some(configdomain.SyncFeatureStrategyRebase)
+			config := configdomain.EmptyPartialConfig()
+			config.BranchOverrides = configdomain.BranchOverrides{"release/*": override}
+			config.MainBranch = Some(gitdomain.NewLocalBranchName("main"))
+			config.PerennialBranches = gitdomain.NewLocalBranchNames("qa", "staging")
+			config.SyncTags = Some(configdomain.SyncTags(false))
+			have := config.Snapshot()
+			want := configdomain.SingleSnapshot{
+				"git-town-branch.release/*.sync-feature-strategy": "rebase",
+				"git-town.main-branch":                            "main",
+				"git-town.perennial-branches":                     "qa staging",
+				"git-town.sync-tags":                              "false",
+			}
+			must.Eq(t, want, have)
+		})
+	})
+}
@@ -0,0 +1,14 @@
+package configdomain
+
+import "strconv"
+
+// ProposeDraft contains the configuration setting about whether to create new proposals as drafts.
+type ProposeDraft bool
+
+func (self ProposeDraft) ShouldCreateDraft() bool {
+	return bool(self)
+}
+
+func (self ProposeDraft) String() string {
+	return strconv.FormatBool(bool(self))
+}
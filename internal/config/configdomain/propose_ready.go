@@ -0,0 +1,8 @@
+package configdomain
+
+// ProposeReady indicates whether "git town propose" should mark the existing draft proposal as ready for review
+type ProposeReady bool
+
+func (self ProposeReady) Enabled() bool {
+	return bool(self)
+}
@@ -0,0 +1,29 @@
+package configdomain
+
+import (
+	"path/filepath"
+
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// ProposeTemplate is the path of the Go template file that Git Town renders into the title and body of new proposals.
+// Relative paths are relative to the root directory of the repository.
+type ProposeTemplate string
+
+// Resolve provides the absolute path of this template file.
+func (self ProposeTemplate) Resolve(rootDir string) string {
+	path := filepath.FromSlash(self.String())
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(rootDir, path)
+}
+
+func (self ProposeTemplate) String() string { return string(self) }
+
+func ParseProposeTemplate(value, _ string) (Option[ProposeTemplate], error) {
+	if value == "" {
+		return None[ProposeTemplate](), nil
+	}
+	return Some(ProposeTemplate(value)), nil
+}
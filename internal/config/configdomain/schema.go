@@ -0,0 +1,8 @@
+package configdomain
+
+// Schema indicates whether "git town config validate" should print the JSON Schema of the configuration file.
+type Schema bool
+
+func (self Schema) ShouldPrintSchema() bool {
+	return bool(self)
+}
@@ -9,10 +9,11 @@ import (
 )
 
 const (
-	ShipStrategyAPI         ShipStrategy = "api"          // shipping via the forge API
-	ShipStrategyAlwaysMerge ShipStrategy = "always-merge" // shipping by doing a local merge commit (merge --no-ff)
-	ShipStrategyFastForward ShipStrategy = "fast-forward" // shipping by doing a local fast-forward
-	ShipStrategySquashMerge ShipStrategy = "squash-merge" // shipping by doing a local squash-merge
+	ShipStrategyAPI          ShipStrategy = "api"            // shipping via the forge API
+	ShipStrategyAPIAutoMerge ShipStrategy = "api-auto-merge" // shipping by letting the forge merge the proposal once it is ready, e.g. through a merge queue
+	ShipStrategyAlwaysMerge  ShipStrategy = "always-merge"   // shipping by doing a local merge commit (merge --no-ff)
+	ShipStrategyFastForward  ShipStrategy = "fast-forward"   // shipping by doing a local fast-forward
+	ShipStrategySquashMerge  ShipStrategy = "squash-merge"   // shipping by doing a local squash-merge
 )
 
 type ShipStrategy string
@@ -38,6 +39,7 @@ func ParseShipStrategy(text string, source string) (Option[ShipStrategy], error)
 func ShipStrategies() []ShipStrategy {
 	return []ShipStrategy{
 		ShipStrategyAPI,
+		ShipStrategyAPIAutoMerge,
 		ShipStrategyAlwaysMerge,
 		ShipStrategyFastForward,
 		ShipStrategySquashMerge,
@@ -0,0 +1,8 @@
+package configdomain
+
+// ShowOrigin indicates whether "git town config get" should display where the configuration value comes from.
+type ShowOrigin bool
+
+func (self ShowOrigin) ShouldShowOrigin() bool {
+	return bool(self)
+}
@@ -0,0 +1,9 @@
+package configdomain
+
+// SyncWorktrees indicates whether Git Town should sync branches that are checked out in other worktrees
+// by running the sync operations inside those worktrees.
+type SyncWorktrees bool
+
+func (self SyncWorktrees) Enabled() bool {
+	return bool(self)
+}
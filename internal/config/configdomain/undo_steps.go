@@ -0,0 +1,8 @@
+package configdomain
+
+// UndoSteps is the number of most recent Git Town commands that "git town undo" should undo.
+type UndoSteps uint
+
+func (self UndoSteps) Value() uint {
+	return uint(self)
+}
@@ -0,0 +1,41 @@
+package configdomain
+
+import (
+	"path/filepath"
+
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// WorktreeRoot is the folder in which "git town worktree add" creates new worktrees.
+// Relative paths are relative to the main worktree of the repository.
+type WorktreeRoot string
+
+// DefaultWorktreeRoot provides the folder for new worktrees if the user hasn't configured one:
+// a sibling of the main worktree with the suffix ".worktrees".
+func DefaultWorktreeRoot(mainWorktree gitdomain.WorktreePath) WorktreeRoot {
+	return WorktreeRoot(mainWorktree.String() + ".worktrees")
+}
+
+func (self WorktreeRoot) String() string { return string(self) }
+
+// Resolve provides the absolute path of this folder.
+func (self WorktreeRoot) Resolve(mainWorktree gitdomain.WorktreePath) string {
+	root := filepath.FromSlash(self.String())
+	if filepath.IsAbs(root) {
+		return root
+	}
+	return filepath.Join(mainWorktree.String(), root)
+}
+
+// WorktreePath provides the path of the worktree for the given branch inside this folder.
+func (self WorktreeRoot) WorktreePath(mainWorktree gitdomain.WorktreePath, branch gitdomain.LocalBranchName) gitdomain.WorktreePath {
+	return gitdomain.WorktreePath(filepath.Join(self.Resolve(mainWorktree), filepath.FromSlash(branch.String())))
+}
+
+func ParseWorktreeRoot(value, _ string) (Option[WorktreeRoot], error) {
+	if value == "" {
+		return None[WorktreeRoot](), nil
+	}
+	return Some(WorktreeRoot(value)), nil
+}
@@ -0,0 +1,42 @@
+package configdomain_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestWorktreeRoot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("DefaultWorktreeRoot", func(t *testing.T) {
+		t.Parallel()
+		have := configdomain.DefaultWorktreeRoot(gitdomain.WorktreePath(filepath.FromSlash("/code/repo")))
+		want := configdomain.WorktreeRoot(filepath.FromSlash("/code/repo.worktrees"))
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("WorktreePath", func(t *testing.T) {
+		t.Parallel()
+		mainWorktree := gitdomain.WorktreePath(filepath.FromSlash("/code/repo"))
+
+		t.Run("absolute root", func(t *testing.T) {
+			t.Parallel()
+			root := configdomain.WorktreeRoot(filepath.FromSlash("/worktrees"))
+			have := root.WorktreePath(mainWorktree, "feature/one")
+			want := gitdomain.WorktreePath(filepath.FromSlash("/worktrees/feature/one"))
+			must.EqOp(t, want, have)
+		})
+
+		t.Run("relative root", func(t *testing.T) {
+			t.Parallel()
+			root := configdomain.WorktreeRoot("../trees")
+			have := root.WorktreePath(mainWorktree, "feature")
+			want := gitdomain.WorktreePath(filepath.FromSlash("/code/trees/feature"))
+			must.EqOp(t, want, have)
+		})
+	})
+}
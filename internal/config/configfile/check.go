@@ -0,0 +1,285 @@
+package configfile
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/gohacks"
+	"github.com/git-town/git-town/v22/internal/gohacks/mapstools"
+	"github.com/git-town/git-town/v22/internal/messages"
+)
+
+// Check strictly verifies the given config file TOML source.
+// It describes all unknown keys, deprecated keys, and invalid values that the source contains.
+// Only the user-wide config file, indicated by userLevel, may contain forge credentials.
+// The returned error indicates that the source is not a valid config file at all.
+func Check(text string, userLevel bool) ([]string, error) {
+	var data Data
+	metadata, err := toml.Decode(text, &data)
+	if err != nil {
+		return []string{}, err
+	}
+	var values map[string]any
+	if _, err = toml.Decode(text, &values); err != nil {
+		return []string{}, err
+	}
+	result := checkUnknownKeys(metadata)
+	result = append(result, checkDeprecatedKeys(metadata)...)
+	result = append(result, checkValues(values)...)
+	if !userLevel {
+		result = append(result, checkCredentials(metadata)...)
+	}
+	return result, nil
+}
+
+// credentialKeys defines the config file keys that contain credentials for forges.
+var credentialKeys = []toml.Key{
+	{"hosting", "azuredevops-token"},
+	{"hosting", "bitbucket-app-password"},
+	{"hosting", "bitbucket-username"},
+	{"hosting", "forgejo-token"},
+	{"hosting", "gitea-token"},
+	{"hosting", "github-token"},
+	{"hosting", "gitlab-token"},
+}
+
+// deprecatedKey describes a config file key that Git Town still understands but that has a better replacement.
+type deprecatedKey struct {
+	key         toml.Key
+	replacement string
+}
+
+// deprecatedKeys defines the up-to-date counterparts to deprecated config file keys.
+var deprecatedKeys = []deprecatedKey{
+	{key: toml.Key{"branches", "default-type"}, replacement: "branches.unknown-type"},
+	{key: toml.Key{"create", "push-new-branches"}, replacement: `create.share-new-branches = "push"`},
+	{key: toml.Key{"create-prototype-branches"}, replacement: `create.new-branch-type = "prototype"`},
+	{key: toml.Key{"hosting", "platform"}, replacement: "hosting.forge-type"},
+	{key: toml.Key{"propose", "lineage"}, replacement: "propose.breadcrumb"},
+	{key: toml.Key{"push-hook"}, replacement: "sync.push-hook"},
+	{key: toml.Key{"push-new-branches"}, replacement: `create.share-new-branches = "push"`},
+	{key: toml.Key{"ship-delete-tracking-branch"}, replacement: "ship.delete-tracking-branch"},
+	{key: toml.Key{"ship-strategy"}, replacement: "ship.strategy"},
+	{key: toml.Key{"sync-strategy", "feature-branches"}, replacement: "sync.feature-strategy"},
+	{key: toml.Key{"sync-strategy", "perennial-branches"}, replacement: "sync.perennial-strategy"},
+	{key: toml.Key{"sync-strategy", "prototype-branches"}, replacement: "sync.prototype-strategy"},
+	{key: toml.Key{"sync-tags"}, replacement: "sync.tags"},
+	{key: toml.Key{"sync-upstream"}, replacement: "sync.upstream"},
+}
+
+// valueCheck verifies the value of a config file key.
+type valueCheck struct {
+	key   toml.Key
+	parse func(value, source string) error
+}
+
+// valueChecks defines how to verify the values of the config file keys that only allow certain values.
+var valueChecks = []valueCheck{
+	{key: toml.Key{"branches", "contribution-regex"}, parse: parseRegex},
+	{key: toml.Key{"branches", "default-type"}, parse: ignoreValue(configdomain.ParseBranchType)},
+	{key: toml.Key{"branches", "display-types"}, parse: ignoreValue(configdomain.ParseDisplayTypes)},
+	{key: toml.Key{"branches", "feature-regex"}, parse: parseRegex},
+	{key: toml.Key{"branches", "observed-regex"}, parse: parseRegex},
+	{key: toml.Key{"branches", "order"}, parse: ignoreValue(configdomain.ParseOrder)},
+	{key: toml.Key{"branches", "perennial-regex"}, parse: ignoreValue(configdomain.ParsePerennialRegex)},
+	{key: toml.Key{"branches", "unknown-type"}, parse: ignoreValue(configdomain.ParseBranchType)},
+	{key: toml.Key{"create", "new-branch-type"}, parse: ignoreValue(configdomain.ParseBranchType)},
+	{key: toml.Key{"create", "share-new-branches"}, parse: ignoreValue(configdomain.ParseShareNewBranches)},
+	{key: toml.Key{"hosting", "forge-type"}, parse: ignoreValue(forgedomain.ParseForgeType)},
+	{key: toml.Key{"hosting", "github-connector"}, parse: ignoreValue(forgedomain.ParseGithubConnectorType)},
+	{key: toml.Key{"hosting", "gitlab-connector"}, parse: ignoreValue(forgedomain.ParseGitlabConnectorType)},
+	{key: toml.Key{"hosting", "platform"}, parse: ignoreValue(forgedomain.ParseForgeType)},
+	{key: toml.Key{"propose", "breadcrumb"}, parse: ignoreValue(configdomain.ParseProposalBreadcrumb)},
+	{key: toml.Key{"propose", "breadcrumb-direction"}, parse: ignoreValue(configdomain.ParseProposalBreadcrumbDirection)},
+	{key: toml.Key{"propose", "lineage"}, parse: ignoreValue(configdomain.ParseProposalBreadcrumb)},
+	{key: toml.Key{"ship", "api-merge-method"}, parse: ignoreValue(forgedomain.ParseProposalMergeMethod)},
+	{key: toml.Key{"ship", "strategy"}, parse: ignoreValue(configdomain.ParseShipStrategy)},
+	{key: toml.Key{"ship-strategy"}, parse: ignoreValue(configdomain.ParseShipStrategy)},
+	{key: toml.Key{"sync", "feature-strategy"}, parse: ignoreValue(configdomain.ParseSyncFeatureStrategy)},
+	{key: toml.Key{"sync", "perennial-strategy"}, parse: ignoreValue(configdomain.ParseSyncPerennialStrategy)},
+	{key: toml.Key{"sync", "prototype-strategy"}, parse: ignoreValue(configdomain.ParseSyncPrototypeStrategy)},
+	{key: toml.Key{"sync-strategy", "feature-branches"}, parse: ignoreValue(configdomain.ParseSyncFeatureStrategy)},
+	{key: toml.Key{"sync-strategy", "perennial-branches"}, parse: ignoreValue(configdomain.ParseSyncPerennialStrategy)},
+	{key: toml.Key{"sync-strategy", "prototype-branches"}, parse: ignoreValue(configdomain.ParseSyncPrototypeStrategy)},
+}
+
+// branchOverrideValueChecks defines how to verify the values inside the "[branches.<glob>]" tables.
+var branchOverrideValueChecks = []valueCheck{
+	{key: toml.Key{"share-new-branches"}, parse: ignoreValue(configdomain.ParseShareNewBranches)},
+	{key: toml.Key{"ship-strategy"}, parse: ignoreValue(configdomain.ParseShipStrategy)},
+	{key: toml.Key{"sync-feature-strategy"}, parse: ignoreValue(configdomain.ParseSyncFeatureStrategy)},
+}
+
+func checkCredentials(metadata toml.MetaData) []string {
+	result := []string{}
+	for _, key := range credentialKeys {
+		if metadata.IsDefined(key...) {
+			result = append(result, fmt.Sprintf(messages.ConfigFileCredentialsKey, key.String()))
+		}
+	}
+	return result
+}
+
+func checkDeprecatedKeys(metadata toml.MetaData) []string {
+	result := []string{}
+	for _, deprecated := range deprecatedKeys {
+		if metadata.IsDefined(deprecated.key...) {
+			result = append(result, fmt.Sprintf(messages.ConfigFileDeprecatedKey, deprecated.key.String(), deprecated.replacement))
+		}
+	}
+	return result
+}
+
+func checkUnknownKeys(metadata toml.MetaData) []string {
+	result := []string{}
+	reported := []toml.Key{}
+	for _, key := range metadata.Undecoded() {
+		// the children of an unknown table are unknown as well
+		if slices.ContainsFunc(reported, func(table toml.Key) bool { return isChildKey(table, key) }) {
+			continue
+		}
+		parent := key[:len(key)-1]
+		name := key[len(key)-1]
+		knownNames := knownKeys(parent)
+		if slices.Contains(knownNames, name) {
+			continue
+		}
+		// "[branches.<glob>]" tables contain per-branch settings
+		if slices.Equal(parent, toml.Key{"branches"}) && metadata.Type(key...) == "Hash" {
+			continue
+		}
+		reported = append(reported, key)
+		if suggestion, hasSuggestion := closestKey(name, knownNames); hasSuggestion {
+			result = append(result, fmt.Sprintf(messages.ConfigFileUnknownKeySuggestion, key.String(), slices.Concat(parent, toml.Key{suggestion}).String()))
+		} else {
+			result = append(result, fmt.Sprintf(messages.ConfigFileUnknownKey, key.String()))
+		}
+	}
+	return result
+}
+
+func checkValues(values map[string]any) []string {
+	result := []string{}
+	for _, check := range valueChecks {
+		if value, hasValue := lookupString(values, check.key); hasValue {
+			if err := check.parse(value, check.key.String()); err != nil {
+				result = append(result, err.Error())
+			}
+		}
+	}
+	branches, _ := values["branches"].(map[string]any)
+	for glob := range mapstools.SortedKeys(branches) {
+		override, isTable := branches[glob].(map[string]any)
+		if !isTable {
+			continue
+		}
+		table := toml.Key{"branches", glob}
+		if _, err := configdomain.ParseBranchPattern(glob, table.String()); err != nil {
+			result = append(result, err.Error())
+		}
+		for _, check := range branchOverrideValueChecks {
+			if value, hasValue := lookupString(override, check.key); hasValue {
+				if err := check.parse(value, slices.Concat(table, check.key).String()); err != nil {
+					result = append(result, err.Error())
+				}
+			}
+		}
+	}
+	return result
+}
+
+// closestKey provides the known key that the given unknown key is most likely a typo of.
+func closestKey(name string, knownNames []string) (string, bool) {
+	result := ""
+	resultDistance := max(2, len(name)/5) + 1
+	for _, knownName := range knownNames {
+		if distance := gohacks.EditDistance(name, knownName); distance < resultDistance {
+			result = knownName
+			resultDistance = distance
+		}
+	}
+	return result, result != ""
+}
+
+func ignoreValue[T any](parse func(value, source string) (T, error)) func(value, source string) error {
+	return func(value, source string) error {
+		_, err := parse(value, source)
+		return err
+	}
+}
+
+// isChildKey indicates whether the given key is located inside the given table.
+func isChildKey(table, key toml.Key) bool {
+	return len(key) > len(table) && slices.Equal(key[:len(table)], table)
+}
+
+// knownKeys provides the names of the keys that the table with the given key can contain.
+func knownKeys(table toml.Key) []string {
+	structType := reflect.TypeFor[Data]()
+	for _, name := range table {
+		fieldType, hasField := tomlField(structType, name)
+		switch {
+		case hasField:
+			structType = fieldType
+		case structType == reflect.TypeFor[Branches]():
+			structType = reflect.TypeFor[BranchOverride]()
+		default:
+			return []string{}
+		}
+		if structType.Kind() == reflect.Pointer {
+			structType = structType.Elem()
+		}
+		if structType.Kind() != reflect.Struct {
+			return []string{}
+		}
+	}
+	result := []string{}
+	for field := range structType.Fields() {
+		if name := tomlName(field); name != "" {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// lookupString provides the string value of the given key in the given decoded TOML data.
+func lookupString(values map[string]any, key toml.Key) (string, bool) {
+	for _, name := range key[:len(key)-1] {
+		table, isTable := values[name].(map[string]any)
+		if !isTable {
+			return "", false
+		}
+		values = table
+	}
+	value, isString := values[key[len(key)-1]].(string)
+	return value, isString
+}
+
+func parseRegex(value, source string) error {
+	_, err := configdomain.ParseRegex(value)
+	return gohacks.WrapIfError(err, messages.CannotParse, source)
+}
+
+// tomlField provides the type of the field in the given struct that stores the TOML key with the given name.
+func tomlField(structType reflect.Type, name string) (reflect.Type, bool) {
+	for field := range structType.Fields() {
+		if tomlName(field) == name {
+			return field.Type, true
+		}
+	}
+	return nil, false
+}
+
+// tomlName provides the TOML key that the given struct field stores.
+func tomlName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("toml"), ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
@@ -0,0 +1,143 @@
+package configfile_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/config/configfile"
+	"github.com/shoenig/test/must"
+)
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	t.Run("branch overrides", func(t *testing.T) {
+		t.Parallel()
+		give := `
+[branches]
+main = "main"
+
+[branches."feature/*"]
+sync-feature-strategy = "rebase"
+sync-strategy = "merge"
+
+[branches."release/*"]
+ship-strategy = "zonk"
+`
+		have, err := configfile.Check(give, false)
+		must.NoError(t, err)
+		want := []string{
+			`unknown key branches."feature/*".sync-strategy`,
+			`unknown ship strategy in branches."release/*".ship-strategy: "zonk"`,
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("credentials", func(t *testing.T) {
+		t.Parallel()
+		give := `
+[hosting]
+forge-type = "github"
+github-token = "secret"
+`
+		t.Run("repo file", func(t *testing.T) {
+			t.Parallel()
+			have, err := configfile.Check(give, false)
+			must.NoError(t, err)
+			want := []string{
+				"forge credentials in hosting.github-token, please store them in the user configuration file or in the Git metadata",
+			}
+			must.Eq(t, want, have)
+		})
+		t.Run("user file", func(t *testing.T) {
+			t.Parallel()
+			have, err := configfile.Check(give, true)
+			must.NoError(t, err)
+			must.Eq(t, []string{}, have)
+		})
+	})
+
+	t.Run("deprecated keys", func(t *testing.T) {
+		t.Parallel()
+		give := `
+push-hook = true
+sync-tags = false
+
+[hosting]
+platform = "github"
+`
+		have, err := configfile.Check(give, false)
+		must.NoError(t, err)
+		want := []string{
+			"deprecated key hosting.platform, please use hosting.forge-type instead",
+			"deprecated key push-hook, please use sync.push-hook instead",
+			"deprecated key sync-tags, please use sync.tags instead",
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("invalid TOML", func(t *testing.T) {
+		t.Parallel()
+		_, err := configfile.Check("[sync\n", false)
+		must.Error(t, err)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		t.Parallel()
+		give := `
+[branches]
+order = "random"
+
+[sync]
+feature-strategy = "zonk"
+perennial-strategy = "rebase"
+`
+		have, err := configfile.Check(give, false)
+		must.NoError(t, err)
+		must.SliceLen(t, 2, have)
+		must.StrContains(t, have[0], "branches.order")
+		must.EqOp(t, `cannot parse sync.feature-strategy: unknown sync strategy: "zonk"`, have[1])
+	})
+
+	t.Run("unknown keys", func(t *testing.T) {
+		t.Parallel()
+		give := `
+colors = true
+
+[synk]
+tags = true
+
+[sync]
+feature-stratgy = "merge"
+`
+		have, err := configfile.Check(give, false)
+		must.NoError(t, err)
+		want := []string{
+			"unknown key colors",
+			"unknown key synk, did you mean sync?",
+			"unknown key sync.feature-stratgy, did you mean sync.feature-strategy?",
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("valid file", func(t *testing.T) {
+		t.Parallel()
+		give := `
+[branches]
+main = "main"
+perennials = ["staging"]
+
+[branches."feature/*"]
+sync-feature-strategy = "rebase"
+
+[hosting]
+forge-type = "github"
+
+[sync]
+feature-strategy = "merge"
+tags = true
+`
+		have, err := configfile.Check(give, false)
+		must.NoError(t, err)
+		must.SliceEmpty(t, have)
+	})
+}
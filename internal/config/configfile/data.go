@@ -2,33 +2,44 @@ package configfile
 
 // Data defines the Go equivalent of the TOML file content.
 type Data struct {
-	Branches                 *Branches     `toml:"branches"`
-	Create                   *Create       `toml:"create"`
-	CreatePrototypeBranches  *bool         `toml:"create-prototype-branches"`
-	Hosting                  *Hosting      `toml:"hosting"`
-	Propose                  *Propose      `toml:"propose"`
-	PushHook                 *bool         `toml:"push-hook"`
-	PushNewBranches          *bool         `toml:"push-new-branches"`
-	Ship                     *Ship         `toml:"ship"`
-	ShipDeleteTrackingBranch *bool         `toml:"ship-delete-tracking-branch"`
-	ShipStrategy             *string       `toml:"ship-strategy"`
-	Sync                     *Sync         `toml:"sync"`
-	SyncStrategy             *SyncStrategy `toml:"sync-strategy"`
-	SyncTags                 *bool         `toml:"sync-tags"`
-	SyncUpstream             *bool         `toml:"sync-upstream"`
+	Aliases                  map[string]string `toml:"aliases"`
+	Branches                 *Branches         `toml:"branches"`
+	Create                   *Create           `toml:"create"`
+	CreatePrototypeBranches  *bool             `toml:"create-prototype-branches"`
+	Hosting                  *Hosting          `toml:"hosting"`
+	Include                  []string          `toml:"include"`
+	Propose                  *Propose          `toml:"propose"`
+	PushHook                 *bool             `toml:"push-hook"`
+	PushNewBranches          *bool             `toml:"push-new-branches"`
+	Ship                     *Ship             `toml:"ship"`
+	ShipDeleteTrackingBranch *bool             `toml:"ship-delete-tracking-branch"`
+	ShipStrategy             *string           `toml:"ship-strategy"`
+	Sync                     *Sync             `toml:"sync"`
+	SyncStrategy             *SyncStrategy     `toml:"sync-strategy"`
+	SyncTags                 *bool             `toml:"sync-tags"`
+	SyncUpstream             *bool             `toml:"sync-upstream"`
+}
+
+// BranchOverride defines the settings for the branches matching a "[branches.<glob>]" table.
+type BranchOverride struct {
+	PushBranches        *bool   `toml:"push-branches"`
+	ShareNewBranches    *string `toml:"share-new-branches"`
+	ShipStrategy        *string `toml:"ship-strategy"`
+	SyncFeatureStrategy *string `toml:"sync-feature-strategy"`
 }
 
 type Branches struct {
-	ContributionRegex *string  `toml:"contribution-regex"`
-	DefaultType       *string  `toml:"default-type"`
-	DisplayTypes      *string  `toml:"display-types"`
-	FeatureRegex      *string  `toml:"feature-regex"`
-	Main              *string  `toml:"main"`
-	ObservedRegex     *string  `toml:"observed-regex"`
-	Order             *string  `toml:"order"`
-	PerennialRegex    *string  `toml:"perennial-regex"`
-	Perennials        []string `toml:"perennials"`
-	UnknownType       *string  `toml:"unknown-type"`
+	ContributionRegex *string                   `toml:"contribution-regex"`
+	DefaultType       *string                   `toml:"default-type"`
+	DisplayTypes      *string                   `toml:"display-types"`
+	FeatureRegex      *string                   `toml:"feature-regex"`
+	Main              *string                   `toml:"main"`
+	ObservedRegex     *string                   `toml:"observed-regex"`
+	Order             *string                   `toml:"order"`
+	Overrides         map[string]BranchOverride `json:"-" toml:"-"` // the "[branches.<glob>]" tables
+	PerennialRegex    *string                   `toml:"perennial-regex"`
+	Perennials        []string                  `toml:"perennials"`
+	UnknownType       *string                   `toml:"unknown-type"`
 }
 
 func (self Branches) IsEmpty() bool {
@@ -41,16 +52,40 @@ type Create struct {
 	PushNewBranches  *bool   `toml:"push-new-branches"`
 	ShareNewBranches *string `toml:"share-new-branches"`
 	Stash            *bool   `toml:"stash"`
+	WorktreeRoot     *string `toml:"worktree-root"`
 }
 
 type Hosting struct {
-	Browser         *string `toml:"browser"`
-	DevRemote       *string `toml:"dev-remote"`
-	ForgeType       *string `toml:"forge-type"`
-	GithubConnector *string `toml:"github-connector"`
-	GitlabConnector *string `toml:"gitlab-connector"`
-	OriginHostname  *string `toml:"origin-hostname"`
-	Platform        *string `toml:"platform"`
+	AzuredevopsToken     *string `toml:"azuredevops-token"`
+	BitbucketAppPassword *string `toml:"bitbucket-app-password"`
+	BitbucketUsername    *string `toml:"bitbucket-username"`
+	Browser              *string `toml:"browser"`
+	DevRemote            *string `toml:"dev-remote"`
+	ForgeType            *string `toml:"forge-type"`
+	ForgejoToken         *string `toml:"forgejo-token"`
+	GiteaToken           *string `toml:"gitea-token"`
+	GithubConnector      *string `toml:"github-connector"`
+	GithubToken          *string `toml:"github-token"`
+	GitlabConnector      *string `toml:"gitlab-connector"`
+	GitlabToken          *string `toml:"gitlab-token"`
+	OriginHostname       *string `toml:"origin-hostname"`
+	Platform             *string `toml:"platform"`
+}
+
+// HasCredentials indicates whether this Hosting section contains credentials for a forge.
+func (self Hosting) HasCredentials() bool {
+	return self.AzuredevopsToken != nil || self.BitbucketAppPassword != nil || self.BitbucketUsername != nil || self.ForgejoToken != nil || self.GiteaToken != nil || self.GithubToken != nil || self.GitlabToken != nil
+}
+
+// RemoveCredentials removes all credentials for forges from this Hosting section.
+func (self *Hosting) RemoveCredentials() {
+	self.AzuredevopsToken = nil
+	self.BitbucketAppPassword = nil
+	self.BitbucketUsername = nil
+	self.ForgejoToken = nil
+	self.GiteaToken = nil
+	self.GithubToken = nil
+	self.GitlabToken = nil
 }
 
 func (self Hosting) IsEmpty() bool {
@@ -58,12 +93,18 @@ func (self Hosting) IsEmpty() bool {
 }
 
 type Propose struct {
-	Breadcrumb          *string `toml:"breadcrumb"`
-	BreadcrumbDirection *string `toml:"breadcrumb-direction"`
-	Lineage             *string `toml:"lineage"`
+	Assignees           []string `toml:"assignees"`
+	Breadcrumb          *string  `toml:"breadcrumb"`
+	BreadcrumbDirection *string  `toml:"breadcrumb-direction"`
+	Draft               *bool    `toml:"draft"`
+	Labels              []string `toml:"labels"`
+	Lineage             *string  `toml:"lineage"`
+	Reviewers           []string `toml:"reviewers"`
+	Template            *string  `toml:"template"`
 }
 
 type Ship struct {
+	APIMergeMethod       *string `toml:"api-merge-method"`
 	DeleteTrackingBranch *bool   `toml:"delete-tracking-branch"`
 	IgnoreUncommitted    *bool   `toml:"ignore-uncommitted"`
 	Strategy             *string `toml:"strategy"`
@@ -4,4 +4,5 @@ const (
 	FileName            = "git-town.toml"
 	HiddenFileName      = ".git-town.toml"
 	AlternativeFileName = ".git-branches.toml"
+	UserFileName        = "config.toml" // name of the user-wide configuration file inside the "git-town" folder of the user configuration directory
 )
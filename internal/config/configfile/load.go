@@ -2,9 +2,6 @@ package configfile
 
 import (
 	"fmt"
-	"io"
-	"os"
-	"path/filepath"
 
 	"github.com/BurntSushi/toml"
 	"github.com/git-town/git-town/v22/internal/config/configdomain"
@@ -20,26 +17,11 @@ import (
 func Decode(text string) (*Data, error) {
 	var result Data
 	_, err := toml.Decode(text, &result)
-	return &result, err
-}
-
-func Load(rootDir gitdomain.RepoRootDir, fileName string, finalMessages stringslice.Collector) (configdomain.PartialConfig, bool, error) {
-	configPath := filepath.Join(rootDir.String(), fileName)
-	file, err := os.Open(configPath)
-	if err != nil {
-		return configdomain.EmptyPartialConfig(), false, nil
-	}
-	defer file.Close()
-	bytes, err := io.ReadAll(file)
-	if err != nil {
-		return configdomain.EmptyPartialConfig(), false, fmt.Errorf(messages.ConfigFileCannotRead, fileName, err)
+	if err != nil || result.Branches == nil {
+		return &result, err
 	}
-	configFileData, err := Decode(string(bytes))
-	if err != nil {
-		return configdomain.EmptyPartialConfig(), false, fmt.Errorf(messages.ConfigFileInvalidContent, fileName, err)
-	}
-	result, err := Validate(*configFileData, finalMessages)
-	return result, true, err
+	result.Branches.Overrides, err = decodeBranchOverrides(text)
+	return &result, err
 }
 
 // Validate converts the given low-level configfile data into high-level config data.
@@ -47,8 +29,13 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 	// TODO: convert to proper variable initialization using None
 	var (
 		// keep-sorted start
+		aliases                     configdomain.Aliases
 		autoResolve                 Option[configdomain.AutoResolve]
 		autoSync                    Option[configdomain.AutoSync]
+		azuredevopsToken            Option[forgedomain.AzuredevopsToken]
+		bitbucketAppPassword        Option[forgedomain.BitbucketAppPassword]
+		bitbucketUsername           Option[forgedomain.BitbucketUsername]
+		branchOverrides             configdomain.BranchOverrides
 		branchPrefix                Option[configdomain.BranchPrefix]
 		browser                     Option[configdomain.Browser]
 		contributionRegex           Option[configdomain.ContributionRegex]
@@ -57,8 +44,12 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 		displayTypes                Option[configdomain.DisplayTypes]
 		featureRegex                Option[configdomain.FeatureRegex]
 		forgeType                   Option[forgedomain.ForgeType]
+		forgejoToken                Option[forgedomain.ForgejoToken]
+		giteaToken                  Option[forgedomain.GiteaToken]
 		githubConnectorType         Option[forgedomain.GithubConnectorType]
+		githubToken                 Option[forgedomain.GithubToken]
 		gitlabConnectorType         Option[forgedomain.GitlabConnectorType]
+		gitlabToken                 Option[forgedomain.GitlabToken]
 		hostingOriginHostname       Option[configdomain.HostingOriginHostname]
 		ignoreUncommitted           Option[configdomain.IgnoreUncommitted]
 		mainBranch                  Option[gitdomain.LocalBranchName]
@@ -69,9 +60,15 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 		perennialRegex              Option[configdomain.PerennialRegex]
 		proposalBreadcrumb          Option[configdomain.ProposalBreadcrumb]
 		proposalBreadcrumbDirection Option[configdomain.ProposalBreadcrumbDirection]
+		proposeAssignees            gitdomain.ProposalMetadataNames
+		proposeDraft                Option[configdomain.ProposeDraft]
+		proposeLabels               gitdomain.ProposalMetadataNames
+		proposeReviewers            gitdomain.ProposalMetadataNames
+		proposeTemplate             Option[configdomain.ProposeTemplate]
 		pushBranches                Option[configdomain.PushBranches]
 		pushHook                    Option[configdomain.PushHook]
 		shareNewBranches            Option[configdomain.ShareNewBranches]
+		shipAPIMergeMethod          Option[forgedomain.ProposalMergeMethod]
 		shipDeleteTrackingBranch    Option[configdomain.ShipDeleteTrackingBranch]
 		shipStrategy                Option[configdomain.ShipStrategy]
 		stash                       Option[configdomain.Stash]
@@ -81,6 +78,7 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 		syncTags                    Option[configdomain.SyncTags]
 		syncUpstream                Option[configdomain.SyncUpstream]
 		unknownBranchType           Option[configdomain.UnknownBranchType]
+		worktreeRoot                Option[configdomain.WorktreeRoot]
 		// keep-sorted end
 	)
 	var err error
@@ -110,6 +108,15 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 	}
 	ec := gohacks.ErrorCollector{}
 	// load proper definitions, overriding the values from the legacy definitions that were loaded above
+	aliases = make(configdomain.Aliases, len(data.Aliases))
+	for name, alias := range data.Aliases { // okay to iterate the map in random order because we assign to a new map
+		aliasableCommand, isAliasableCommand := configdomain.AllAliasableCommands().Lookup(name).Get()
+		if !isAliasableCommand {
+			ec.Check(fmt.Errorf(messages.AliasableCommandUnknown, messages.ConfigFile, name))
+			continue
+		}
+		aliases[aliasableCommand] = alias
+	}
 	if data.Branches != nil {
 		if data.Branches.Main != nil {
 			mainBranch = gitdomain.NewLocalBranchNameOption(*data.Branches.Main)
@@ -158,6 +165,14 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 			ec.Check(err)
 			unknownBranchType = configdomain.UnknownBranchTypeOpt(branchType)
 		}
+		if len(data.Branches.Overrides) > 0 {
+			branchOverrides = make(configdomain.BranchOverrides, len(data.Branches.Overrides))
+		}
+		for glob, override := range data.Branches.Overrides { // okay to iterate the map in random order because we assign to a new map
+			pattern, err := configdomain.ParseBranchPattern(glob, messages.ConfigFile)
+			ec.Check(err)
+			branchOverrides[pattern] = validateBranchOverride(override, &ec)
+		}
 	}
 	if data.Create != nil {
 		if data.Create.BranchPrefix != nil {
@@ -180,8 +195,21 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 		if data.Create.Stash != nil {
 			stash = Some(configdomain.Stash(*data.Create.Stash))
 		}
+		if data.Create.WorktreeRoot != nil {
+			worktreeRoot, err = configdomain.ParseWorktreeRoot(*data.Create.WorktreeRoot, messages.ConfigFile)
+			ec.Check(err)
+		}
 	}
 	if data.Hosting != nil {
+		if data.Hosting.AzuredevopsToken != nil {
+			azuredevopsToken = forgedomain.ParseAzuredevopsToken(*data.Hosting.AzuredevopsToken)
+		}
+		if data.Hosting.BitbucketAppPassword != nil {
+			bitbucketAppPassword = forgedomain.ParseBitbucketAppPassword(*data.Hosting.BitbucketAppPassword)
+		}
+		if data.Hosting.BitbucketUsername != nil {
+			bitbucketUsername = forgedomain.ParseBitbucketUsername(*data.Hosting.BitbucketUsername)
+		}
 		if data.Hosting.Browser != nil {
 			browser, err = configdomain.ParseBrowser(*data.Hosting.Browser, messages.ConfigFile)
 			ec.Check(err)
@@ -197,6 +225,12 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 			forgeType, err = forgedomain.ParseForgeType(*data.Hosting.ForgeType, messages.ConfigFile)
 			ec.Check(err)
 		}
+		if data.Hosting.ForgejoToken != nil {
+			forgejoToken = forgedomain.ParseForgejoToken(*data.Hosting.ForgejoToken)
+		}
+		if data.Hosting.GiteaToken != nil {
+			giteaToken = forgedomain.ParseGiteaToken(*data.Hosting.GiteaToken)
+		}
 		if data.Hosting.GithubConnector != nil {
 			githubConnectorType, err = forgedomain.ParseGithubConnectorType(*data.Hosting.GithubConnector, messages.ConfigFile)
 			ec.Check(err)
@@ -205,6 +239,12 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 			gitlabConnectorType, err = forgedomain.ParseGitlabConnectorType(*data.Hosting.GitlabConnector, messages.ConfigFile)
 			ec.Check(err)
 		}
+		if data.Hosting.GithubToken != nil {
+			githubToken = forgedomain.ParseGithubToken(*data.Hosting.GithubToken)
+		}
+		if data.Hosting.GitlabToken != nil {
+			gitlabToken = forgedomain.ParseGitlabToken(*data.Hosting.GitlabToken)
+		}
 		if data.Hosting.OriginHostname != nil {
 			hostingOriginHostname = configdomain.ParseHostingOriginHostname(*data.Hosting.OriginHostname)
 		}
@@ -223,8 +263,22 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 			proposalBreadcrumbDirection, err = configdomain.ParseProposalBreadcrumbDirection(*data.Propose.BreadcrumbDirection, messages.ConfigFile)
 			ec.Check(err)
 		}
+		if data.Propose.Draft != nil {
+			proposeDraft = Some(configdomain.ProposeDraft(*data.Propose.Draft))
+		}
+		proposeAssignees = gitdomain.NewProposalMetadataNames(data.Propose.Assignees...)
+		proposeLabels = gitdomain.NewProposalMetadataNames(data.Propose.Labels...)
+		proposeReviewers = gitdomain.NewProposalMetadataNames(data.Propose.Reviewers...)
+		if data.Propose.Template != nil {
+			proposeTemplate, err = configdomain.ParseProposeTemplate(*data.Propose.Template, messages.ConfigFile)
+			ec.Check(err)
+		}
 	}
 	if data.Ship != nil {
+		if data.Ship.APIMergeMethod != nil {
+			shipAPIMergeMethod, err = forgedomain.ParseProposalMergeMethod(*data.Ship.APIMergeMethod, messages.ConfigFile)
+			ec.Check(err)
+		}
 		if data.Ship.DeleteTrackingBranch != nil {
 			shipDeleteTrackingBranch = Some(configdomain.ShipDeleteTrackingBranch(*data.Ship.DeleteTrackingBranch))
 		}
@@ -285,14 +339,16 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 		}
 	}
 	return configdomain.PartialConfig{
-		Aliases:                     map[configdomain.AliasableCommand]string{},
+		Aliases:                     aliases,
 		AutoSync:                    autoSync,
-		BitbucketAppPassword:        None[forgedomain.BitbucketAppPassword](),
-		BitbucketUsername:           None[forgedomain.BitbucketUsername](),
+		AzuredevopsToken:            azuredevopsToken,
+		BitbucketAppPassword:        bitbucketAppPassword,
+		BitbucketUsername:           bitbucketUsername,
+		BranchOverrides:             branchOverrides,
 		BranchPrefix:                branchPrefix,
 		BranchTypeOverrides:         configdomain.BranchTypeOverrides{},
 		Browser:                     browser,
-		ForgejoToken:                None[forgedomain.ForgejoToken](),
+		ForgejoToken:                forgejoToken,
 		ContributionRegex:           contributionRegex,
 		Detached:                    detached,
 		DisplayDialogs:              None[configdomain.DisplayDialogs](),
@@ -303,12 +359,12 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 		FeatureRegex:                featureRegex,
 		ForgeType:                   forgeType,
 		GithubConnectorType:         githubConnectorType,
-		GithubToken:                 None[forgedomain.GithubToken](),
+		GithubToken:                 githubToken,
 		GitlabConnectorType:         gitlabConnectorType,
-		GitlabToken:                 None[forgedomain.GitlabToken](),
+		GitlabToken:                 gitlabToken,
 		GitUserEmail:                None[gitdomain.GitUserEmail](),
 		GitUserName:                 None[gitdomain.GitUserName](),
-		GiteaToken:                  None[forgedomain.GiteaToken](),
+		GiteaToken:                  giteaToken,
 		HostingOriginHostname:       hostingOriginHostname,
 		Lineage:                     configdomain.NewLineage(),
 		MainBranch:                  mainBranch,
@@ -321,9 +377,15 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 		PerennialRegex:              perennialRegex,
 		ProposalBreadcrumb:          proposalBreadcrumb,
 		ProposalBreadcrumbDirection: proposalBreadcrumbDirection,
+		ProposeAssignees:            proposeAssignees,
+		ProposeDraft:                proposeDraft,
+		ProposeLabels:               proposeLabels,
+		ProposeReviewers:            proposeReviewers,
+		ProposeTemplate:             proposeTemplate,
 		PushBranches:                pushBranches,
 		PushHook:                    pushHook,
 		ShareNewBranches:            shareNewBranches,
+		ShipAPIMergeMethod:          shipAPIMergeMethod,
 		ShipDeleteTrackingBranch:    shipDeleteTrackingBranch,
 		IgnoreUncommitted:           ignoreUncommitted,
 		ShipStrategy:                shipStrategy,
@@ -334,5 +396,54 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 		SyncTags:                    syncTags,
 		SyncUpstream:                syncUpstream,
 		Verbose:                     None[configdomain.Verbose](),
+		WorktreeRoot:                worktreeRoot,
 	}, ec.Err
 }
+
+// decodeBranchOverrides provides the "[branches.<glob>]" tables in the given config file TOML source.
+func decodeBranchOverrides(text string) (map[string]BranchOverride, error) {
+	var data struct {
+		Branches map[string]toml.Primitive `toml:"branches"`
+	}
+	metadata, err := toml.Decode(text, &data)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]BranchOverride)
+	for key, primitive := range data.Branches { // okay to iterate the map in random order because we assign to a new map
+		if metadata.Type("branches", key) != "Hash" {
+			continue
+		}
+		var override BranchOverride
+		if err := metadata.PrimitiveDecode(primitive, &override); err != nil {
+			return result, err
+		}
+		result[key] = override
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+// validateBranchOverride converts the given low-level branch override data into high-level config data.
+func validateBranchOverride(data BranchOverride, ec *gohacks.ErrorCollector) configdomain.BranchOverride {
+	result := configdomain.EmptyBranchOverride()
+	var err error
+	if data.PushBranches != nil {
+		result.PushBranches = Some(configdomain.PushBranches(*data.PushBranches))
+	}
+	if data.ShareNewBranches != nil {
+		result.ShareNewBranches, err = configdomain.ParseShareNewBranches(*data.ShareNewBranches, messages.ConfigFile)
+		ec.Check(err)
+	}
+	if data.ShipStrategy != nil {
+		result.ShipStrategy, err = configdomain.ParseShipStrategy(*data.ShipStrategy, messages.ConfigFile)
+		ec.Check(err)
+	}
+	if data.SyncFeatureStrategy != nil {
+		result.SyncFeatureStrategy, err = configdomain.ParseSyncFeatureStrategy(*data.SyncFeatureStrategy, messages.ConfigFile)
+		ec.Check(err)
+	}
+	return result
+}
@@ -0,0 +1,172 @@
+package configfile
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/gohacks/stringslice"
+	"github.com/git-town/git-town/v22/internal/messages"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// LoadAll loads all configuration files that apply to the given repository,
+// ordered from lowest to highest precedence:
+//   - the user-wide configuration file
+//   - the configuration file in the root directory of the repository
+//   - the configuration files in the subfolders between the repository root and the working directory
+//
+// The files that a configuration file includes come right before it.
+func LoadAll(args LoadAllArgs) (configdomain.ConfigFiles, error) {
+	homeDir, _ := os.UserHomeDir() // without a home directory, paths starting with "~" don't get expanded
+	loader := fileLoader{
+		finalMessages: args.FinalMessages,
+		homeDir:       homeDir,
+		result:        configdomain.ConfigFiles{},
+		rootDir:       args.RootDir.String(),
+		userFilePath:  UserFilePath(args.UserConfigDir),
+	}
+	if fileExists(loader.userFilePath) {
+		if err := loader.load(loader.userFilePath, configdomain.ConfigFileKindUser, true, []string{}); err != nil {
+			return loader.result, err
+		}
+	}
+	for d, dir := range repoDirs(args.RootDir.String(), args.WorkingDir) {
+		path, hasFile := FindFile(dir).Get()
+		if !hasFile {
+			continue
+		}
+		kind := configdomain.ConfigFileKindSubfolder
+		if d == 0 {
+			kind = configdomain.ConfigFileKindRepo
+		}
+		if err := loader.load(path, kind, false, []string{}); err != nil {
+			return loader.result, err
+		}
+	}
+	return loader.result, nil
+}
+
+type LoadAllArgs struct {
+	FinalMessages stringslice.Collector
+	RootDir       gitdomain.RepoRootDir
+	UserConfigDir configdomain.UserConfigDir
+	WorkingDir    string // the directory in which Git Town runs
+}
+
+// FindFile provides the path of the configuration file in the given directory.
+func FindFile(dir string) Option[string] {
+	for _, fileName := range []string{FileName, HiddenFileName, AlternativeFileName} {
+		path := filepath.Join(dir, fileName)
+		if fileExists(path) {
+			return Some(path)
+		}
+	}
+	return None[string]()
+}
+
+// UserFilePath provides the location of the user-wide configuration file inside the given UserConfigDir.
+func UserFilePath(userConfigDir configdomain.UserConfigDir) string {
+	return filepath.Join(userConfigDir.String(), "git-town", UserFileName)
+}
+
+// fileLoader loads configuration files and the files they include.
+type fileLoader struct {
+	finalMessages stringslice.Collector
+	homeDir       string
+	result        configdomain.ConfigFiles
+	rootDir       string
+	userFilePath  string
+}
+
+// displayPath provides the given path in the form that Git Town shows it to the user.
+func (self *fileLoader) displayPath(path string) string {
+	if rel, err := filepath.Rel(self.rootDir, path); err == nil && !strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(rel)
+	}
+	if self.homeDir != "" {
+		if rel, err := filepath.Rel(self.homeDir, path); err == nil && !strings.HasPrefix(rel, "..") {
+			return "~/" + filepath.ToSlash(rel)
+		}
+	}
+	return path
+}
+
+// load adds the configuration file at the given path and the files it includes to the result.
+// The given chain contains the files that include this file.
+func (self *fileLoader) load(path string, kind configdomain.ConfigFileKind, userLevel bool, chain []string) error {
+	if slices.Contains(chain, path) {
+		return fmt.Errorf(messages.ConfigFileIncludeCycle, self.displayPath(path))
+	}
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf(messages.ConfigFileCannotRead, self.displayPath(path), err)
+	}
+	data, err := Decode(string(bytes))
+	if err != nil {
+		return fmt.Errorf(messages.ConfigFileInvalidContent, self.displayPath(path), err)
+	}
+	// credentials must not end up in files that get committed into repositories
+	if !userLevel && data.Hosting != nil && data.Hosting.HasCredentials() {
+		self.finalMessages.Addf(messages.ConfigFileCredentials, self.displayPath(path), self.displayPath(self.userFilePath))
+		data.Hosting.RemoveCredentials()
+	}
+	for _, include := range data.Include {
+		includePath := self.resolveInclude(include, filepath.Dir(path))
+		if err = self.load(includePath, configdomain.ConfigFileKindIncluded, userLevel, append(chain, path)); err != nil {
+			return err
+		}
+	}
+	config, err := Validate(*data, self.finalMessages)
+	if err != nil {
+		return err
+	}
+	self.result = append(self.result, configdomain.ConfigFile{
+		Config: config,
+		Kind:   kind,
+		Path:   self.displayPath(path),
+	})
+	return nil
+}
+
+// resolveInclude provides the absolute path of the given include directive in a configuration file located in the given directory.
+func (self *fileLoader) resolveInclude(include, dir string) string {
+	if self.homeDir != "" && (include == "~" || strings.HasPrefix(include, "~/")) {
+		return filepath.Join(self.homeDir, include[1:])
+	}
+	if filepath.IsAbs(include) {
+		return filepath.Clean(include)
+	}
+	return filepath.Join(dir, include)
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+// repoDirs provides the repository root and the subfolders between it and the given working directory, starting at the root.
+func repoDirs(rootDir, workingDir string) []string {
+	result := []string{rootDir}
+	rel, err := filepath.Rel(resolveSymlinks(rootDir), resolveSymlinks(workingDir))
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return result
+	}
+	dir := rootDir
+	for _, segment := range strings.Split(rel, string(filepath.Separator)) {
+		dir = filepath.Join(dir, segment)
+		result = append(result, dir)
+	}
+	return result
+}
+
+func resolveSymlinks(path string) string {
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		return resolved
+	}
+	return path
+}
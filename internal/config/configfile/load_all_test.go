@@ -0,0 +1,118 @@
+package configfile_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/config/configfile"
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/gohacks/stringslice"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestLoadAll(t *testing.T) {
+	t.Parallel()
+
+	writeFile := func(t *testing.T, path, content string) {
+		t.Helper()
+		must.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+		must.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	t.Run("credentials in the repo config file", func(t *testing.T) {
+		t.Parallel()
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, "git-town.toml"), "[hosting]\nforge-type = \"github\"\ngithub-token = \"secret\"\n")
+		finalMessages := stringslice.NewCollector()
+		have, err := configfile.LoadAll(configfile.LoadAllArgs{
+			FinalMessages: finalMessages,
+			RootDir:       gitdomain.NewRepoRootDir(dir),
+			UserConfigDir: configdomain.UserConfigDir(filepath.Join(dir, "config")),
+			WorkingDir:    dir,
+		})
+		must.NoError(t, err)
+		must.SliceLen(t, 1, have)
+		must.True(t, have[0].Config.GithubToken.IsNone())
+		must.True(t, have[0].Config.ForgeType.EqualSome(forgedomain.ForgeTypeGithub))
+		must.SliceLen(t, 1, finalMessages.Result())
+		must.StrContains(t, finalMessages.Result()[0], "Ignoring the forge credentials in the configuration file git-town.toml")
+	})
+
+	t.Run("include cycle", func(t *testing.T) {
+		t.Parallel()
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, "git-town.toml"), "include = [\"other.toml\"]\n")
+		writeFile(t, filepath.Join(dir, "other.toml"), "include = [\"git-town.toml\"]\n")
+		_, err := configfile.LoadAll(configfile.LoadAllArgs{
+			FinalMessages: stringslice.NewCollector(),
+			RootDir:       gitdomain.NewRepoRootDir(dir),
+			UserConfigDir: configdomain.UserConfigDir(filepath.Join(dir, "config")),
+			WorkingDir:    dir,
+		})
+		must.ErrorContains(t, err, "the configuration file git-town.toml includes itself")
+	})
+
+	t.Run("missing included file", func(t *testing.T) {
+		t.Parallel()
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, "git-town.toml"), "include = [\"missing.toml\"]\n")
+		_, err := configfile.LoadAll(configfile.LoadAllArgs{
+			FinalMessages: stringslice.NewCollector(),
+			RootDir:       gitdomain.NewRepoRootDir(dir),
+			UserConfigDir: configdomain.UserConfigDir(filepath.Join(dir, "config")),
+			WorkingDir:    dir,
+		})
+		must.ErrorContains(t, err, "cannot read the configuration file missing.toml")
+	})
+
+	t.Run("no config files", func(t *testing.T) {
+		t.Parallel()
+		dir := t.TempDir()
+		have, err := configfile.LoadAll(configfile.LoadAllArgs{
+			FinalMessages: stringslice.NewCollector(),
+			RootDir:       gitdomain.NewRepoRootDir(dir),
+			UserConfigDir: configdomain.UserConfigDir(filepath.Join(dir, "config")),
+			WorkingDir:    dir,
+		})
+		must.NoError(t, err)
+		must.SliceEmpty(t, have)
+	})
+
+	t.Run("user file, included file, repo file, and subfolder file", func(t *testing.T) {
+		t.Parallel()
+		dir := t.TempDir()
+		rootDir := filepath.Join(dir, "repo")
+		userConfigDir := configdomain.UserConfigDir(filepath.Join(dir, "config"))
+		writeFile(t, configfile.UserFilePath(userConfigDir), "[hosting]\nbrowser = \"firefox\"\ngithub-token = \"user-token\"\n\n[branches]\norder = \"desc\"\n")
+		writeFile(t, filepath.Join(dir, "shared", "base.toml"), "[sync]\nfeature-strategy = \"rebase\"\ntags = false\n")
+		writeFile(t, filepath.Join(rootDir, "git-town.toml"), "include = [\"../shared/base.toml\"]\n\n[branches]\nmain = \"main\"\norder = \"asc\"\n\n[sync]\ntags = true\n")
+		writeFile(t, filepath.Join(rootDir, "frontend", ".git-town.toml"), "[sync]\nfeature-strategy = \"merge\"\n")
+		have, err := configfile.LoadAll(configfile.LoadAllArgs{
+			FinalMessages: stringslice.NewCollector(),
+			RootDir:       gitdomain.NewRepoRootDir(rootDir),
+			UserConfigDir: userConfigDir,
+			WorkingDir:    filepath.Join(rootDir, "frontend", "src"),
+		})
+		must.NoError(t, err)
+		must.SliceLen(t, 4, have)
+		must.EqOp(t, configdomain.ConfigFileKindUser, have[0].Kind)
+		must.EqOp(t, configdomain.ConfigFileKindIncluded, have[1].Kind)
+		must.EqOp(t, configdomain.ConfigFileKindRepo, have[2].Kind)
+		must.EqOp(t, "git-town.toml", have[2].Path)
+		must.EqOp(t, configdomain.ConfigFileKindSubfolder, have[3].Kind)
+		must.EqOp(t, "frontend/.git-town.toml", have[3].Path)
+		merged := have.Merge()
+		must.True(t, merged.Browser.EqualSome(configdomain.Browser("firefox")))
+		must.True(t, merged.Order.EqualSome(configdomain.OrderAsc))
+		must.True(t, merged.SyncFeatureStrategy.EqualSome(configdomain.SyncFeatureStrategyMerge))
+		must.True(t, merged.SyncTags.EqualSome(configdomain.SyncTags(true)))
+		must.True(t, merged.GithubToken.EqualSome(forgedomain.GithubToken("user-token")))
+		repo := have.Repo()
+		must.Eq(t, None[configdomain.SyncFeatureStrategy](), repo.SyncFeatureStrategy)
+		must.True(t, repo.MainBranch.EqualSome(gitdomain.NewLocalBranchName("main")))
+	})
+}
@@ -27,6 +27,10 @@ func TestConfigfile(t *testing.T) {
 
 # See https://www.git-town.com/configuration-file for details
 
+[aliases]
+append = "town append"
+sync = "town sync"
+
 [branches]
 contribution-regex = "^gittown-"
 display-types = "no main perennial"
@@ -38,6 +42,11 @@ perennials = ["public", "staging"]
 perennial-regex = "release-.*"
 unknown-type = "prototype"
 
+[branches."release/*"]
+push-branches = false
+ship-strategy = "fast-forward"
+sync-feature-strategy = "rebase"
+
 [create]
 branch-prefix = "feature-"
 new-branch-type = "prototype"
@@ -53,10 +62,15 @@ gitlab-connector = "glab"
 origin-hostname = "github.com"
 
 [propose]
+assignees = ["alice"]
 breadcrumb = "stacks"
 breadcrumb-direction = "up"
+labels = ["team-a", "backend"]
+reviewers = ["bob", "carol"]
+template = ".github/proposal.tmpl"
 
 [ship]
+api-merge-method = "rebase"
 delete-tracking-branch = false
 ignore-uncommitted = true
 strategy = "api"
@@ -76,6 +90,10 @@ upstream = true
 			haveData, err := configfile.Decode(giveTOML)
 			must.NoError(t, err)
 			wantData := configfile.Data{
+				Aliases: map[string]string{
+					"append": "town append",
+					"sync":   "town sync",
+				},
 				Branches: &configfile.Branches{
 					ContributionRegex: new("^gittown-"),
 					DefaultType:       nil,
@@ -84,9 +102,17 @@ upstream = true
 					Main:              new("main"),
 					ObservedRegex:     new(`^dependabot\/`),
 					Order:             new("desc"),
-					PerennialRegex:    new("release-.*"),
-					Perennials:        []string{"public", "staging"},
-					UnknownType:       new("prototype"),
+					Overrides: map[string]configfile.BranchOverride{
+						"release/*": {
+							PushBranches:        new(false),
+							ShareNewBranches:    nil,
+							ShipStrategy:        new("fast-forward"),
+							SyncFeatureStrategy: new("rebase"),
+						},
+					},
+					PerennialRegex: new("release-.*"),
+					Perennials:     []string{"public", "staging"},
+					UnknownType:    new("prototype"),
 				},
 				Create: &configfile.Create{
 					BranchPrefix:     new("feature-"),
@@ -96,19 +122,32 @@ upstream = true
 					Stash:            new(true),
 				},
 				Hosting: &configfile.Hosting{
-					Browser:         new("chrome"),
-					DevRemote:       new("origin"),
-					ForgeType:       new("github"),
-					GithubConnector: new("gh"),
-					GitlabConnector: new("glab"),
-					OriginHostname:  new("github.com"),
-					Platform:        nil,
+					AzuredevopsToken:     nil,
+					BitbucketAppPassword: nil,
+					BitbucketUsername:    nil,
+					Browser:              new("chrome"),
+					DevRemote:            new("origin"),
+					ForgeType:            new("github"),
+					ForgejoToken:         nil,
+					GiteaToken:           nil,
+					GithubConnector:      new("gh"),
+					GithubToken:          nil,
+					GitlabConnector:      new("glab"),
+					GitlabToken:          nil,
+					OriginHostname:       new("github.com"),
+					Platform:             nil,
 				},
+				Include: nil,
 				Propose: &configfile.Propose{
+					Assignees:           []string{"alice"},
 					Breadcrumb:          new("stacks"),
 					BreadcrumbDirection: new("up"),
+					Labels:              []string{"team-a", "backend"},
+					Reviewers:           []string{"bob", "carol"},
+					Template:            new(".github/proposal.tmpl"),
 				},
 				Ship: &configfile.Ship{
+					APIMergeMethod:       new("rebase"),
 					DeleteTrackingBranch: new(false),
 					IgnoreUncommitted:    new(true),
 					Strategy:             new("api"),
@@ -141,21 +180,33 @@ upstream = true
 			haveConfig, err := configfile.Validate(*haveData, finalMessages)
 			must.NoError(t, err)
 			wantConfig := configdomain.PartialConfig{
-				Aliases:              configdomain.Aliases{},
+				Aliases: configdomain.Aliases{
+					configdomain.AliasableCommandAppend: "town append",
+					configdomain.AliasableCommandSync:   "town sync",
+				},
 				AutoResolve:          Some(configdomain.AutoResolve(false)),
 				AutoSync:             None[configdomain.AutoSync](),
 				BitbucketAppPassword: None[forgedomain.BitbucketAppPassword](),
 				BitbucketUsername:    None[forgedomain.BitbucketUsername](),
-				BranchPrefix:         Some(configdomain.BranchPrefix("feature-")),
-				BranchTypeOverrides:  configdomain.BranchTypeOverrides{},
-				Browser:              Some(configdomain.Browser("chrome")),
-				ContributionRegex:    asserts.NoError1(configdomain.ParseContributionRegex("^gittown-", "test")),
-				Detached:             Some(configdomain.Detached(true)),
-				DevRemote:            Some(gitdomain.Remote("origin")),
+				BranchOverrides: configdomain.BranchOverrides{
+					"release/*": {
+						PushBranches:        Some(configdomain.PushBranches(false)),
+						ShareNewBranches:    None[configdomain.ShareNewBranches](),
+						ShipStrategy:        Some(configdomain.ShipStrategyFastForward),
+						SyncFeatureStrategy: Some(configdomain.SyncFeatureStrategyRebase),
+					},
+				},
+				BranchPrefix:        Some(configdomain.BranchPrefix("feature-")),
+				BranchTypeOverrides: configdomain.BranchTypeOverrides{},
+				Browser:             Some(configdomain.Browser("chrome")),
+				ContributionRegex:   asserts.NoError1(configdomain.ParseContributionRegex("^gittown-", "test")),
+				Detached:            Some(configdomain.Detached(true)),
+				DevRemote:           Some(gitdomain.Remote("origin")),
 				DisplayTypes: Some(configdomain.DisplayTypes{
 					BranchTypes: []configdomain.BranchType{configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch},
 					Quantifier:  configdomain.QuantifierNo,
 				}),
+				AzuredevopsToken:            None[forgedomain.AzuredevopsToken](),
 				DryRun:                      None[configdomain.DryRun](),
 				FeatureRegex:                asserts.NoError1(configdomain.ParseFeatureRegex("^kg-", "test")),
 				ForgeType:                   asserts.NoError1(forgedomain.ParseForgeType("github", "test")),
@@ -179,9 +230,14 @@ upstream = true
 				PerennialRegex:              asserts.NoError1(configdomain.ParsePerennialRegex("release-.*", "test")),
 				ProposalBreadcrumb:          Some(configdomain.ProposalBreadcrumbStacks),
 				ProposalBreadcrumbDirection: Some(configdomain.ProposalBreadcrumbDirectionUp),
+				ProposeAssignees:            gitdomain.NewProposalMetadataNames("alice"),
+				ProposeLabels:               gitdomain.NewProposalMetadataNames("team-a", "backend"),
+				ProposeReviewers:            gitdomain.NewProposalMetadataNames("bob", "carol"),
+				ProposeTemplate:             Some(configdomain.ProposeTemplate(".github/proposal.tmpl")),
 				PushBranches:                None[configdomain.PushBranches](),
 				PushHook:                    Some(configdomain.PushHook(true)),
 				ShareNewBranches:            Some(configdomain.ShareNewBranchesPush),
+				ShipAPIMergeMethod:          Some(forgedomain.ProposalMergeMethodRebase),
 				ShipDeleteTrackingBranch:    Some(configdomain.ShipDeleteTrackingBranch(false)),
 				ShipStrategy:                Some(configdomain.ShipStrategyAPI),
 				Stash:                       Some(configdomain.Stash(true)),
@@ -217,6 +273,7 @@ main = "main"
 				},
 				Create:                   nil,
 				Hosting:                  nil,
+				Include:                  nil,
 				SyncStrategy:             nil,
 				PushHook:                 nil,
 				ShipDeleteTrackingBranch: nil,
@@ -259,6 +316,18 @@ perennials = [
 			must.Eq(t, want, *have)
 		})
 
+		t.Run("unknown aliased command", func(t *testing.T) {
+			t.Parallel()
+			give := `
+[aliases]
+zonk = "town zonk"
+`[1:]
+			data, err := configfile.Decode(give)
+			must.NoError(t, err)
+			_, err = configfile.Validate(*data, stringslice.NewCollector())
+			must.ErrorContains(t, err, `unknown aliasable command in config file: "zonk"`)
+		})
+
 		t.Run("outdated entries", func(t *testing.T) {
 			t.Parallel()
 			giveTOML := `
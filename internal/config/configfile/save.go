@@ -2,12 +2,16 @@ package configfile
 
 import (
 	"cmp"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/git-town/git-town/v22/internal/config/configdomain"
 	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/gohacks/mapstools"
 )
 
 func RenderPerennialBranches(perennials gitdomain.LocalBranchNames) string {
@@ -17,10 +21,32 @@ func RenderPerennialBranches(perennials gitdomain.LocalBranchNames) string {
 	return fmt.Sprintf(`["%s"]`, perennials.Join(`", "`))
 }
 
+func renderStrings(values []string) string {
+	if len(values) == 0 {
+		return "[]"
+	}
+	return fmt.Sprintf(`["%s"]`, strings.Join(values, `", "`))
+}
+
 func RenderTOML(data configdomain.PartialConfig) string {
+	return renderTOML(data, []string{})
+}
+
+// renderTOML provides the TOML source of a config file with the given settings that includes the given config files.
+func renderTOML(data configdomain.PartialConfig, include []string) string {
 	result := strings.Builder{}
 	result.WriteString("#:schema https://raw.githubusercontent.com/git-town/git-town/refs/heads/main/docs/git-town.schema.json\n\n")
 	result.WriteString("# See https://www.git-town.com/configuration-file for details\n")
+	if len(include) > 0 {
+		result.WriteString(fmt.Sprintf("\ninclude = %s\n", renderStrings(include)))
+	}
+
+	if len(data.Aliases) > 0 {
+		result.WriteString("\n[aliases]\n")
+		for aliasableCommand, alias := range mapstools.SortedKeyValues(data.Aliases) {
+			result.WriteString(fmt.Sprintf("%s = %q\n", aliasableCommand, alias))
+		}
+	}
 
 	// keep-sorted start
 	contributionRegex, hasContributionRegex := data.ContributionRegex.Get()
@@ -77,12 +103,30 @@ func RenderTOML(data configdomain.PartialConfig) string {
 		}
 		// keep-sorted end
 	}
+	for pattern, override := range mapstools.SortedKeyValues(data.BranchOverrides) {
+		result.WriteString(fmt.Sprintf("\n[branches.%q]\n", pattern))
+		// keep-sorted start block=yes
+		if pushBranches, hasPushBranches := override.PushBranches.Get(); hasPushBranches {
+			result.WriteString(fmt.Sprintf("push-branches = %t\n", pushBranches))
+		}
+		if shareNewBranches, hasShareNewBranches := override.ShareNewBranches.Get(); hasShareNewBranches {
+			result.WriteString(fmt.Sprintf("share-new-branches = %q\n", shareNewBranches))
+		}
+		if shipStrategy, hasShipStrategy := override.ShipStrategy.Get(); hasShipStrategy {
+			result.WriteString(fmt.Sprintf("ship-strategy = %q\n", shipStrategy))
+		}
+		if syncFeatureStrategy, hasSyncFeatureStrategy := override.SyncFeatureStrategy.Get(); hasSyncFeatureStrategy {
+			result.WriteString(fmt.Sprintf("sync-feature-strategy = %q\n", syncFeatureStrategy))
+		}
+		// keep-sorted end
+	}
 
 	branchPrefix, hasBranchPrefix := data.BranchPrefix.Get()
 	newBranchType, hasNewBranchType := data.NewBranchType.Get()
 	shareNewBranches, hasShareNewBranches := data.ShareNewBranches.Get()
 	stash, hasStash := data.Stash.Get()
-	if cmp.Or(hasBranchPrefix, hasNewBranchType, hasShareNewBranches, hasStash) {
+	worktreeRoot, hasWorktreeRoot := data.WorktreeRoot.Get()
+	if cmp.Or(hasBranchPrefix, hasNewBranchType, hasShareNewBranches, hasStash, hasWorktreeRoot) {
 		result.WriteString("\n[create]\n")
 		// keep-sorted start block=yes
 		if hasBranchPrefix {
@@ -97,6 +141,9 @@ func RenderTOML(data configdomain.PartialConfig) string {
 		if hasStash {
 			result.WriteString(fmt.Sprintf("stash = %s\n", stash))
 		}
+		if hasWorktreeRoot {
+			result.WriteString(fmt.Sprintf("worktree-root = %q\n", worktreeRoot))
+		}
 		// keep-sorted end
 	}
 
@@ -143,21 +190,45 @@ func RenderTOML(data configdomain.PartialConfig) string {
 
 	proposalBreadcrumb, hasProposalBreadcrumb := data.ProposalBreadcrumb.Get()
 	proposalBreadcrumbDirection, hasProposalBreadcrumbDirection := data.ProposalBreadcrumbDirection.Get()
-	if hasProposalBreadcrumb || hasProposalBreadcrumbDirection {
+	proposeDraft, hasProposeDraft := data.ProposeDraft.Get()
+	hasProposeAssignees := len(data.ProposeAssignees) > 0
+	hasProposeLabels := len(data.ProposeLabels) > 0
+	hasProposeReviewers := len(data.ProposeReviewers) > 0
+	proposeTemplate, hasProposeTemplate := data.ProposeTemplate.Get()
+	if cmp.Or(hasProposalBreadcrumb, hasProposalBreadcrumbDirection, hasProposeAssignees, hasProposeDraft, hasProposeLabels, hasProposeReviewers, hasProposeTemplate) {
 		result.WriteString("\n[propose]\n")
+		if hasProposeAssignees {
+			result.WriteString(fmt.Sprintf("assignees = %s\n", renderStrings(data.ProposeAssignees.Strings())))
+		}
 		if hasProposalBreadcrumb {
 			result.WriteString(fmt.Sprintf("breadcrumb = %q\n", proposalBreadcrumb))
 		}
 		if hasProposalBreadcrumbDirection {
 			result.WriteString(fmt.Sprintf("breadcrumb-direction = %q\n", proposalBreadcrumbDirection))
 		}
+		if hasProposeDraft {
+			result.WriteString(fmt.Sprintf("draft = %t\n", proposeDraft))
+		}
+		if hasProposeLabels {
+			result.WriteString(fmt.Sprintf("labels = %s\n", renderStrings(data.ProposeLabels.Strings())))
+		}
+		if hasProposeReviewers {
+			result.WriteString(fmt.Sprintf("reviewers = %s\n", renderStrings(data.ProposeReviewers.Strings())))
+		}
+		if hasProposeTemplate {
+			result.WriteString(fmt.Sprintf("template = %q\n", proposeTemplate))
+		}
 	}
 
+	apiMergeMethod, hasAPIMergeMethod := data.ShipAPIMergeMethod.Get()
 	deleteTrackingBranch, hasDeleteTrackingBranch := data.ShipDeleteTrackingBranch.Get()
 	ignoreUncommitted, hasIgnoreUncommitted := data.IgnoreUncommitted.Get()
 	shipStrategy, hasShipStrategy := data.ShipStrategy.Get()
-	if cmp.Or(hasDeleteTrackingBranch, hasIgnoreUncommitted, hasShipStrategy) {
+	if cmp.Or(hasAPIMergeMethod, hasDeleteTrackingBranch, hasIgnoreUncommitted, hasShipStrategy) {
 		result.WriteString("\n[ship]\n")
+		if hasAPIMergeMethod {
+			result.WriteString(fmt.Sprintf("api-merge-method = %q\n", apiMergeMethod))
+		}
 		if hasDeleteTrackingBranch {
 			result.WriteString(fmt.Sprintf("delete-tracking-branch = %t\n", deleteTrackingBranch))
 		}
@@ -232,6 +303,124 @@ func RenderTOML(data configdomain.PartialConfig) string {
 	return result.String()
 }
 
+// RenderFile provides the new content of the config file at the given path with the given settings.
+// The new content keeps the config files that the existing file includes.
+func RenderFile(data configdomain.PartialConfig, path string) string {
+	return renderTOML(data, Includes(path))
+}
+
 func Save(data configdomain.PartialConfig) error {
-	return os.WriteFile(FileName, []byte(RenderTOML(data)), 0o600)
+	return os.WriteFile(FileName, []byte(RenderFile(data, FileName)), 0o600)
+}
+
+// SaveSetting stores the given setting in the config file at the given path.
+// It changes only the entries of this setting and keeps the remaining content of the file, including comments.
+func SaveSetting(setting configdomain.PartialConfig, path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		return os.WriteFile(path, []byte(RenderTOML(setting)), 0o600)
+	}
+	return os.WriteFile(path, []byte(UpdateTOML(string(content), setting)), 0o600)
+}
+
+// UpdateTOML provides the given TOML source with the entries of the given settings changed or added.
+func UpdateTOML(content string, setting configdomain.PartialConfig) string {
+	if strings.TrimSpace(content) == "" {
+		return RenderTOML(setting)
+	}
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	table := ""
+	for _, line := range strings.Split(RenderTOML(setting), "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "", strings.HasPrefix(trimmed, "#"):
+		case strings.HasPrefix(trimmed, "["):
+			table = tomlTable(trimmed)
+		default:
+			lines = updateEntry(lines, table, tomlKey(trimmed), trimmed)
+		}
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// entryEnd provides the index of the last line of the TOML entry that starts at the given line.
+// Arrays can span multiple lines.
+func entryEnd(lines []string, start int) int {
+	_, value, _ := strings.Cut(lines[start], "=")
+	if !strings.HasPrefix(strings.TrimSpace(value), "[") {
+		return start
+	}
+	depth := strings.Count(value, "[") - strings.Count(value, "]")
+	end := start
+	for depth > 0 && end+1 < len(lines) {
+		end++
+		depth += strings.Count(lines[end], "[") - strings.Count(lines[end], "]")
+	}
+	return end
+}
+
+// tomlKey provides the key of the given TOML entry line.
+func tomlKey(line string) string {
+	key, _, _ := strings.Cut(line, "=")
+	return strings.Trim(strings.TrimSpace(key), `"`)
+}
+
+// tomlTable provides the name of the table that the given TOML table header line starts.
+func tomlTable(line string) string {
+	end := strings.LastIndex(line, "]")
+	if end < 1 {
+		return ""
+	}
+	return strings.TrimSpace(line[1:end])
+}
+
+// updateEntry provides the given TOML lines with the entry for the given key in the given table replaced by the given entry.
+// It adds the entry, and if needed the table, if they don't exist yet.
+func updateEntry(lines []string, table, key, entry string) []string {
+	currentTable := ""
+	lastTableLine := -1 // the last line of the given table that isn't empty or a comment
+	for i := 0; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "[") {
+			currentTable = tomlTable(trimmed)
+			if currentTable == table {
+				lastTableLine = i
+			}
+			continue
+		}
+		end := entryEnd(lines, i)
+		lineKey := tomlKey(trimmed)
+		switch {
+		case currentTable == table && lineKey == key:
+			return slices.Replace(lines, i, end+1, entry)
+		case currentTable == "" && lineKey == table+"."+key:
+			return slices.Replace(lines, i, end+1, table+"."+entry)
+		case currentTable == table:
+			lastTableLine = end
+		}
+		i = end
+	}
+	if lastTableLine >= 0 {
+		return slices.Insert(lines, lastTableLine+1, entry)
+	}
+	return append(lines, "", "["+table+"]", entry)
+}
+
+// Includes provides the config files that the existing config file at the given path includes.
+func Includes(path string) []string {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return []string{}
+	}
+	data, err := Decode(string(bytes))
+	if err != nil {
+		return []string{}
+	}
+	return data.Include
 }
@@ -74,9 +74,15 @@ func TestSave(t *testing.T) {
 				PerennialRegex:              perennialRegex,
 				ProposalBreadcrumb:          Some(configdomain.ProposalBreadcrumbBranches),
 				ProposalBreadcrumbDirection: Some(configdomain.ProposalBreadcrumbDirectionUp),
+				ProposeAssignees:            gitdomain.NewProposalMetadataNames("alice"),
+				ProposeDraft:                Some(configdomain.ProposeDraft(true)),
+				ProposeLabels:               gitdomain.NewProposalMetadataNames("team-a", "backend"),
+				ProposeReviewers:            gitdomain.NewProposalMetadataNames("bob", "carol"),
+				ProposeTemplate:             Some(configdomain.ProposeTemplate(".github/proposal.tmpl")),
 				PushBranches:                Some(configdomain.PushBranches(true)),
 				PushHook:                    Some(configdomain.PushHook(true)),
 				ShareNewBranches:            Some(configdomain.ShareNewBranchesPropose),
+				ShipAPIMergeMethod:          Some(forgedomain.ProposalMergeMethodMerge),
 				ShipDeleteTrackingBranch:    Some(configdomain.ShipDeleteTrackingBranch(true)),
 				ShipStrategy:                Some(configdomain.ShipStrategyAPI),
 				Stash:                       Some(configdomain.Stash(true)),
@@ -118,10 +124,16 @@ gitlab-connector = "glab"
 origin-hostname = "forge"
 
 [propose]
+assignees = ["alice"]
 breadcrumb = "branches"
 breadcrumb-direction = "up"
+draft = true
+labels = ["team-a", "backend"]
+reviewers = ["bob", "carol"]
+template = ".github/proposal.tmpl"
 
 [ship]
+api-merge-method = "merge"
 delete-tracking-branch = true
 ignore-uncommitted = true
 strategy = "api"
@@ -163,4 +175,117 @@ upstream = true
 		want := configfile.RenderTOML(config)
 		must.EqOp(t, want, have)
 	})
+
+	t.Run("UpdateTOML", func(t *testing.T) {
+		t.Parallel()
+		t.Run("changes an existing entry and keeps comments", func(t *testing.T) {
+			t.Parallel()
+			give := `
+# shared settings
+
+[branches]
+main = "main" # the default branch
+perennials = ["qa"]
+
+[sync]
+# rebase is easier to review
+feature-strategy = "rebase"
+`[1:]
+			setting := configdomain.EmptyPartialConfig()
+			setting.SyncFeatureStrategy = Some(configdomain.SyncFeatureStrategyMerge)
+			have := configfile.UpdateTOML(give, setting)
+			want := `
+# shared settings
+
+[branches]
+main = "main" # the default branch
+perennials = ["qa"]
+
+[sync]
+# rebase is easier to review
+feature-strategy = "merge"
+`[1:]
+			must.EqOp(t, want, have)
+		})
+		t.Run("changes a multi-line array", func(t *testing.T) {
+			t.Parallel()
+			give := `
+[branches]
+perennials = [
+  "qa",
+]
+main = "main"
+`[1:]
+			setting := configdomain.EmptyPartialConfig()
+			setting.PerennialBranches = gitdomain.NewLocalBranchNames("qa", "staging")
+			have := configfile.UpdateTOML(give, setting)
+			want := `
+[branches]
+perennials = ["qa", "staging"]
+main = "main"
+`[1:]
+			must.EqOp(t, want, have)
+		})
+		t.Run("changes a dotted key", func(t *testing.T) {
+			t.Parallel()
+			give := `
+branches.main = "main"
+`[1:]
+			setting := configdomain.EmptyPartialConfig()
+			setting.MainBranch = Some(gitdomain.NewLocalBranchName("master"))
+			have := configfile.UpdateTOML(give, setting)
+			want := `
+branches.main = "master"
+`[1:]
+			must.EqOp(t, want, have)
+		})
+		t.Run("adds an entry to an existing table", func(t *testing.T) {
+			t.Parallel()
+			give := `
+[branches]
+main = "main"
+
+[sync]
+upstream = true
+`[1:]
+			setting := configdomain.EmptyPartialConfig()
+			setting.PerennialBranches = gitdomain.NewLocalBranchNames("qa")
+			have := configfile.UpdateTOML(give, setting)
+			want := `
+[branches]
+main = "main"
+perennials = ["qa"]
+
+[sync]
+upstream = true
+`[1:]
+			must.EqOp(t, want, have)
+		})
+		t.Run("adds a new table", func(t *testing.T) {
+			t.Parallel()
+			give := `
+[branches]
+main = "main"
+`[1:]
+			setting := configdomain.EmptyPartialConfig()
+			setting.SyncTags = Some(configdomain.SyncTags(false))
+			have := configfile.UpdateTOML(give, setting)
+			want := `
+[branches]
+main = "main"
+
+[sync]
+tags = false
+`[1:]
+			must.EqOp(t, want, have)
+		})
+		t.Run("empty file", func(t *testing.T) {
+			t.Parallel()
+			setting := configdomain.EmptyPartialConfig()
+			setting.SyncTags = Some(configdomain.SyncTags(false))
+			have := configfile.UpdateTOML("", setting)
+			want := configfile.RenderTOML(setting)
+			must.EqOp(t, want, have)
+		})
+	})
 }
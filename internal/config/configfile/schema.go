@@ -0,0 +1,52 @@
+package configfile
+
+import (
+	"encoding/json"
+	"maps"
+	"strings"
+	"unicode"
+
+	"github.com/invopop/jsonschema"
+)
+
+// JSONSchema provides the JSON Schema of the configuration file format.
+// Editors use it to autocomplete and validate configuration files.
+func JSONSchema() (string, error) {
+	reflector := new(jsonschema.Reflector)
+	reflector.RequiredFromJSONSchemaTags = true
+	reflector.KeyNamer = CamelToKebab
+	schema := reflector.Reflect(&Data{}) //exhaustruct:ignore
+	// the "[branches.<glob>]" tables contain the settings for the branches matching the glob
+	branchOverrideSchema := reflector.Reflect(&BranchOverride{}) //exhaustruct:ignore
+	maps.Copy(schema.Definitions, branchOverrideSchema.Definitions)
+	schema.Definitions["Branches"].AdditionalProperties = &jsonschema.Schema{Ref: "#/$defs/BranchOverride"} //exhaustruct:ignore
+	schema.ID = "https://www.git-town.com/git-town.toml"
+	schemaJSON, err := json.MarshalIndent(schema, "", "  ")
+	return string(schemaJSON), err
+}
+
+// CamelToKebab converts a CamelCase string to kebab-case.
+// For example, "SyncStrategy" becomes "sync-strategy".
+// Acronyms remain a single word, so "APIMergeMethod" becomes "api-merge-method".
+func CamelToKebab(s string) string {
+	var result strings.Builder
+	runes := []rune(s)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && startsWord(runes, i) {
+				result.WriteRune('-')
+			}
+			result.WriteRune(unicode.ToLower(r))
+		} else {
+			result.WriteRune(r)
+		}
+	}
+	return result.String()
+}
+
+// startsWord indicates whether the uppercase rune at the given position starts a new word.
+func startsWord(runes []rune, i int) bool {
+	previousIsUpper := unicode.IsUpper(runes[i-1])
+	nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+	return !previousIsUpper || nextIsLower
+}
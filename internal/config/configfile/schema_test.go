@@ -1,9 +1,10 @@
-package main
+package configfile_test
 
 import (
 	"fmt"
 	"testing"
 
+	"github.com/git-town/git-town/v22/internal/config/configfile"
 	"github.com/shoenig/test/must"
 )
 
@@ -13,11 +14,13 @@ func TestCamelToKebab(t *testing.T) {
 		"SyncStrategy":            "sync-strategy",
 		"CreatePrototypeBranches": "create-prototype-branches",
 		"Single":                  "single",
+		"APIMergeMethod":          "api-merge-method",
+		"OriginURL":               "origin-url",
 	}
 	for give, want := range tests {
 		t.Run(fmt.Sprintf("%s -> %s", give, want), func(t *testing.T) {
 			t.Parallel()
-			have := CamelToKebab(give)
+			have := configfile.CamelToKebab(give)
 			must.Eq(t, want, have)
 		})
 	}
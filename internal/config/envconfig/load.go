@@ -13,6 +13,7 @@ import (
 const (
 	autoResolve                 = "GIT_TOWN_AUTO_RESOLVE"
 	autoSync                    = "GIT_TOWN_AUTO_SYNC"
+	azuredevopsToken            = "GIT_TOWN_AZUREDEVOPS_TOKEN"
 	bitbucketAppPassword        = "GIT_TOWN_BITBUCKET_APP_PASSWORD"
 	bitbucketUserName           = "GIT_TOWN_BITBUCKET_USERNAME"
 	branchPrefix                = "GIT_TOWN_BRANCH_PREFIX"
@@ -45,9 +46,12 @@ const (
 	perennialRegex              = "GIT_TOWN_PERENNIAL_REGEX"
 	proposalBreadcrumb          = "GIT_TOWN_PROPOSAL_BREADCRUMB"
 	proposalBreadcrumbDirection = "GIT_TOWN_PROPOSAL_BREADCRUMB_DIRECTION"
+	proposeDraft                = "GIT_TOWN_PROPOSE_DRAFT"
+	proposeTemplate             = "GIT_TOWN_PROPOSE_TEMPLATE"
 	pushBranches                = "GIT_TOWN_PUSH_BRANCHES"
 	pushHook                    = "GIT_TOWN_PUSH_HOOK"
 	shareNewBranches            = "GIT_TOWN_SHARE_NEW_BRANCHES"
+	shipAPIMergeMethod          = "GIT_TOWN_SHIP_API_MERGE_METHOD"
 	shipDeleteTrackingBranch    = "GIT_TOWN_SHIP_DELETE_TRACKING_BRANCH"
 	shipStrategy                = "GIT_TOWN_SHIP_STRATEGY"
 	stash                       = "GIT_TOWN_STASH"
@@ -59,6 +63,7 @@ const (
 	term                        = "TERM"
 	unknownBranchType           = "GIT_TOWN_UNKNOWN_BRANCH_TYPE"
 	verbose                     = "GIT_TOWN_VERBOSE"
+	worktreeRoot                = "GIT_TOWN_WORKTREE_ROOT"
 )
 
 func Load(env EnvVars) (configdomain.PartialConfig, error) {
@@ -89,9 +94,12 @@ func Load(env EnvVars) (configdomain.PartialConfig, error) {
 	perennialRegex, errPerennialRegex := load(env, perennialRegex, configdomain.ParsePerennialRegex)
 	proposalBreadcrumb, errProposalBreadcrumb := load(env, proposalBreadcrumb, configdomain.ParseProposalBreadcrumb)
 	proposalBreadcrumbDirection, errProposalBreadcrumbDirection := load(env, proposalBreadcrumbDirection, configdomain.ParseProposalBreadcrumbDirection)
+	proposeDraft, errProposeDraft := load(env, proposeDraft, gohacks.ParseBoolOpt[configdomain.ProposeDraft])
+	proposeTemplate, errProposeTemplate := load(env, proposeTemplate, configdomain.ParseProposeTemplate)
 	pushBranches, errPushBranches := load(env, pushBranches, gohacks.ParseBoolOpt[configdomain.PushBranches])
 	pushHook, errPushHook := load(env, pushHook, gohacks.ParseBoolOpt[configdomain.PushHook])
 	shareNewBranches, errShareNewBranches := load(env, shareNewBranches, configdomain.ParseShareNewBranches)
+	shipAPIMergeMethod, errShipAPIMergeMethod := load(env, shipAPIMergeMethod, forgedomain.ParseProposalMergeMethod)
 	shipDeleteTrackingBranch, errShipDeleteTrackingBranch := load(env, shipDeleteTrackingBranch, gohacks.ParseBoolOpt[configdomain.ShipDeleteTrackingBranch])
 	shipStrategy, errShipStrategy := load(env, shipStrategy, configdomain.ParseShipStrategy)
 	stash, errStash := load(env, stash, gohacks.ParseBoolOpt[configdomain.Stash])
@@ -102,6 +110,7 @@ func Load(env EnvVars) (configdomain.PartialConfig, error) {
 	syncUpstream, errSyncUpstream := load(env, syncUpstream, gohacks.ParseBoolOpt[configdomain.SyncUpstream])
 	unknownBranchType, errUnknownBranchType := load(env, unknownBranchType, configdomain.ParseBranchType)
 	verbose, errVerbose := load(env, verbose, gohacks.ParseBoolOpt[configdomain.Verbose])
+	worktreeRoot, errWorktreeRoot := load(env, worktreeRoot, configdomain.ParseWorktreeRoot)
 	err := cmp.Or(
 		errAutoResolve,
 		errAutoSync,
@@ -123,9 +132,12 @@ func Load(env EnvVars) (configdomain.PartialConfig, error) {
 		errPerennialRegex,
 		errProposalBreadcrumb,
 		errProposalBreadcrumbDirection,
+		errProposeDraft,
+		errProposeTemplate,
 		errPushBranches,
 		errPushHook,
 		errShareNewBranches,
+		errShipAPIMergeMethod,
 		errShipDeleteTrackingBranch,
 		errShipStrategy,
 		errStash,
@@ -136,13 +148,16 @@ func Load(env EnvVars) (configdomain.PartialConfig, error) {
 		errSyncUpstream,
 		errUnknownBranchType,
 		errVerbose,
+		errWorktreeRoot,
 	)
 	return configdomain.PartialConfig{
 		Aliases:                     configdomain.Aliases{}, // aliases aren't loaded from env vars
 		AutoResolve:                 autoResolve,
 		AutoSync:                    autoSync,
+		AzuredevopsToken:            forgedomain.ParseAzuredevopsToken(env.Get(azuredevopsToken)),
 		BitbucketAppPassword:        forgedomain.ParseBitbucketAppPassword(env.Get(bitbucketAppPassword)),
 		BitbucketUsername:           forgedomain.ParseBitbucketUsername(env.Get(bitbucketUserName)),
+		BranchOverrides:             configdomain.BranchOverrides{}, // not loaded from env vars
 		BranchPrefix:                branchPrefix,
 		BranchTypeOverrides:         configdomain.BranchTypeOverrides{}, // not loaded from env vars
 		Browser:                     browser,
@@ -174,9 +189,15 @@ func Load(env EnvVars) (configdomain.PartialConfig, error) {
 		PerennialRegex:              perennialRegex,
 		ProposalBreadcrumb:          proposalBreadcrumb,
 		ProposalBreadcrumbDirection: proposalBreadcrumbDirection,
+		ProposeAssignees:            gitdomain.ProposalMetadataNames{},
+		ProposeDraft:                proposeDraft,
+		ProposeLabels:               gitdomain.ProposalMetadataNames{},
+		ProposeReviewers:            gitdomain.ProposalMetadataNames{},
+		ProposeTemplate:             proposeTemplate,
 		PushBranches:                pushBranches,
 		PushHook:                    pushHook,
 		ShareNewBranches:            shareNewBranches,
+		ShipAPIMergeMethod:          shipAPIMergeMethod,
 		ShipDeleteTrackingBranch:    shipDeleteTrackingBranch,
 		ShipStrategy:                shipStrategy,
 		Stash:                       stash,
@@ -187,6 +208,7 @@ func Load(env EnvVars) (configdomain.PartialConfig, error) {
 		SyncUpstream:                syncUpstream,
 		UnknownBranchType:           configdomain.UnknownBranchTypeOpt(unknownBranchType),
 		Verbose:                     verbose,
+		WorktreeRoot:                worktreeRoot,
 	}, err
 }
 
@@ -41,6 +41,10 @@ func RemoveAutoSync(runner subshelldomain.Runner) error {
 	return RemoveConfigValue(runner, configdomain.ConfigScopeLocal, configdomain.KeyAutoSync)
 }
 
+func RemoveAzuredevopsToken(runner subshelldomain.Runner) error {
+	return RemoveConfigValue(runner, configdomain.ConfigScopeLocal, configdomain.KeyAzuredevopsToken)
+}
+
 func RemoveBitbucketAppPassword(runner subshelldomain.Runner) error {
 	return RemoveConfigValue(runner, configdomain.ConfigScopeLocal, configdomain.KeyBitbucketAppPassword)
 }
@@ -49,6 +53,11 @@ func RemoveBitbucketUsername(runner subshelldomain.Runner) error {
 	return RemoveConfigValue(runner, configdomain.ConfigScopeLocal, configdomain.KeyBitbucketUsername)
 }
 
+func RemoveBranchOverride(runner subshelldomain.Runner, branch gitdomain.LocalBranchName, setting configdomain.Key) error {
+	key := configdomain.NewBranchOverrideKey(branch, setting)
+	return RemoveConfigValue(runner, configdomain.ConfigScopeLocal, key.Key)
+}
+
 func RemoveBranchPrefix(runner subshelldomain.Runner) error {
 	return RemoveConfigValue(runner, configdomain.ConfigScopeLocal, configdomain.KeyBranchPrefix)
 }
@@ -202,6 +211,10 @@ func SetAutoSync(runner subshelldomain.Runner, value configdomain.AutoSync, scop
 	return SetConfigValue(runner, scope, configdomain.KeyAutoSync, value.String())
 }
 
+func SetAzuredevopsToken(runner subshelldomain.Runner, value forgedomain.AzuredevopsToken, scope configdomain.ConfigScope) error {
+	return SetConfigValue(runner, scope, configdomain.KeyAzuredevopsToken, value.String())
+}
+
 func SetBitbucketAppPassword(runner subshelldomain.Runner, value forgedomain.BitbucketAppPassword, scope configdomain.ConfigScope) error {
 	return SetConfigValue(runner, scope, configdomain.KeyBitbucketAppPassword, value.String())
 }
@@ -210,6 +223,11 @@ func SetBitbucketUsername(runner subshelldomain.Runner, value forgedomain.Bitbuc
 	return SetConfigValue(runner, scope, configdomain.KeyBitbucketUsername, value.String())
 }
 
+func SetBranchOverride(runner subshelldomain.Runner, branch gitdomain.LocalBranchName, setting configdomain.Key, value string) error {
+	key := configdomain.NewBranchOverrideKey(branch, setting)
+	return SetConfigValue(runner, configdomain.ConfigScopeLocal, key.Key, value)
+}
+
 func SetBranchPrefix(runner subshelldomain.Runner, value configdomain.BranchPrefix, scope configdomain.ConfigScope) error {
 	return SetConfigValue(runner, scope, configdomain.KeyBranchPrefix, value.String())
 }
@@ -32,8 +32,10 @@ type NormalConfig struct {
 	Aliases                     configdomain.Aliases
 	AutoResolve                 configdomain.AutoResolve
 	AutoSync                    configdomain.AutoSync
+	AzuredevopsToken            Option[forgedomain.AzuredevopsToken]
 	BitbucketAppPassword        Option[forgedomain.BitbucketAppPassword]
 	BitbucketUsername           Option[forgedomain.BitbucketUsername]
+	BranchOverrides             configdomain.BranchOverrides
 	BranchPrefix                Option[configdomain.BranchPrefix]
 	BranchTypeOverrides         configdomain.BranchTypeOverrides
 	Browser                     Option[configdomain.Browser]
@@ -64,9 +66,15 @@ type NormalConfig struct {
 	PerennialRegex              Option[configdomain.PerennialRegex]
 	ProposalBreadcrumb          configdomain.ProposalBreadcrumb
 	ProposalBreadcrumbDirection configdomain.ProposalBreadcrumbDirection
+	ProposeAssignees            gitdomain.ProposalMetadataNames
+	ProposeDraft                configdomain.ProposeDraft
+	ProposeLabels               gitdomain.ProposalMetadataNames
+	ProposeReviewers            gitdomain.ProposalMetadataNames
+	ProposeTemplate             Option[configdomain.ProposeTemplate]
 	PushBranches                configdomain.PushBranches
 	PushHook                    configdomain.PushHook
 	ShareNewBranches            configdomain.ShareNewBranches
+	ShipAPIMergeMethod          forgedomain.ProposalMergeMethod
 	ShipDeleteTrackingBranch    configdomain.ShipDeleteTrackingBranch
 	ShipStrategy                configdomain.ShipStrategy
 	Stash                       configdomain.Stash
@@ -77,6 +85,7 @@ type NormalConfig struct {
 	SyncUpstream                configdomain.SyncUpstream
 	UnknownBranchType           configdomain.UnknownBranchType
 	Verbose                     configdomain.Verbose
+	WorktreeRoot                Option[configdomain.WorktreeRoot]
 }
 
 // Author provides the locally Git configured user.
@@ -103,8 +112,10 @@ func (self *NormalConfig) OverwriteWith(other configdomain.PartialConfig) Normal
 		Aliases:                     other.Aliases,
 		AutoResolve:                 other.AutoResolve.GetOr(self.AutoResolve),
 		AutoSync:                    other.AutoSync.GetOr(self.AutoSync),
+		AzuredevopsToken:            other.AzuredevopsToken.Or(self.AzuredevopsToken),
 		BitbucketAppPassword:        other.BitbucketAppPassword.Or(self.BitbucketAppPassword),
 		BitbucketUsername:           other.BitbucketUsername.Or(self.BitbucketUsername),
+		BranchOverrides:             self.BranchOverrides.Concat(other.BranchOverrides),
 		BranchPrefix:                other.BranchPrefix.Or(self.BranchPrefix),
 		BranchTypeOverrides:         other.BranchTypeOverrides.Concat(self.BranchTypeOverrides),
 		Browser:                     other.Browser.Or(self.Browser),
@@ -135,9 +146,15 @@ func (self *NormalConfig) OverwriteWith(other configdomain.PartialConfig) Normal
 		PerennialRegex:              other.PerennialRegex.Or(self.PerennialRegex),
 		ProposalBreadcrumb:          other.ProposalBreadcrumb.GetOr(self.ProposalBreadcrumb),
 		ProposalBreadcrumbDirection: other.ProposalBreadcrumbDirection.GetOr(self.ProposalBreadcrumbDirection),
+		ProposeAssignees:            append(other.ProposeAssignees, self.ProposeAssignees...),
+		ProposeDraft:                other.ProposeDraft.GetOr(self.ProposeDraft),
+		ProposeLabels:               append(other.ProposeLabels, self.ProposeLabels...),
+		ProposeReviewers:            append(other.ProposeReviewers, self.ProposeReviewers...),
+		ProposeTemplate:             other.ProposeTemplate.Or(self.ProposeTemplate),
 		PushBranches:                other.PushBranches.GetOr(self.PushBranches),
 		PushHook:                    other.PushHook.GetOr(self.PushHook),
 		ShareNewBranches:            other.ShareNewBranches.GetOr(self.ShareNewBranches),
+		ShipAPIMergeMethod:          other.ShipAPIMergeMethod.GetOr(self.ShipAPIMergeMethod),
 		ShipDeleteTrackingBranch:    other.ShipDeleteTrackingBranch.GetOr(self.ShipDeleteTrackingBranch),
 		ShipStrategy:                other.ShipStrategy.GetOr(self.ShipStrategy),
 		Stash:                       other.Stash.GetOr(self.Stash),
@@ -148,6 +165,7 @@ func (self *NormalConfig) OverwriteWith(other configdomain.PartialConfig) Normal
 		SyncUpstream:                other.SyncUpstream.GetOr(self.SyncUpstream),
 		UnknownBranchType:           other.UnknownBranchType.GetOr(self.UnknownBranchType),
 		Verbose:                     other.Verbose.GetOr(self.Verbose),
+		WorktreeRoot:                other.WorktreeRoot.Or(self.WorktreeRoot),
 	}
 }
 
@@ -198,6 +216,11 @@ func (self *NormalConfig) PartialBranchesOfType(branchType configdomain.BranchTy
 	return matching.Values()
 }
 
+// PushBranchesFor provides the push-branches setting that applies to the given branch.
+func (self *NormalConfig) PushBranchesFor(branch gitdomain.LocalBranchName) configdomain.PushBranches {
+	return self.BranchOverrides.For(branch).PushBranches.GetOr(self.PushBranches)
+}
+
 // RemoteURL provides the URL for the given remote.
 // Tests can stub this through the GIT_TOWN_REMOTE environment variable.
 // Caches its result so can be called repeatedly.
@@ -252,13 +275,94 @@ func (self *NormalConfig) SetPerennialBranches(runner subshelldomain.Runner, bra
 	return err
 }
 
+// ShareNewBranchesFor provides the share-new-branches setting that applies to the given new branch.
+func (self *NormalConfig) ShareNewBranchesFor(branch gitdomain.LocalBranchName) configdomain.ShareNewBranches {
+	return self.BranchOverrides.For(branch).ShareNewBranches.GetOr(self.ShareNewBranches)
+}
+
+// ShipStrategyFor provides the ship-strategy setting that applies to the given branch.
+func (self *NormalConfig) ShipStrategyFor(branch gitdomain.LocalBranchName) configdomain.ShipStrategy {
+	return self.BranchOverrides.For(branch).ShipStrategy.GetOr(self.ShipStrategy)
+}
+
+// SyncFeatureStrategyFor provides the sync-feature-strategy setting that applies to the given branch.
+func (self *NormalConfig) SyncFeatureStrategyFor(branch gitdomain.LocalBranchName) configdomain.SyncFeatureStrategy {
+	return self.BranchOverrides.For(branch).SyncFeatureStrategy.GetOr(self.SyncFeatureStrategy)
+}
+
+// ToPartialConfig provides the data of this NormalConfig as a PartialConfig.
+func (self *NormalConfig) ToPartialConfig() configdomain.PartialConfig {
+	return configdomain.PartialConfig{
+		Aliases:                     self.Aliases,
+		AutoResolve:                 Some(self.AutoResolve),
+		AutoSync:                    Some(self.AutoSync),
+		AzuredevopsToken:            self.AzuredevopsToken,
+		BitbucketAppPassword:        self.BitbucketAppPassword,
+		BitbucketUsername:           self.BitbucketUsername,
+		BranchOverrides:             self.BranchOverrides,
+		BranchPrefix:                self.BranchPrefix,
+		BranchTypeOverrides:         self.BranchTypeOverrides,
+		Browser:                     self.Browser,
+		ContributionRegex:           self.ContributionRegex,
+		Detached:                    Some(self.Detached),
+		DevRemote:                   Some(self.DevRemote),
+		DisplayDialogs:              Some(self.DisplayDialogs),
+		DisplayTypes:                Some(self.DisplayTypes),
+		DryRun:                      Some(self.DryRun),
+		FeatureRegex:                self.FeatureRegex,
+		ForgeType:                   self.ForgeType,
+		ForgejoToken:                self.ForgejoToken,
+		GitUserEmail:                self.GitUserEmail,
+		GitUserName:                 self.GitUserName,
+		GiteaToken:                  self.GiteaToken,
+		GithubConnectorType:         self.GithubConnectorType,
+		GithubToken:                 self.GithubToken,
+		GitlabConnectorType:         self.GitlabConnectorType,
+		GitlabToken:                 self.GitlabToken,
+		HostingOriginHostname:       self.HostingOriginHostname,
+		IgnoreUncommitted:           Some(self.IgnoreUncommitted),
+		Lineage:                     self.Lineage,
+		MainBranch:                  None[gitdomain.LocalBranchName](),
+		NewBranchType:               self.NewBranchType,
+		ObservedRegex:               self.ObservedRegex,
+		Offline:                     Some(self.Offline),
+		Order:                       Some(self.Order),
+		PerennialBranches:           self.PerennialBranches,
+		PerennialRegex:              self.PerennialRegex,
+		ProposalBreadcrumb:          Some(self.ProposalBreadcrumb),
+		ProposalBreadcrumbDirection: Some(self.ProposalBreadcrumbDirection),
+		ProposeAssignees:            self.ProposeAssignees,
+		ProposeDraft:                Some(self.ProposeDraft),
+		ProposeLabels:               self.ProposeLabels,
+		ProposeReviewers:            self.ProposeReviewers,
+		ProposeTemplate:             self.ProposeTemplate,
+		PushBranches:                Some(self.PushBranches),
+		PushHook:                    Some(self.PushHook),
+		ShareNewBranches:            Some(self.ShareNewBranches),
+		ShipAPIMergeMethod:          Some(self.ShipAPIMergeMethod),
+		ShipDeleteTrackingBranch:    Some(self.ShipDeleteTrackingBranch),
+		ShipStrategy:                Some(self.ShipStrategy),
+		Stash:                       Some(self.Stash),
+		SyncFeatureStrategy:         Some(self.SyncFeatureStrategy),
+		SyncPerennialStrategy:       Some(self.SyncPerennialStrategy),
+		SyncPrototypeStrategy:       Some(self.SyncPrototypeStrategy),
+		SyncTags:                    Some(self.SyncTags),
+		SyncUpstream:                Some(self.SyncUpstream),
+		UnknownBranchType:           Some(self.UnknownBranchType),
+		Verbose:                     Some(self.Verbose),
+		WorktreeRoot:                self.WorktreeRoot,
+	}
+}
+
 func DefaultNormalConfig() NormalConfig {
 	return NormalConfig{
 		Aliases:              configdomain.Aliases{},
 		AutoResolve:          true,
 		AutoSync:             true,
+		AzuredevopsToken:     None[forgedomain.AzuredevopsToken](),
 		BitbucketAppPassword: None[forgedomain.BitbucketAppPassword](),
 		BitbucketUsername:    None[forgedomain.BitbucketUsername](),
+		BranchOverrides:      configdomain.BranchOverrides{},
 		BranchPrefix:         None[configdomain.BranchPrefix](),
 		BranchTypeOverrides:  configdomain.BranchTypeOverrides{},
 		Browser:              None[configdomain.Browser](),
@@ -292,9 +396,15 @@ func DefaultNormalConfig() NormalConfig {
 		PerennialRegex:              None[configdomain.PerennialRegex](),
 		ProposalBreadcrumb:          configdomain.ProposalBreadcrumbNone,
 		ProposalBreadcrumbDirection: configdomain.ProposalBreadcrumbDirectionDown,
+		ProposeAssignees:            gitdomain.ProposalMetadataNames{},
+		ProposeDraft:                false,
+		ProposeLabels:               gitdomain.ProposalMetadataNames{},
+		ProposeReviewers:            gitdomain.ProposalMetadataNames{},
+		ProposeTemplate:             None[configdomain.ProposeTemplate](),
 		PushBranches:                true,
 		PushHook:                    true,
 		ShareNewBranches:            configdomain.ShareNewBranchesNone,
+		ShipAPIMergeMethod:          forgedomain.ProposalMergeMethodSquash,
 		ShipDeleteTrackingBranch:    true,
 		ShipStrategy:                configdomain.ShipStrategyAPI,
 		Stash:                       true,
@@ -305,6 +415,7 @@ func DefaultNormalConfig() NormalConfig {
 		SyncUpstream:                true,
 		UnknownBranchType:           configdomain.UnknownBranchType(configdomain.BranchTypeFeatureBranch),
 		Verbose:                     false,
+		WorktreeRoot:                None[configdomain.WorktreeRoot](),
 	}
 }
 
@@ -315,8 +426,10 @@ func NewNormalConfigFromPartial(partial configdomain.PartialConfig, defaults Nor
 		Aliases:                     partial.Aliases,
 		AutoResolve:                 partial.AutoResolve.GetOr(defaults.AutoResolve),
 		AutoSync:                    partial.AutoSync.GetOr(defaults.AutoSync),
+		AzuredevopsToken:            partial.AzuredevopsToken,
 		BitbucketAppPassword:        partial.BitbucketAppPassword,
 		BitbucketUsername:           partial.BitbucketUsername,
+		BranchOverrides:             partial.BranchOverrides,
 		BranchPrefix:                partial.BranchPrefix,
 		BranchTypeOverrides:         partial.BranchTypeOverrides,
 		Browser:                     partial.Browser.Or(defaults.Browser),
@@ -347,9 +460,15 @@ func NewNormalConfigFromPartial(partial configdomain.PartialConfig, defaults Nor
 		PerennialRegex:              partial.PerennialRegex,
 		ProposalBreadcrumb:          partial.ProposalBreadcrumb.GetOr(defaults.ProposalBreadcrumb),
 		ProposalBreadcrumbDirection: proposalBreadcrumbDirection,
+		ProposeAssignees:            partial.ProposeAssignees,
+		ProposeDraft:                partial.ProposeDraft.GetOr(defaults.ProposeDraft),
+		ProposeLabels:               partial.ProposeLabels,
+		ProposeReviewers:            partial.ProposeReviewers,
+		ProposeTemplate:             partial.ProposeTemplate,
 		PushBranches:                partial.PushBranches.GetOr(defaults.PushBranches),
 		PushHook:                    partial.PushHook.GetOr(defaults.PushHook),
 		ShareNewBranches:            partial.ShareNewBranches.GetOr(defaults.ShareNewBranches),
+		ShipAPIMergeMethod:          partial.ShipAPIMergeMethod.GetOr(defaults.ShipAPIMergeMethod),
 		ShipDeleteTrackingBranch:    partial.ShipDeleteTrackingBranch.GetOr(defaults.ShipDeleteTrackingBranch),
 		ShipStrategy:                partial.ShipStrategy.GetOr(defaults.ShipStrategy),
 		Stash:                       partial.Stash.GetOr(defaults.Stash),
@@ -360,6 +479,7 @@ func NewNormalConfigFromPartial(partial configdomain.PartialConfig, defaults Nor
 		SyncUpstream:                partial.SyncUpstream.GetOr(defaults.SyncUpstream),
 		UnknownBranchType:           partial.UnknownBranchType.GetOr(configdomain.UnknownBranchType(configdomain.BranchTypeFeatureBranch)),
 		Verbose:                     partial.Verbose.GetOr(defaults.Verbose),
+		WorktreeRoot:                partial.WorktreeRoot,
 	}
 }
 
@@ -16,6 +16,34 @@ import (
 	. "github.com/git-town/git-town/v22/pkg/prelude"
 )
 
+// NewBranchOverridesInSnapshot provides the per-branch settings stored in the given Git metadata snapshot.
+func NewBranchOverridesInSnapshot(snapshot configdomain.SingleSnapshot, ignoreUnknown bool) (configdomain.BranchOverrides, error) {
+	result := configdomain.BranchOverrides{}
+	for key, value := range snapshot { // okay to iterate the map in random order because each key sets a different value
+		overrideKey, isOverrideKey := configdomain.ParseBranchOverrideKey(key).Get()
+		if !isOverrideKey {
+			continue
+		}
+		pattern := configdomain.BranchPattern(overrideKey.Branch())
+		override, hasOverride := result[pattern]
+		if !hasOverride {
+			override = configdomain.EmptyBranchOverride()
+		}
+		override, err := override.Set(overrideKey.Setting(), strings.TrimSpace(value), key.String())
+		if err != nil {
+			if ignoreUnknown {
+				fmt.Printf("Ignoring invalid value for %q: %q\n", key, value)
+				continue
+			}
+			return result, err
+		}
+		if !override.IsEmpty() {
+			result[pattern] = override
+		}
+	}
+	return result, nil
+}
+
 // NewBranchTypeOverridesInSnapshot provides the branch type overrides stored in the given Git metadata snapshot.
 func NewBranchTypeOverridesInSnapshot(snapshot configdomain.SingleSnapshot, ignoreUnknown bool, runner subshelldomain.Runner) (configdomain.BranchTypeOverrides, error) {
 	result := configdomain.BranchTypeOverrides{}
@@ -84,6 +112,7 @@ func NewPartialConfigFromSnapshot(snapshot configdomain.SingleSnapshot, updateOu
 	// TODO: add keep-sorted to all blocks in this function
 	autoResolve, errAutoResolve := load(snapshot, configdomain.KeyAutoResolve, gohacks.ParseBoolOpt[configdomain.AutoResolve], ignoreUnknown)
 	autoSync, errAutoSync := load(snapshot, configdomain.KeyAutoSync, gohacks.ParseBoolOpt[configdomain.AutoSync], ignoreUnknown)
+	branchOverrides, errBranchOverrides := NewBranchOverridesInSnapshot(snapshot, ignoreUnknown)
 	branchPrefix, errBranchPrefix := load(snapshot, configdomain.KeyBranchPrefix, configdomain.ParseBranchPrefix, ignoreUnknown)
 	branchTypeOverrides, errBranchTypeOverride := NewBranchTypeOverridesInSnapshot(snapshot, ignoreUnknown, runner)
 	browser, errBrowser := load(snapshot, configdomain.KeyBrowser, configdomain.ParseBrowser, ignoreUnknown)
@@ -104,9 +133,12 @@ func NewPartialConfigFromSnapshot(snapshot configdomain.SingleSnapshot, updateOu
 	perennialRegex, errPerennialRegex := load(snapshot, configdomain.KeyPerennialRegex, configdomain.ParsePerennialRegex, ignoreUnknown)
 	proposalBreadcrumb, errProposalBreadcrumb := load(snapshot, configdomain.KeyProposalBreadcrumb, configdomain.ParseProposalBreadcrumb, ignoreUnknown)
 	proposalBreadcrumbDirection, errProposalBreadcrumbDirection := load(snapshot, configdomain.KeyProposalBreadcrumbDirection, configdomain.ParseProposalBreadcrumbDirection, ignoreUnknown)
+	proposeDraft, errProposeDraft := load(snapshot, configdomain.KeyProposeDraft, gohacks.ParseBoolOpt[configdomain.ProposeDraft], ignoreUnknown)
+	proposeTemplate, errProposeTemplate := load(snapshot, configdomain.KeyProposeTemplate, configdomain.ParseProposeTemplate, ignoreUnknown)
 	pushBranches, errPushBranches := load(snapshot, configdomain.KeyPushBranches, gohacks.ParseBoolOpt[configdomain.PushBranches], ignoreUnknown)
 	pushHook, errPushHook := load(snapshot, configdomain.KeyPushHook, gohacks.ParseBoolOpt[configdomain.PushHook], ignoreUnknown)
 	shareNewBranches, errShareNewBranches := load(snapshot, configdomain.KeyShareNewBranches, configdomain.ParseShareNewBranches, ignoreUnknown)
+	shipAPIMergeMethod, errShipAPIMergeMethod := load(snapshot, configdomain.KeyShipAPIMergeMethod, forgedomain.ParseProposalMergeMethod, ignoreUnknown)
 	shipDeleteTrackingBranch, errShipDeleteTrackingBranch := load(snapshot, configdomain.KeyShipDeleteTrackingBranch, gohacks.ParseBoolOpt[configdomain.ShipDeleteTrackingBranch], ignoreUnknown)
 	shipStrategy, errShipStrategy := load(snapshot, configdomain.KeyShipStrategy, configdomain.ParseShipStrategy, ignoreUnknown)
 	stash, errStash := load(snapshot, configdomain.KeyStash, gohacks.ParseBoolOpt[configdomain.Stash], ignoreUnknown)
@@ -117,9 +149,11 @@ func NewPartialConfigFromSnapshot(snapshot configdomain.SingleSnapshot, updateOu
 	syncUpstream, errSyncUpstream := load(snapshot, configdomain.KeySyncUpstream, gohacks.ParseBoolOpt[configdomain.SyncUpstream], ignoreUnknown)
 	unknownBranchTypeValue, errUnknownBranchType := load(snapshot, configdomain.KeyUnknownBranchType, configdomain.ParseBranchType, ignoreUnknown)
 	unknownBranchType := configdomain.UnknownBranchTypeOpt(unknownBranchTypeValue)
+	worktreeRoot, errWorktreeRoot := load(snapshot, configdomain.KeyWorktreeRoot, configdomain.ParseWorktreeRoot, ignoreUnknown)
 	err := cmp.Or(
 		errAutoResolve,
 		errAutoSync,
+		errBranchOverrides,
 		errBranchPrefix,
 		errBranchTypeOverride,
 		errBrowser,
@@ -139,9 +173,12 @@ func NewPartialConfigFromSnapshot(snapshot configdomain.SingleSnapshot, updateOu
 		errPerennialRegex,
 		errProposalBreadcrumb,
 		errProposalBreadcrumbDirection,
+		errProposeDraft,
+		errProposeTemplate,
 		errPushBranches,
 		errPushHook,
 		errShareNewBranches,
+		errShipAPIMergeMethod,
 		errShipDeleteTrackingBranch,
 		errShipStrategy,
 		errStash,
@@ -151,13 +188,16 @@ func NewPartialConfigFromSnapshot(snapshot configdomain.SingleSnapshot, updateOu
 		errSyncTags,
 		errSyncUpstream,
 		errUnknownBranchType,
+		errWorktreeRoot,
 	)
 	return configdomain.PartialConfig{
 		Aliases:                     snapshot.Aliases(),
 		AutoResolve:                 autoResolve,
 		AutoSync:                    autoSync,
+		AzuredevopsToken:            forgedomain.ParseAzuredevopsToken(snapshot[configdomain.KeyAzuredevopsToken]),
 		BitbucketAppPassword:        forgedomain.ParseBitbucketAppPassword(snapshot[configdomain.KeyBitbucketAppPassword]),
 		BitbucketUsername:           forgedomain.ParseBitbucketUsername(snapshot[configdomain.KeyBitbucketUsername]),
+		BranchOverrides:             branchOverrides,
 		BranchPrefix:                branchPrefix,
 		BranchTypeOverrides:         branchTypeOverrides,
 		Browser:                     browser,
@@ -189,9 +229,15 @@ func NewPartialConfigFromSnapshot(snapshot configdomain.SingleSnapshot, updateOu
 		PerennialRegex:              perennialRegex,
 		ProposalBreadcrumb:          proposalBreadcrumb,
 		ProposalBreadcrumbDirection: proposalBreadcrumbDirection,
+		ProposeAssignees:            gitdomain.ProposalMetadataNames{},
+		ProposeDraft:                proposeDraft,
+		ProposeLabels:               gitdomain.ProposalMetadataNames{},
+		ProposeReviewers:            gitdomain.ProposalMetadataNames{},
+		ProposeTemplate:             proposeTemplate,
 		PushBranches:                pushBranches,
 		PushHook:                    pushHook,
 		ShareNewBranches:            shareNewBranches,
+		ShipAPIMergeMethod:          shipAPIMergeMethod,
 		ShipDeleteTrackingBranch:    shipDeleteTrackingBranch,
 		ShipStrategy:                shipStrategy,
 		Stash:                       stash,
@@ -202,6 +248,7 @@ func NewPartialConfigFromSnapshot(snapshot configdomain.SingleSnapshot, updateOu
 		SyncUpstream:                syncUpstream,
 		UnknownBranchType:           unknownBranchType,
 		Verbose:                     None[configdomain.Verbose](),
+		WorktreeRoot:                worktreeRoot,
 	}, err
 }
 
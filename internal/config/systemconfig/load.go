@@ -14,8 +14,10 @@ func Load() configdomain.PartialConfig {
 		Aliases:                     configdomain.Aliases{},
 		AutoResolve:                 None[configdomain.AutoResolve](),
 		AutoSync:                    None[configdomain.AutoSync](),
+		AzuredevopsToken:            None[forgedomain.AzuredevopsToken](),
 		BitbucketAppPassword:        None[forgedomain.BitbucketAppPassword](),
 		BitbucketUsername:           None[forgedomain.BitbucketUsername](),
+		BranchOverrides:             configdomain.BranchOverrides{},
 		BranchPrefix:                None[configdomain.BranchPrefix](),
 		BranchTypeOverrides:         configdomain.BranchTypeOverrides{},
 		Browser:                     None[configdomain.Browser](),
@@ -47,9 +49,15 @@ func Load() configdomain.PartialConfig {
 		PerennialRegex:              None[configdomain.PerennialRegex](),
 		ProposalBreadcrumb:          None[configdomain.ProposalBreadcrumb](),
 		ProposalBreadcrumbDirection: None[configdomain.ProposalBreadcrumbDirection](),
+		ProposeAssignees:            gitdomain.ProposalMetadataNames{},
+		ProposeDraft:                None[configdomain.ProposeDraft](),
+		ProposeLabels:               gitdomain.ProposalMetadataNames{},
+		ProposeReviewers:            gitdomain.ProposalMetadataNames{},
+		ProposeTemplate:             None[configdomain.ProposeTemplate](),
 		PushBranches:                None[configdomain.PushBranches](),
 		PushHook:                    None[configdomain.PushHook](),
 		ShareNewBranches:            None[configdomain.ShareNewBranches](),
+		ShipAPIMergeMethod:          None[forgedomain.ProposalMergeMethod](),
 		ShipDeleteTrackingBranch:    None[configdomain.ShipDeleteTrackingBranch](),
 		ShipStrategy:                None[configdomain.ShipStrategy](),
 		Stash:                       None[configdomain.Stash](),
@@ -60,5 +68,6 @@ func Load() configdomain.PartialConfig {
 		SyncUpstream:                None[configdomain.SyncUpstream](),
 		UnknownBranchType:           None[configdomain.UnknownBranchType](),
 		Verbose:                     None[configdomain.Verbose](),
+		WorktreeRoot:                None[configdomain.WorktreeRoot](),
 	}
 }
@@ -16,7 +16,8 @@ type UnvalidatedConfig struct {
 	CLI               configdomain.PartialConfig // configuration received via CLI flags
 	Defaults          NormalConfig               // default values
 	Env               configdomain.PartialConfig // environment variables
-	File              configdomain.PartialConfig // content of git-town.toml
+	File              configdomain.PartialConfig // content of the git-town.toml file in the repository root
+	Files             configdomain.ConfigFiles   // all configuration files, ordered from lowest to highest precedence
 	GitGlobal         configdomain.PartialConfig // global Git metadata
 	GitLocal          configdomain.PartialConfig // local Git metadata
 	GitUnscoped       configdomain.PartialConfig // unscoped Git metadata
@@ -36,6 +37,25 @@ func (self *UnvalidatedConfig) IsMainOrPerennialBranch(branch gitdomain.LocalBra
 	return branchType == configdomain.BranchTypeMainBranch || branchType == configdomain.BranchTypePerennialBranch
 }
 
+// Lookup provides the effective value of the setting with the given key
+// and the configuration source that this value comes from.
+func (self *UnvalidatedConfig) Lookup(key configdomain.Key) (Option[string], configdomain.ConfigOrigin) {
+	sources := []configSource{
+		{config: self.CLI, origin: configdomain.ConfigOriginCLI},
+		{config: self.Env, origin: configdomain.ConfigOriginEnv},
+		{config: self.GitLocal, origin: configdomain.ConfigOriginLocal},
+		{config: self.GitGlobal, origin: configdomain.ConfigOriginGlobal},
+		{config: self.Files.Merge(), origin: configdomain.ConfigOriginFile},
+		{config: self.SystemConfig, origin: configdomain.ConfigOriginSystem},
+	}
+	for _, source := range sources {
+		if value, hasValue := source.config.Value(key).Get(); hasValue {
+			return Some(value), source.origin
+		}
+	}
+	return self.NormalConfig.ToPartialConfig().Value(key), configdomain.ConfigOriginDefault
+}
+
 func (self *UnvalidatedConfig) MainAndPerennials() gitdomain.LocalBranchNames {
 	if mainBranch, hasMainBranch := self.UnvalidatedConfig.MainBranch.Get(); hasMainBranch {
 		return append(gitdomain.LocalBranchNames{mainBranch}, self.NormalConfig.PerennialBranches...)
@@ -53,7 +73,7 @@ func (self *UnvalidatedConfig) Reload(backend subshelldomain.RunnerQuerier) conf
 		cli:      configdomain.EmptyPartialConfig(),
 		defaults: DefaultNormalConfig(),
 		env:      envConfig,
-		file:     self.File,
+		file:     self.Files.Merge(),
 		git:      unscopedGitConfig,
 		system:   self.SystemConfig,
 	})
@@ -90,7 +110,7 @@ func NewUnvalidatedConfig(args NewUnvalidatedConfigArgs) UnvalidatedConfig {
 		cli:      args.CliConfig,
 		defaults: args.Defaults,
 		env:      args.EnvConfig,
-		file:     args.ConfigFile,
+		file:     args.ConfigFiles.Merge(),
 		git:      args.GitUnscoped,
 		system:   args.SystemConfig,
 	})
@@ -98,7 +118,8 @@ func NewUnvalidatedConfig(args NewUnvalidatedConfigArgs) UnvalidatedConfig {
 		CLI:               args.CliConfig,
 		Defaults:          args.Defaults,
 		Env:               args.EnvConfig,
-		File:              args.ConfigFile,
+		File:              args.ConfigFiles.Repo(),
+		Files:             args.ConfigFiles,
 		GitGlobal:         args.GitGlobal,
 		GitLocal:          args.GitLocal,
 		GitUnscoped:       args.GitUnscoped,
@@ -110,7 +131,7 @@ func NewUnvalidatedConfig(args NewUnvalidatedConfigArgs) UnvalidatedConfig {
 
 type NewUnvalidatedConfigArgs struct {
 	CliConfig     configdomain.PartialConfig
-	ConfigFile    configdomain.PartialConfig
+	ConfigFiles   configdomain.ConfigFiles
 	Defaults      NormalConfig
 	EnvConfig     configdomain.PartialConfig
 	FinalMessages stringslice.Collector
@@ -127,14 +148,26 @@ func mergeConfigs(args mergeConfigsArgs) (configdomain.UnvalidatedConfigData, No
 	result = result.Merge(args.git)
 	result = result.Merge(args.env)
 	result = result.Merge(args.cli)
+	// settings provided via CLI flags apply to all branches
+	for _, key := range configdomain.BranchOverridableKeys {
+		if args.cli.Value(key).IsSome() {
+			result.BranchOverrides = result.BranchOverrides.Without(key)
+		}
+	}
 	return result.ToUnvalidatedConfig(), NewNormalConfigFromPartial(result, args.defaults)
 }
 
+// configSource is a configuration source that Lookup consults.
+type configSource struct {
+	config configdomain.PartialConfig
+	origin configdomain.ConfigOrigin
+}
+
 type mergeConfigsArgs struct {
 	cli      configdomain.PartialConfig
 	defaults NormalConfig
 	env      configdomain.PartialConfig // configuration data taken from environment variables
-	file     configdomain.PartialConfig // data of the configuration file
+	file     configdomain.PartialConfig // data of the configuration files
 	git      configdomain.PartialConfig
 	system   configdomain.PartialConfig
 }
@@ -3,15 +3,109 @@ package config_test
 import (
 	"testing"
 
+	"github.com/git-town/git-town/v22/internal/config"
 	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/config/configfile"
 	"github.com/git-town/git-town/v22/internal/config/gitconfig"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/gohacks/stringslice"
 	"github.com/git-town/git-town/v22/internal/test/testruntime"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
 	"github.com/shoenig/test/must"
 )
 
 func TestUnvalidatedConfig(t *testing.T) {
 	t.Parallel()
 
+	t.Run("Lookup", func(t *testing.T) {
+		t.Parallel()
+		newConfig := func(env, file, local configdomain.PartialConfig) config.UnvalidatedConfig {
+			return config.NewUnvalidatedConfig(config.NewUnvalidatedConfigArgs{
+				CliConfig:     configdomain.EmptyPartialConfig(),
+				ConfigFiles:   configdomain.ConfigFiles{{Config: file, Kind: configdomain.ConfigFileKindRepo, Path: configfile.FileName}},
+				Defaults:      config.DefaultNormalConfig(),
+				EnvConfig:     env,
+				FinalMessages: stringslice.NewCollector(),
+				GitGlobal:     configdomain.EmptyPartialConfig(),
+				GitLocal:      local,
+				GitUnscoped:   local,
+				SystemConfig:  configdomain.EmptyPartialConfig(),
+			})
+		}
+		t.Run("default value", func(t *testing.T) {
+			t.Parallel()
+			unvalidatedConfig := newConfig(configdomain.EmptyPartialConfig(), configdomain.EmptyPartialConfig(), configdomain.EmptyPartialConfig())
+			value, origin := unvalidatedConfig.Lookup(configdomain.KeySyncFeatureStrategy)
+			must.Eq(t, Some("merge"), value)
+			must.EqOp(t, configdomain.ConfigOriginDefault, origin)
+		})
+		t.Run("no value", func(t *testing.T) {
+			t.Parallel()
+			unvalidatedConfig := newConfig(configdomain.EmptyPartialConfig(), configdomain.EmptyPartialConfig(), configdomain.EmptyPartialConfig())
+			value, origin := unvalidatedConfig.Lookup(configdomain.KeyGithubToken)
+			must.Eq(t, None[string](), value)
+			must.EqOp(t, configdomain.ConfigOriginDefault, origin)
+		})
+		t.Run("environment variable overrides local Git metadata and config file", func(t *testing.T) {
+			t.Parallel()
+			env := configdomain.EmptyPartialConfig()
+			env.SyncFeatureStrategy = Some(configdomain.SyncFeatureStrategyCompress)
+			file := configdomain.EmptyPartialConfig()
+			file.SyncFeatureStrategy = Some(configdomain.SyncFeatureStrategyMerge)
+			local := configdomain.EmptyPartialConfig()
+			local.SyncFeatureStrategy = Some(configdomain.SyncFeatureStrategyRebase)
+			unvalidatedConfig := newConfig(env, file, local)
+			value, origin := unvalidatedConfig.Lookup(configdomain.KeySyncFeatureStrategy)
+			must.Eq(t, Some("compress"), value)
+			must.EqOp(t, configdomain.ConfigOriginEnv, origin)
+		})
+		t.Run("local Git metadata overrides config file", func(t *testing.T) {
+			t.Parallel()
+			file := configdomain.EmptyPartialConfig()
+			file.SyncFeatureStrategy = Some(configdomain.SyncFeatureStrategyMerge)
+			local := configdomain.EmptyPartialConfig()
+			local.SyncFeatureStrategy = Some(configdomain.SyncFeatureStrategyRebase)
+			unvalidatedConfig := newConfig(configdomain.EmptyPartialConfig(), file, local)
+			value, origin := unvalidatedConfig.Lookup(configdomain.KeySyncFeatureStrategy)
+			must.Eq(t, Some("rebase"), value)
+			must.EqOp(t, configdomain.ConfigOriginLocal, origin)
+		})
+		t.Run("config file", func(t *testing.T) {
+			t.Parallel()
+			file := configdomain.EmptyPartialConfig()
+			file.PerennialBranches = gitdomain.NewLocalBranchNames("qa", "staging")
+			unvalidatedConfig := newConfig(configdomain.EmptyPartialConfig(), file, configdomain.EmptyPartialConfig())
+			value, origin := unvalidatedConfig.Lookup(configdomain.KeyPerennialBranches)
+			must.Eq(t, Some("qa staging"), value)
+			must.EqOp(t, configdomain.ConfigOriginFile, origin)
+		})
+		t.Run("config file overrides system config", func(t *testing.T) {
+			t.Parallel()
+			file := configdomain.EmptyPartialConfig()
+			file.Browser = Some(configdomain.Browser("chrome"))
+			system := configdomain.EmptyPartialConfig()
+			system.Browser = Some(configdomain.Browser("firefox"))
+			system.Order = Some(configdomain.OrderDesc)
+			unvalidatedConfig := config.NewUnvalidatedConfig(config.NewUnvalidatedConfigArgs{
+				CliConfig:     configdomain.EmptyPartialConfig(),
+				ConfigFiles:   configdomain.ConfigFiles{{Config: file, Kind: configdomain.ConfigFileKindRepo, Path: configfile.FileName}},
+				Defaults:      config.DefaultNormalConfig(),
+				EnvConfig:     configdomain.EmptyPartialConfig(),
+				FinalMessages: stringslice.NewCollector(),
+				GitGlobal:     configdomain.EmptyPartialConfig(),
+				GitLocal:      configdomain.EmptyPartialConfig(),
+				GitUnscoped:   configdomain.EmptyPartialConfig(),
+				SystemConfig:  system,
+			})
+			value, origin := unvalidatedConfig.Lookup(configdomain.KeyBrowser)
+			must.Eq(t, Some("chrome"), value)
+			must.EqOp(t, configdomain.ConfigOriginFile, origin)
+			value, origin = unvalidatedConfig.Lookup(configdomain.KeyOrder)
+			must.Eq(t, Some("desc"), value)
+			must.EqOp(t, configdomain.ConfigOriginSystem, origin)
+		})
+	})
+
 	t.Run("Reload", func(t *testing.T) {
 		t.Parallel()
 		t.Run("lineage changed", func(t *testing.T) {
@@ -85,26 +85,27 @@ func OpenRepo(args OpenRepoArgs) (OpenRepoResult, error) {
 		Unscoped: unscopedSnapshot,
 	}
 	finalMessages := stringslice.NewCollector()
-	configFile, hasConfigFile, err := configfile.Load(rootDir, configfile.FileName, finalMessages)
+	userConfigDir, err := cli.SystemUserConfigDir()
 	if err != nil {
-		return emptyOpenRepoResult(), err
+		return emptyOpenRepoResult(), fmt.Errorf(messages.ConfigDirUserCannotDetermine, err)
 	}
-	if !hasConfigFile {
-		configFile, hasConfigFile, err = configfile.Load(rootDir, configfile.HiddenFileName, finalMessages)
-		if err != nil {
-			return emptyOpenRepoResult(), err
-		}
+	workingDir, err := os.Getwd()
+	if err != nil {
+		return emptyOpenRepoResult(), errors.New(messages.DirCurrentProblem)
 	}
-	if !hasConfigFile {
-		configFile, _, err = configfile.Load(rootDir, configfile.AlternativeFileName, finalMessages)
-		if err != nil {
-			return emptyOpenRepoResult(), err
-		}
+	configFiles, err := configfile.LoadAll(configfile.LoadAllArgs{
+		FinalMessages: finalMessages,
+		RootDir:       rootDir,
+		UserConfigDir: userConfigDir,
+		WorkingDir:    workingDir,
+	})
+	if err != nil {
+		return emptyOpenRepoResult(), err
 	}
 	systemConfig := systemconfig.Load()
 	unvalidatedConfig := config.NewUnvalidatedConfig(config.NewUnvalidatedConfigArgs{
 		CliConfig:     args.CliConfig,
-		ConfigFile:    configFile,
+		ConfigFiles:   configFiles,
 		Defaults:      defaultConfig,
 		EnvConfig:     envConfig,
 		FinalMessages: finalMessages,
@@ -141,22 +142,13 @@ func OpenRepo(args OpenRepoArgs) (OpenRepoResult, error) {
 		return emptyOpenRepoResult(), errors.New(messages.OfflineNotAllowed)
 	}
 	if args.ValidateGitRepo {
-		var currentDirectory string
-		currentDirectory, err = os.Getwd()
-		if err != nil {
-			return emptyOpenRepoResult(), errors.New(messages.DirCurrentProblem)
-		}
-		if currentDirectory != rootDir.String() {
+		if workingDir != rootDir.String() {
 			err = gitCommands.ChangeDir(rootDir)
 			if err != nil {
 				return emptyOpenRepoResult(), err
 			}
 		}
 	}
-	userConfigDir, err := cli.SystemUserConfigDir()
-	if err != nil {
-		return emptyOpenRepoResult(), fmt.Errorf(messages.ConfigDirUserCannotDetermine, err)
-	}
 	repoConfigDir := userConfigDir.RepoConfigDir(rootDir)
 	return OpenRepoResult{
 		Backend:           backendRunner,
@@ -211,6 +203,7 @@ func newFrontendRunner(args newFrontendRunnerArgs) subshelldomain.Runner { //nol
 	}
 	return &subshell.FrontendRunner{
 		Backend:          args.backend,
+		Dir:              None[string](),
 		GetCurrentBranch: args.getCurrentBranch,
 		GetCurrentSHA:    args.getCurrentSHA,
 		PrintBranchNames: args.printBranchNames,
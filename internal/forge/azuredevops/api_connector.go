@@ -0,0 +1,247 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/carlmjohnson/requests"
+	"github.com/git-town/git-town/v22/internal/cli/print"
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/pkg/colors"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// the version of the Azure DevOps REST API that this connector talks to
+const apiVersion = "7.1"
+
+// type checks
+var (
+	apiConnector APIConnector
+	_            forgedomain.Connector = apiConnector
+)
+
+// APIConnector provides access to the Azure DevOps API.
+type APIConnector struct {
+	WebConnector
+	log   print.Logger
+	token forgedomain.AzuredevopsToken
+}
+
+// ============================================================================
+// find proposals
+// ============================================================================
+
+var _ forgedomain.ProposalFinder = apiConnector // type check
+
+func (self APIConnector) FindProposal(branch, target gitdomain.LocalBranchName) (Option[forgedomain.Proposal], error) {
+	self.log.Start(messages.APIProposalFindStart, branch, target)
+	var resp PullRequestResponse
+	err := self.request(self.pullRequestsURL()).
+		Param("searchCriteria.sourceRefName", branchRef(branch)).
+		Param("searchCriteria.targetRefName", branchRef(target)).
+		Param("searchCriteria.status", "active").
+		ToJSON(&resp).
+		Fetch(context.Background())
+	if err != nil {
+		self.log.Failed(err.Error())
+		return None[forgedomain.Proposal](), err
+	}
+	switch len(resp.Value) {
+	case 0:
+		self.log.Success("none")
+		return None[forgedomain.Proposal](), nil
+	case 1:
+		proposalData := parsePullRequest(resp.Value[0], self.RepositoryURL())
+		self.log.Log(fmt.Sprintf("%s (%s)", colors.BoldGreen().Styled("#"+proposalData.Number.String()), proposalData.Title))
+		return Some(forgedomain.Proposal{Data: proposalData, ForgeType: forgedomain.ForgeTypeAzuredevops}), nil
+	default:
+		return None[forgedomain.Proposal](), fmt.Errorf(messages.ProposalMultipleFromToFound, len(resp.Value), branch, target)
+	}
+}
+
+// ============================================================================
+// search proposals
+// ============================================================================
+
+var _ forgedomain.ProposalSearcher = apiConnector // type check
+
+func (self APIConnector) SearchProposals(branch gitdomain.LocalBranchName) ([]forgedomain.Proposal, error) {
+	self.log.Start(messages.APIProposalSearchStart, branch.String())
+	var resp PullRequestResponse
+	err := self.request(self.pullRequestsURL()).
+		Param("searchCriteria.sourceRefName", branchRef(branch)).
+		Param("searchCriteria.status", "active").
+		ToJSON(&resp).
+		Fetch(context.Background())
+	if err != nil {
+		self.log.Failed(err.Error())
+		return []forgedomain.Proposal{}, err
+	}
+	result := make([]forgedomain.Proposal, len(resp.Value))
+	ids := make([]string, len(resp.Value))
+	for p, pullRequest := range resp.Value {
+		proposalData := parsePullRequest(pullRequest, self.RepositoryURL())
+		result[p] = forgedomain.Proposal{Data: proposalData, ForgeType: forgedomain.ForgeTypeAzuredevops}
+		ids[p] = colors.BoldGreen().Styled("#" + proposalData.Number.String())
+	}
+	if len(result) == 0 {
+		self.log.Success("none")
+	} else {
+		self.log.Log(strings.Join(ids, ", "))
+	}
+	return result, nil
+}
+
+// ============================================================================
+// merge proposals
+// ============================================================================
+
+var _ forgedomain.ProposalMerger = apiConnector // type check
+
+func (self APIConnector) MergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message gitdomain.CommitMessage) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.ForgeAzuredevopsMergingViaAPI, colors.BoldGreen().Styled("#"+number.String()))
+	// Azure DevOps only completes a pull request if we tell it the commit we expect to merge
+	var pullRequest PullRequest
+	err := self.request(self.pullRequestURL(number)).
+		ToJSON(&pullRequest).
+		Fetch(context.Background())
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+	err = self.request(self.pullRequestURL(number)).
+		Patch().
+		BodyJSON(map[string]any{
+			"status":                "completed",
+			"lastMergeSourceCommit": pullRequest.LastMergeSourceCommit,
+			"completionOptions": CompletionOptions{
+				DeleteSourceBranch: false,
+				MergeCommitMessage: message.String(),
+				MergeStrategy:      mergeStrategy(method),
+			},
+		}).
+		Fetch(context.Background())
+	self.log.Finished(err)
+	return err
+}
+
+// mergeStrategy provides the Azure DevOps merge strategy for the given merge method.
+func mergeStrategy(method forgedomain.ProposalMergeMethod) string {
+	switch method {
+	case forgedomain.ProposalMergeMethodMerge:
+		return "noFastForward"
+	case forgedomain.ProposalMergeMethodRebase:
+		return "rebase"
+	case forgedomain.ProposalMergeMethodSquash:
+		return "squash"
+	}
+	panic("unhandled proposal merge method: " + method.String())
+}
+
+// ============================================================================
+// update proposal body
+// ============================================================================
+
+var _ forgedomain.ProposalBodyUpdater = apiConnector // type check
+
+func (self APIConnector) UpdateProposalBody(proposalData forgedomain.ProposalInterface, updatedBody gitdomain.ProposalBody) error {
+	data := proposalData.Data()
+	self.log.Start(messages.APIProposalUpdateBody, colors.BoldGreen().Styled("#"+data.Number.String()))
+	err := self.request(self.pullRequestURL(data.Number)).
+		Patch().
+		BodyJSON(map[string]string{
+			"description": updatedBody.String(),
+		}).
+		Fetch(context.Background())
+	self.log.Finished(err)
+	return err
+}
+
+// ============================================================================
+// update proposal target
+// ============================================================================
+
+var _ forgedomain.ProposalTargetUpdater = apiConnector // type check
+
+func (self APIConnector) UpdateProposalTarget(proposalData forgedomain.ProposalInterface, target gitdomain.LocalBranchName) error {
+	data := proposalData.Data()
+	self.log.Start(messages.APIUpdateProposalTarget, colors.BoldGreen().Styled("#"+data.Number.String()), colors.BoldCyan().Styled(target.String()))
+	err := self.request(self.pullRequestURL(data.Number)).
+		Patch().
+		BodyJSON(map[string]string{
+			"targetRefName": branchRef(target),
+		}).
+		Fetch(context.Background())
+	self.log.Finished(err)
+	return err
+}
+
+// ============================================================================
+// verify credentials
+// ============================================================================
+
+var _ forgedomain.CredentialVerifier = apiConnector // type check
+
+func (self APIConnector) VerifyCredentials() forgedomain.VerifyCredentialsResult {
+	var connectionData ConnectionData
+	err := self.request(fmt.Sprintf("https://%s/%s/_apis/connectionData", self.apiHostname(), self.organization())).
+		ToJSON(&connectionData).
+		Fetch(context.Background())
+	if err != nil {
+		return forgedomain.VerifyCredentialsResult{
+			AuthenticatedUser:   None[string](),
+			AuthenticationError: err,
+			AuthorizationError:  nil,
+		}
+	}
+	err = self.request(self.pullRequestsURL()).
+		Param("$top", "1").
+		Fetch(context.Background())
+	return forgedomain.VerifyCredentialsResult{
+		AuthenticatedUser:   NewOption(connectionData.AuthenticatedUser.ProviderDisplayName),
+		AuthenticationError: nil,
+		AuthorizationError:  err,
+	}
+}
+
+// apiHostname provides the hostname of the Azure DevOps REST API.
+// Repositories cloned via SSH have "ssh.dev.azure.com" as their hostname,
+// but the API is only available at "dev.azure.com".
+func (self APIConnector) apiHostname() string {
+	return strings.TrimPrefix(self.Hostname, "ssh.")
+}
+
+// organization provides the name of the Azure DevOps organization that hosts this repository.
+// The organization part of Azure DevOps remote URLs contains the organization and the project.
+func (self APIConnector) organization() string {
+	organization, _, _ := strings.Cut(self.Organization, "/")
+	return organization
+}
+
+func (self APIConnector) pullRequestURL(number forgedomain.ProposalNumber) string {
+	return self.pullRequestsURL() + "/" + number.String()
+}
+
+func (self APIConnector) pullRequestsURL() string {
+	return fmt.Sprintf(
+		"https://%s/%s/_apis/git/repositories/%s/pullrequests",
+		self.apiHostname(),
+		self.Organization,
+		self.Repository,
+	)
+}
+
+// request provides a pre-configured request to the given Azure DevOps API endpoint.
+func (self APIConnector) request(url string) *requests.Builder {
+	// Azure DevOps uses personal access tokens as the password of basic authentication, with an empty username
+	return requests.URL(url).
+		BasicAuth("", self.token.String()).
+		Param("api-version", apiVersion)
+}
@@ -1,9 +1,14 @@
 package azuredevops
 
 import (
+	"strings"
+
+	"github.com/git-town/git-town/v22/internal/cli/print"
 	"github.com/git-town/git-town/v22/internal/config/configdomain"
 	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
 	"github.com/git-town/git-town/v22/internal/git/giturl"
+	"github.com/git-town/git-town/v22/internal/subshell"
+	"github.com/git-town/git-town/v22/internal/test/mockproposals"
 	. "github.com/git-town/git-town/v22/pkg/prelude"
 )
 
@@ -13,18 +18,44 @@ func Detect(remoteURL giturl.Parts) bool {
 }
 
 type NewConnectorArgs struct {
+	APIToken  Option[forgedomain.AzuredevopsToken]
 	Browser   Option[configdomain.Browser]
+	ConfigDir configdomain.RepoConfigDir
+	Log       print.Logger
 	RemoteURL giturl.Parts
 }
 
 // NewConnector provides the correct connector for talking to Azure DevOps.
-func NewConnector(args NewConnectorArgs) WebConnector {
-	return WebConnector{
+func NewConnector(args NewConnectorArgs) forgedomain.Connector { //nolint:ireturn
+	webConnector := WebConnector{
 		HostedRepoInfo: forgedomain.HostedRepoInfo{
 			Hostname:     args.RemoteURL.Host,
-			Organization: args.RemoteURL.Org,
+			Organization: strings.TrimSuffix(args.RemoteURL.Org, "/_git"), // HTTPS remotes have the form https://dev.azure.com/org/project/_git/repo
 			Repository:   args.RemoteURL.Repo,
 		},
 		browser: args.Browser,
 	}
+	if subshell.IsInTest() {
+		proposalsPath := mockproposals.NewMockProposalPath(args.ConfigDir)
+		proposals := mockproposals.Load(proposalsPath)
+		return &MockConnector{
+			Proposals:     proposals,
+			ProposalsPath: proposalsPath,
+			WebConnector:  webConnector,
+			cache:         forgedomain.APICache{},
+			log:           args.Log,
+		}
+	}
+	if apiToken, hasAPIToken := args.APIToken.Get(); hasAPIToken {
+		apiConnector := APIConnector{
+			WebConnector: webConnector,
+			log:          args.Log,
+			token:        apiToken,
+		}
+		return &CachedAPIConnector{
+			api:   apiConnector,
+			cache: forgedomain.APICache{},
+		}
+	}
+	return webConnector
 }
@@ -0,0 +1,25 @@
+package azuredevops_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/forge/azuredevops"
+	"github.com/git-town/git-town/v22/internal/git/giturl"
+	"github.com/shoenig/test/must"
+)
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+	tests := map[string]bool{
+		"git@ssh.dev.azure.com:v3/kevingoslar/tikibase/tikibase":               true,  // SSH URL
+		"https://kevingoslar@dev.azure.com/kevingoslar/tikibase/_git/tikibase": true,  // HTTPS URL
+		"git@custom-url.com:git-town/docs.git":                                 false, // custom URL
+		"git@github.com:git-town/git-town.git":                                 false, // other hosting service URL
+	}
+	for give, want := range tests {
+		url, has := giturl.Parse(give).Get()
+		must.True(t, has)
+		have := azuredevops.Detect(url)
+		must.EqOp(t, want, have)
+	}
+}
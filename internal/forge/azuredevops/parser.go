@@ -0,0 +1,67 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+)
+
+const refsHeadsPrefix = "refs/heads/"
+
+// branchRef provides the fully qualified Git ref that the Azure DevOps API uses for the given branch.
+func branchRef(branch gitdomain.LocalBranchName) string {
+	return refsHeadsPrefix + branch.String()
+}
+
+func parsePullRequest(pullRequest PullRequest, repoURL string) forgedomain.ProposalData {
+	return forgedomain.ProposalData{
+		Active:       pullRequest.Status == "active",
+		Body:         gitdomain.NewProposalBodyOpt(pullRequest.Description),
+		Draft:        pullRequest.IsDraft,
+		MergeWithAPI: pullRequest.MergeStatus == "succeeded" && !pullRequest.IsDraft,
+		Merged:       pullRequest.Status == "completed",
+		Number:       forgedomain.ProposalNumber(pullRequest.PullRequestID),
+		Source:       gitdomain.NewLocalBranchName(strings.TrimPrefix(pullRequest.SourceRefName, refsHeadsPrefix)),
+		Target:       gitdomain.NewLocalBranchName(strings.TrimPrefix(pullRequest.TargetRefName, refsHeadsPrefix)),
+		Title:        gitdomain.ProposalTitle(pullRequest.Title),
+		URL:          fmt.Sprintf("%s/pullrequest/%d", repoURL, pullRequest.PullRequestID),
+	}
+}
+
+type Commit struct {
+	CommitID string `json:"commitId"`
+}
+
+type CompletionOptions struct {
+	DeleteSourceBranch bool   `json:"deleteSourceBranch"`
+	MergeCommitMessage string `json:"mergeCommitMessage"`
+	MergeStrategy      string `json:"mergeStrategy"`
+}
+
+type ConnectionData struct {
+	AuthenticatedUser Identity `json:"authenticatedUser"`
+}
+
+type Identity struct {
+	ID                  string `json:"id"`
+	ProviderDisplayName string `json:"providerDisplayName"`
+}
+
+type PullRequest struct {
+	Description           string `json:"description"`
+	IsDraft               bool   `json:"isDraft"`
+	LastMergeSourceCommit Commit `json:"lastMergeSourceCommit"`
+	MergeStatus           string `json:"mergeStatus"`
+	PullRequestID         int    `json:"pullRequestId"`
+	SourceRefName         string `json:"sourceRefName"`
+	Status                string `json:"status"`
+	TargetRefName         string `json:"targetRefName"`
+	Title                 string `json:"title"`
+}
+
+type PullRequestResponse struct {
+	Count int           `json:"count"`
+	Value []PullRequest `json:"value"`
+}
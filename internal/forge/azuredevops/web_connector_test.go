@@ -6,17 +6,19 @@ import (
 	"github.com/git-town/git-town/v22/internal/forge/azuredevops"
 	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
 	"github.com/git-town/git-town/v22/internal/git/gitdomain"
-	"github.com/git-town/git-town/v22/internal/git/giturl"
 	. "github.com/git-town/git-town/v22/pkg/prelude"
 	"github.com/shoenig/test/must"
 )
 
 func TestWebConnector(t *testing.T) {
 	t.Parallel()
-	url := giturl.Parse("git@ssh.dev.azure.com:v3/kevingoslar/tikibase/tikibase").GetOrPanic()
-	connector := azuredevops.NewConnector(azuredevops.NewConnectorArgs{
-		RemoteURL: url,
-	})
+	connector := azuredevops.WebConnector{
+		HostedRepoInfo: forgedomain.HostedRepoInfo{
+			Hostname:     "ssh.dev.azure.com",
+			Organization: "kevingoslar/tikibase",
+			Repository:   "tikibase",
+		},
+	}
 
 	t.Run("NewProposalURL", func(t *testing.T) {
 		t.Parallel()
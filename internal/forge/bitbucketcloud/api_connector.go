@@ -1,9 +1,11 @@
 package bitbucketcloud
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
+	"github.com/carlmjohnson/requests"
 	"github.com/git-town/git-town/v22/internal/cli/print"
 	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
 	"github.com/git-town/git-town/v22/internal/git/gitdomain"
@@ -22,8 +24,49 @@ var (
 // APIConnector provides access to the Bitbucket Cloud API.
 type APIConnector struct {
 	WebConnector
-	client Mutable[bitbucket.Client]
-	log    print.Logger
+	appPassword forgedomain.BitbucketAppPassword
+	client      Mutable[bitbucket.Client]
+	log         print.Logger
+	userName    forgedomain.BitbucketUsername
+}
+
+// ============================================================================
+// create proposals
+// ============================================================================
+
+var _ forgedomain.ProposalCreator = apiConnector // type check
+
+func (self APIConnector) CreateProposalViaAPI(args forgedomain.CreateProposalArgs) (forgedomain.ProposalData, error) {
+	if len(args.Labels) > 0 || len(args.Assignees) > 0 {
+		return forgedomain.ProposalData{}, errors.New(messages.BitbucketCloudLabelsAssigneesUnsupported)
+	}
+	self.log.Start(messages.APIProposalCreateStart, args.Branch, args.ParentBranch)
+	result1, err := self.client.Value.Repositories.PullRequests.Create(&bitbucket.PullRequestsOptions{
+		Owner:             self.Organization,
+		RepoSlug:          self.Repository,
+		SourceBranch:      args.Branch.String(),
+		DestinationBranch: args.ParentBranch.String(),
+		Title:             args.ProposalTitle.GetOrZero().String(),
+		Description:       args.ProposalBody.GetOrZero().String(),
+		Draft:             args.Draft,
+		Reviewers:         args.Reviewers.Strings(), // Bitbucket Cloud identifies reviewers via their UUID
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return forgedomain.ProposalData{}, err
+	}
+	result2, ok := result1.(map[string]any)
+	if !ok {
+		self.log.Failed(messages.APIUnexpectedResultDataStructure)
+		return forgedomain.ProposalData{}, errors.New(messages.APIUnexpectedResultDataStructure)
+	}
+	proposalData, err := parsePullRequest(result2)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return forgedomain.ProposalData{}, err
+	}
+	self.log.Success("#" + proposalData.Number.String())
+	return proposalData.ProposalData, nil
 }
 
 // ============================================================================
@@ -94,6 +137,30 @@ func (self APIConnector) FindProposal(branch, target gitdomain.LocalBranchName)
 	}
 }
 
+// ============================================================================
+// mark proposals as ready for review
+// ============================================================================
+
+var _ forgedomain.ProposalReadyMarker = apiConnector // type check
+
+func (self APIConnector) MarkProposalReady(proposalData forgedomain.ProposalInterface) error {
+	data := proposalData.(forgedomain.BitbucketCloudProposalData)
+	self.log.Start(messages.APIProposalMarkReady, colors.BoldGreen().Styled("#"+data.Number.String()))
+	_, err := self.client.Value.Repositories.PullRequests.Update(&bitbucket.PullRequestsOptions{
+		ID:                data.Number.String(),
+		Owner:             self.Organization,
+		RepoSlug:          self.Repository,
+		SourceBranch:      data.Source.String(),
+		DestinationBranch: data.Target.String(),
+		Title:             data.Title.String(),
+		Description:       data.Body.GetOrZero().String(),
+		Draft:             false,
+		CloseSourceBranch: data.CloseSourceBranch,
+	})
+	self.log.Finished(err)
+	return err
+}
+
 // ============================================================================
 // search proposals
 // ============================================================================
@@ -153,26 +220,43 @@ func (self APIConnector) SearchProposals(branch gitdomain.LocalBranchName) ([]fo
 }
 
 // ============================================================================
-// squash-merge proposals
+// merge proposals
 // ============================================================================
 
 var _ forgedomain.ProposalMerger = apiConnector // type check
 
-func (self APIConnector) SquashMergeProposal(number forgedomain.ProposalNumber, message gitdomain.CommitMessage) error {
+func (self APIConnector) MergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message gitdomain.CommitMessage) error {
 	if number <= 0 {
 		return errors.New(messages.ProposalNoNumberGiven)
 	}
 	self.log.Start(messages.ForgeBitbucketMergingViaAPI, colors.BoldGreen().Styled("#"+number.String()))
-	_, err := self.client.Value.Repositories.PullRequests.Merge(&bitbucket.PullRequestsOptions{
-		ID:       number.String(),
-		Owner:    self.Organization,
-		RepoSlug: self.Repository,
-		Message:  message.String(),
-	})
+	// the go-bitbucket library doesn't support the merge strategy, so we talk to the API directly
+	url := fmt.Sprintf("%s/repositories/%s/%s/pullrequests/%s/merge", self.client.Value.GetApiBaseURL(), self.Organization, self.Repository, number)
+	err := requests.URL(url).
+		BasicAuth(self.userName.String(), self.appPassword.String()).
+		BodyJSON(map[string]any{
+			"close_source_branch": false,
+			"merge_strategy":      mergeStrategy(method),
+			"message":             message.String(),
+		}).
+		Fetch(context.Background())
 	self.log.Finished(err)
 	return err
 }
 
+// mergeStrategy provides the Bitbucket Cloud merge strategy for the given merge method.
+func mergeStrategy(method forgedomain.ProposalMergeMethod) string {
+	switch method {
+	case forgedomain.ProposalMergeMethodMerge:
+		return "merge_commit"
+	case forgedomain.ProposalMergeMethodRebase:
+		return "rebase_fast_forward"
+	case forgedomain.ProposalMergeMethodSquash:
+		return "squash"
+	}
+	panic("unhandled proposal merge method: " + method.String())
+}
+
 // ============================================================================
 // update proposal body
 // ============================================================================
@@ -51,8 +51,10 @@ func NewConnector(args NewConnectorArgs) forgedomain.Connector { //nolint: iretu
 	if hasUserName && hasAppPassword {
 		apiConnector := APIConnector{
 			WebConnector: webConnector,
+			appPassword:  appPassword,
 			client:       NewMutable(bitbucket.NewBasicAuth(userName.String(), appPassword.String())),
 			log:          args.Log,
+			userName:     userName,
 		}
 		return &CachedAPIConnector{
 			api:   apiConnector,
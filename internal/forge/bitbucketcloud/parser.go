@@ -137,7 +137,9 @@ func parsePullRequest(pullRequest map[string]any) (forgedomain.BitbucketCloudPro
 	return forgedomain.BitbucketCloudProposalData{
 		ProposalData: forgedomain.ProposalData{
 			Active:       isActive,
+			Draft:        draft2,
 			MergeWithAPI: false,
+			Merged:       state3 == "merged",
 			Number:       number,
 			Source:       gitdomain.NewLocalBranchName(source6),
 			Target:       gitdomain.NewLocalBranchName(destination6),
@@ -146,6 +148,5 @@ func parsePullRequest(pullRequest map[string]any) (forgedomain.BitbucketCloudPro
 			URL:          url6,
 		},
 		CloseSourceBranch: closeSourceBranch2,
-		Draft:             draft2,
 	}, nil
 }
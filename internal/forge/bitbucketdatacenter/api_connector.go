@@ -2,7 +2,9 @@ package bitbucketdatacenter
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/carlmjohnson/requests"
@@ -70,6 +72,54 @@ func (self APIConnector) FindProposal(branch, target gitdomain.LocalBranchName)
 	return Some(proposal), nil
 }
 
+// ============================================================================
+// merge proposals
+// ============================================================================
+
+var _ forgedomain.ProposalMerger = apiConnector // type check
+
+func (self APIConnector) MergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message gitdomain.CommitMessage) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.ForgeBitbucketDatacenterMergingViaAPI, colors.BoldGreen().Styled("#"+number.String()))
+	ctx := context.TODO()
+	pullRequestURL := self.apiBaseURL() + "/" + number.String()
+	// Bitbucket Data Center only merges a pull request if we tell it the version we expect to merge
+	var pullRequest PullRequest
+	err := requests.URL(pullRequestURL).
+		BasicAuth(self.username, self.token).
+		ToJSON(&pullRequest).
+		Fetch(ctx)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+	err = requests.URL(pullRequestURL+"/merge").
+		BasicAuth(self.username, self.token).
+		Param("version", strconv.Itoa(pullRequest.Version)).
+		BodyJSON(map[string]any{
+			"message":    message.String(),
+			"strategyId": mergeStrategy(method),
+		}).
+		Fetch(ctx)
+	self.log.Finished(err)
+	return err
+}
+
+// mergeStrategy provides the Bitbucket Data Center merge strategy for the given merge method.
+func mergeStrategy(method forgedomain.ProposalMergeMethod) string {
+	switch method {
+	case forgedomain.ProposalMergeMethodMerge:
+		return "no-ff"
+	case forgedomain.ProposalMergeMethodRebase:
+		return "rebase-ff-only"
+	case forgedomain.ProposalMergeMethodSquash:
+		return "squash"
+	}
+	panic("unhandled proposal merge method: " + method.String())
+}
+
 // ============================================================================
 // search proposals
 // ============================================================================
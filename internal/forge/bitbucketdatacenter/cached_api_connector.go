@@ -48,6 +48,17 @@ func (self *CachedAPIConnector) FindProposal(source, target gitdomain.LocalBranc
 	return loadedProposal, err
 }
 
+// ============================================================================
+// merge proposals
+// ============================================================================
+
+var _ forgedomain.ProposalMerger = &cachedAPIConnector // type check
+
+func (self *CachedAPIConnector) MergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message gitdomain.CommitMessage) error {
+	self.cache.Clear(number)
+	return self.api.MergeProposal(number, method, message)
+}
+
 // ============================================================================
 // search proposals
 // ============================================================================
@@ -10,7 +10,9 @@ import (
 func parsePullRequest(pullRequest PullRequest, repoURL string) forgedomain.ProposalData {
 	return forgedomain.ProposalData{
 		Active:       !pullRequest.Closed,
+		Draft:        pullRequest.Draft,
 		MergeWithAPI: false,
+		Merged:       pullRequest.State == "MERGED",
 		Number:       forgedomain.ProposalNumber(pullRequest.ID),
 		Source:       gitdomain.NewLocalBranchName(pullRequest.FromRef.DisplayID),
 		Target:       gitdomain.NewLocalBranchName(pullRequest.ToRef.DisplayID),
@@ -0,0 +1,22 @@
+package forgedomain
+
+import (
+	"strings"
+
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// AzuredevopsToken is a personal access token to use with the Azure DevOps API.
+type AzuredevopsToken string
+
+func (self AzuredevopsToken) String() string {
+	return string(self)
+}
+
+func ParseAzuredevopsToken(value string) Option[AzuredevopsToken] {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return None[AzuredevopsToken]()
+	}
+	return Some(AzuredevopsToken(value))
+}
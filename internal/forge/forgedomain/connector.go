@@ -22,12 +22,21 @@ type Connector interface {
 }
 
 type CreateProposalArgs struct {
+	Assignees      gitdomain.ProposalMetadataNames // people to assign to the new proposal
 	Branch         gitdomain.LocalBranchName
+	Draft          bool // whether to create the proposal as a draft
 	FrontendRunner subshelldomain.Runner
+	Labels         gitdomain.ProposalMetadataNames // labels to add to the new proposal
 	MainBranch     gitdomain.LocalBranchName
 	ParentBranch   gitdomain.LocalBranchName
 	ProposalBody   Option[gitdomain.ProposalBody]
 	ProposalTitle  Option[gitdomain.ProposalTitle]
+	Reviewers      gitdomain.ProposalMetadataNames // people to request reviews of the new proposal from
+}
+
+// HasMetadata indicates whether the proposal to create has assignees, labels, or reviewers.
+func (self CreateProposalArgs) HasMetadata() bool {
+	return len(self.Assignees) > 0 || len(self.Labels) > 0 || len(self.Reviewers) > 0
 }
 
 // CredentialVerifier describes capabilities to verify credentials.
@@ -42,6 +51,16 @@ type VerifyCredentialsResult struct {
 	AuthorizationError  error          // error while verifying authorization, nil == user is authenticated
 }
 
+// ProposalAutoMerger describes methods that connectors need to implement
+// to enable Git Town to let the active forge merge proposals once they are ready,
+// for example through a merge queue or merge train.
+type ProposalAutoMerger interface {
+	// Enables auto-merge for the proposal with the given number.
+	// The forge merges the proposal using the given merge method,
+	// and the given message if provided, once all its requirements are met.
+	AutoMergeProposal(number ProposalNumber, method ProposalMergeMethod, message Option[gitdomain.CommitMessage]) error
+}
+
 // ProposalBodyUpdater describes methods that connectors need to implement
 // to enable Git Town to update proposals at the active forge.
 type ProposalBodyUpdater interface {
@@ -49,6 +68,13 @@ type ProposalBodyUpdater interface {
 	UpdateProposalBody(proposal ProposalInterface, newBody gitdomain.ProposalBody) error
 }
 
+// ProposalCreator describes methods that connectors need to implement
+// to enable Git Town to create proposals through the API of the active forge.
+type ProposalCreator interface {
+	// Creates a proposal with the given data at the forge and provides the created proposal.
+	CreateProposalViaAPI(args CreateProposalArgs) (ProposalData, error)
+}
+
 // ProposalFinder describes methods that connectors need to implement
 // to enable Git Town to find proposals at the active forge.
 type ProposalFinder interface {
@@ -56,11 +82,25 @@ type ProposalFinder interface {
 	FindProposal(branch, target gitdomain.LocalBranchName) (Option[Proposal], error)
 }
 
+// ProposalHistorySearcher describes methods that connectors need to implement
+// to enable Git Town to find merged and closed proposals at the active forge.
+type ProposalHistorySearcher interface {
+	// SearchProposalHistory finds all open, merged, and closed proposals that have the given branch as their source branch.
+	SearchProposalHistory(branch gitdomain.LocalBranchName) ([]Proposal, error)
+}
+
 // ProposalMerger describes methods that connectors need to implement
 // to enable Git Town to merge for proposals at the active forge.
 type ProposalMerger interface {
-	// Merges the proposal with the given number using the given message.
-	SquashMergeProposal(number ProposalNumber, message gitdomain.CommitMessage) error
+	// Merges the proposal with the given number using the given merge method and message.
+	MergeProposal(number ProposalNumber, method ProposalMergeMethod, message gitdomain.CommitMessage) error
+}
+
+// ProposalReadyMarker describes methods that connectors need to implement
+// to enable Git Town to mark draft proposals as ready for review at the active forge.
+type ProposalReadyMarker interface {
+	// Marks the given draft proposal as ready for review.
+	MarkProposalReady(proposal ProposalInterface) error
 }
 
 // ProposalSearcher describes methods that connectors need to implement
@@ -8,7 +8,10 @@ import (
 type ProposalData struct {
 	Active       bool // whether the proposal is open
 	Body         Option[gitdomain.ProposalBody]
+	Draft        bool                  // whether the proposal is marked as a draft
+	HeadSHA      Option[gitdomain.SHA] // the SHA of the latest commit on the source branch of this proposal
 	MergeWithAPI bool
+	Merged       bool // whether the proposal was merged
 	Number       ProposalNumber
 	Source       gitdomain.LocalBranchName
 	Target       gitdomain.LocalBranchName
@@ -20,10 +23,21 @@ func (self ProposalData) Data() ProposalData {
 	return self
 }
 
+// State provides the lifecycle state of this proposal.
+func (self ProposalData) State() ProposalState {
+	switch {
+	case self.Active:
+		return ProposalStateOpen
+	case self.Merged:
+		return ProposalStateMerged
+	default:
+		return ProposalStateClosed
+	}
+}
+
 type BitbucketCloudProposalData struct {
 	ProposalData
 	CloseSourceBranch bool
-	Draft             bool
 }
 
 func (self BitbucketCloudProposalData) Data() ProposalData {
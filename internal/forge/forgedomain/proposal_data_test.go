@@ -18,7 +18,9 @@ func TestBitbucketCloudProposalData(t *testing.T) {
 			ProposalData: forgedomain.ProposalData{
 				Active:       true,
 				Body:         gitdomain.NewProposalBodyOpt("body"),
+				Draft:        true,
 				MergeWithAPI: true,
+				Merged:       false,
 				Number:       123,
 				Source:       "source",
 				Target:       "target",
@@ -26,7 +28,6 @@ func TestBitbucketCloudProposalData(t *testing.T) {
 				URL:          "url",
 			},
 			CloseSourceBranch: true,
-			Draft:             true,
 		}
 		serialized, err := json.MarshalIndent(data, "", "  ")
 		must.NoError(t, err)
@@ -34,14 +35,16 @@ func TestBitbucketCloudProposalData(t *testing.T) {
 {
   "Active": true,
   "Body": "body",
+  "Draft": true,
+  "HeadSHA": null,
   "MergeWithAPI": true,
+  "Merged": false,
   "Number": 123,
   "Source": "source",
   "Target": "target",
   "Title": "title",
   "URL": "url",
-  "CloseSourceBranch": true,
-  "Draft": true
+  "CloseSourceBranch": true
 }`[1:]
 		must.EqOp(t, want, string(serialized))
 
@@ -52,3 +55,26 @@ func TestBitbucketCloudProposalData(t *testing.T) {
 		must.True(t, data2.Draft)
 	})
 }
+
+func TestProposalData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("State", func(t *testing.T) {
+		t.Parallel()
+		t.Run("closed", func(t *testing.T) {
+			t.Parallel()
+			data := forgedomain.ProposalData{Active: false, Merged: false}
+			must.EqOp(t, forgedomain.ProposalStateClosed, data.State())
+		})
+		t.Run("merged", func(t *testing.T) {
+			t.Parallel()
+			data := forgedomain.ProposalData{Active: false, Merged: true}
+			must.EqOp(t, forgedomain.ProposalStateMerged, data.State())
+		})
+		t.Run("open", func(t *testing.T) {
+			t.Parallel()
+			data := forgedomain.ProposalData{Active: true, Merged: false}
+			must.EqOp(t, forgedomain.ProposalStateOpen, data.State())
+		})
+	})
+}
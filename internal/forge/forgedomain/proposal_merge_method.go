@@ -0,0 +1,45 @@
+package forgedomain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v22/internal/messages"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// ProposalMergeMethod defines legal values for the "git-town.ship-api-merge-method" config setting.
+// It defines how the forge merges proposals when shipping via its API.
+type ProposalMergeMethod string
+
+func (self ProposalMergeMethod) String() string { return string(self) }
+
+const (
+	ProposalMergeMethodMerge  ProposalMergeMethod = "merge"  // the forge creates a merge commit
+	ProposalMergeMethodRebase ProposalMergeMethod = "rebase" // the forge rebases the commits onto the target branch and fast-forwards it
+	ProposalMergeMethodSquash ProposalMergeMethod = "squash" // the forge squashes all commits into a single commit
+)
+
+// ParseProposalMergeMethod provides the ProposalMergeMethod enum matching the given text.
+func ParseProposalMergeMethod(name string, source string) (Option[ProposalMergeMethod], error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return None[ProposalMergeMethod](), nil
+	}
+	nameLower := strings.ToLower(name)
+	for _, mergeMethod := range proposalMergeMethods() {
+		if nameLower == mergeMethod.String() {
+			return Some(mergeMethod), nil
+		}
+	}
+	return None[ProposalMergeMethod](), fmt.Errorf(messages.ProposalMergeMethodUnknown, source, name)
+}
+
+// proposalMergeMethods provides all legal values for ProposalMergeMethod
+func proposalMergeMethods() []ProposalMergeMethod {
+	return []ProposalMergeMethod{
+		ProposalMergeMethodMerge,
+		ProposalMergeMethodRebase,
+		ProposalMergeMethodSquash,
+	}
+}
@@ -0,0 +1,37 @@
+package forgedomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestParseProposalMergeMethod(t *testing.T) {
+	t.Parallel()
+
+	t.Run("acceptable content", func(t *testing.T) {
+		t.Parallel()
+		tests := map[string]Option[forgedomain.ProposalMergeMethod]{
+			"":         None[forgedomain.ProposalMergeMethod](),
+			"merge":    Some(forgedomain.ProposalMergeMethodMerge),
+			"Merge":    Some(forgedomain.ProposalMergeMethodMerge),
+			"rebase":   Some(forgedomain.ProposalMergeMethodRebase),
+			"REBASE":   Some(forgedomain.ProposalMergeMethodRebase),
+			"squash":   Some(forgedomain.ProposalMergeMethodSquash),
+			" squash ": Some(forgedomain.ProposalMergeMethodSquash),
+		}
+		for give, want := range tests {
+			have, err := forgedomain.ParseProposalMergeMethod(give, "test")
+			must.NoError(t, err)
+			must.Eq(t, want, have)
+		}
+	})
+
+	t.Run("unacceptable content", func(t *testing.T) {
+		t.Parallel()
+		_, err := forgedomain.ParseProposalMergeMethod("zonk", "test")
+		must.EqError(t, err, `unknown proposal merge method in test: "zonk"`)
+	})
+}
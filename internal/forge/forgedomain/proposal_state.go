@@ -0,0 +1,14 @@
+package forgedomain
+
+// ProposalState describes the lifecycle state of a proposal at the forge.
+type ProposalState string
+
+const (
+	ProposalStateClosed ProposalState = "closed"
+	ProposalStateMerged ProposalState = "merged"
+	ProposalStateOpen   ProposalState = "open"
+)
+
+func (self ProposalState) String() string {
+	return string(self)
+}
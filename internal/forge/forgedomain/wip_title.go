@@ -0,0 +1,26 @@
+package forgedomain
+
+import (
+	"regexp"
+
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+)
+
+// Gitea and Forgejo have no dedicated API field for draft pull requests.
+// They mark pull requests as work in progress if their title starts with one of these prefixes.
+var wipPrefixRE = regexp.MustCompile(`(?i)^\s*(wip:|\[wip\])\s*`)
+
+// IsWIPTitle indicates whether the given pull request title marks the pull request as work in progress.
+func IsWIPTitle(title gitdomain.ProposalTitle) bool {
+	return wipPrefixRE.MatchString(title.String())
+}
+
+// WIPTitle provides the title that marks a pull request with the given title as work in progress.
+func WIPTitle(title gitdomain.ProposalTitle) gitdomain.ProposalTitle {
+	return "WIP: " + WithoutWIPPrefix(title)
+}
+
+// WithoutWIPPrefix provides the given pull request title without the prefix that marks it as work in progress.
+func WithoutWIPPrefix(title gitdomain.ProposalTitle) gitdomain.ProposalTitle {
+	return gitdomain.ProposalTitle(wipPrefixRE.ReplaceAllString(title.String(), ""))
+}
@@ -0,0 +1,49 @@
+package forgedomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestWIPTitle(t *testing.T) {
+	t.Parallel()
+
+	t.Run("IsWIPTitle", func(t *testing.T) {
+		t.Parallel()
+		must.True(t, forgedomain.IsWIPTitle("WIP: my title"))
+		must.True(t, forgedomain.IsWIPTitle("[wip] my title"))
+		must.False(t, forgedomain.IsWIPTitle("my title"))
+		must.False(t, forgedomain.IsWIPTitle("my WIP: title"))
+	})
+
+	t.Run("WIPTitle", func(t *testing.T) {
+		t.Parallel()
+		tests := map[gitdomain.ProposalTitle]gitdomain.ProposalTitle{
+			"my title":      "WIP: my title",
+			"WIP: my title": "WIP: my title",
+			"[WIP] title":   "WIP: title",
+		}
+		for give, want := range tests {
+			have := forgedomain.WIPTitle(give)
+			must.EqOp(t, want, have)
+		}
+	})
+
+	t.Run("WithoutWIPPrefix", func(t *testing.T) {
+		t.Parallel()
+		tests := map[gitdomain.ProposalTitle]gitdomain.ProposalTitle{
+			"my title":       "my title",
+			"WIP: my title":  "my title",
+			"wip:my title":   "my title",
+			"[WIP] my title": "my title",
+			"my title WIP:":  "my title WIP:",
+		}
+		for give, want := range tests {
+			have := forgedomain.WithoutWIPPrefix(give)
+			must.EqOp(t, want, have)
+		}
+	})
+}
@@ -3,6 +3,7 @@ package forgejo
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
@@ -30,6 +31,76 @@ type APIConnector struct {
 	remoteURL giturl.Parts
 }
 
+// ============================================================================
+// create proposals
+// ============================================================================
+
+var _ forgedomain.ProposalCreator = &apiConnector // type check
+
+func (self *APIConnector) CreateProposalViaAPI(args forgedomain.CreateProposalArgs) (forgedomain.ProposalData, error) {
+	client, err := self.getClient()
+	if err != nil {
+		return forgedomain.ProposalData{}, err
+	}
+	self.log.Start(messages.APIProposalCreateStart, args.Branch, args.ParentBranch)
+	title := args.ProposalTitle.GetOrZero()
+	if args.Draft {
+		title = forgedomain.WIPTitle(title)
+	}
+	labelIDs, err := self.labelIDs(client, args.Labels)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return forgedomain.ProposalData{}, err
+	}
+	pullRequest, _, err := client.CreatePullRequest(self.Organization, self.Repository, forgejo.CreatePullRequestOption{
+		Assignees: args.Assignees.Strings(),
+		Base:      args.ParentBranch.String(),
+		Body:      args.ProposalBody.GetOrZero().String(),
+		Head:      args.Branch.String(),
+		Labels:    labelIDs,
+		Title:     title.String(),
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return forgedomain.ProposalData{}, err
+	}
+	proposalData := parsePullRequest(pullRequest)
+	self.log.Success("#" + proposalData.Number.String())
+	if len(args.Reviewers) > 0 {
+		// the Forgejo API doesn't accept reviewers when creating pull requests
+		self.log.Start(messages.APIProposalRequestReviewers, args.Reviewers.Join(", "), colors.BoldGreen().Styled("#"+proposalData.Number.String()))
+		_, err = client.CreateReviewRequests(self.Organization, self.Repository, proposalData.Number.Int64(), forgejo.PullReviewRequestOptions{
+			Reviewers: args.Reviewers.Strings(),
+		})
+		self.log.Finished(err)
+	}
+	return proposalData, err
+}
+
+// labelIDs provides the IDs of the labels with the given names in this repository.
+func (self *APIConnector) labelIDs(client *forgejo.Client, names gitdomain.ProposalMetadataNames) ([]int64, error) {
+	result := []int64{}
+	if len(names) == 0 {
+		return result, nil
+	}
+	labels, _, err := client.ListRepoLabels(self.Organization, self.Repository, forgejo.ListLabelsOptions{
+		ListOptions: forgejo.ListOptions{Page: -1},
+	})
+	if err != nil {
+		return result, err
+	}
+	for _, name := range names {
+		index := slices.IndexFunc(labels, func(label *forgejo.Label) bool {
+			return label.Name == name.String()
+		})
+		if index < 0 {
+			return result, fmt.Errorf(messages.ForgeLabelNotFound, name)
+		}
+		result = append(result, labels[index].ID)
+	}
+	return result, nil
+}
+
 // ============================================================================
 // find proposals
 // ============================================================================
@@ -67,6 +138,26 @@ func (self *APIConnector) FindProposal(branch, target gitdomain.LocalBranchName)
 	}
 }
 
+// ============================================================================
+// mark proposals as ready for review
+// ============================================================================
+
+var _ forgedomain.ProposalReadyMarker = &apiConnector // type check
+
+func (self *APIConnector) MarkProposalReady(proposalData forgedomain.ProposalInterface) error {
+	client, err := self.getClient()
+	if err != nil {
+		return err
+	}
+	data := proposalData.Data()
+	self.log.Start(messages.APIProposalMarkReady, colors.BoldGreen().Styled("#"+data.Number.String()))
+	_, _, err = client.EditPullRequest(self.Organization, self.Repository, data.Number.Int64(), forgejo.EditPullRequestOption{
+		Title: forgedomain.WithoutWIPPrefix(data.Title).String(),
+	})
+	self.log.Finished(err)
+	return err
+}
+
 // ============================================================================
 // search proposals
 // ============================================================================
@@ -107,12 +198,12 @@ func (self *APIConnector) SearchProposals(branch gitdomain.LocalBranchName) ([]f
 }
 
 // ============================================================================
-// squash-merge proposals
+// merge proposals
 // ============================================================================
 
 var _ forgedomain.ProposalMerger = &apiConnector // type check
 
-func (self *APIConnector) SquashMergeProposal(number forgedomain.ProposalNumber, message gitdomain.CommitMessage) error {
+func (self *APIConnector) MergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message gitdomain.CommitMessage) error {
 	if number <= 0 {
 		return errors.New(messages.ProposalNoNumberGiven)
 	}
@@ -123,7 +214,7 @@ func (self *APIConnector) SquashMergeProposal(number forgedomain.ProposalNumber,
 		return err
 	}
 	_, _, err = client.MergePullRequest(self.Organization, self.Repository, number.Int64(), forgejo.MergePullRequestOption{
-		Style:   forgejo.MergeStyleSquash,
+		Style:   mergeStyle(method),
 		Title:   commitMessageParts.Title.String(),
 		Message: commitMessageParts.Body,
 	})
@@ -135,6 +226,19 @@ func (self *APIConnector) SquashMergeProposal(number forgedomain.ProposalNumber,
 	return nil
 }
 
+// mergeStyle provides the Forgejo merge style for the given merge method.
+func mergeStyle(method forgedomain.ProposalMergeMethod) forgejo.MergeStyle {
+	switch method {
+	case forgedomain.ProposalMergeMethodMerge:
+		return forgejo.MergeStyleMerge
+	case forgedomain.ProposalMergeMethodRebase:
+		return forgejo.MergeStyleRebase
+	case forgedomain.ProposalMergeMethodSquash:
+		return forgejo.MergeStyleSquash
+	}
+	panic("unhandled proposal merge method: " + method.String())
+}
+
 // ============================================================================
 // update proposal body
 // ============================================================================
@@ -246,7 +350,9 @@ func filterPullRequests2(pullRequests []*forgejo.PullRequest, branch gitdomain.L
 func parsePullRequest(pullRequest *forgejo.PullRequest) forgedomain.ProposalData {
 	return forgedomain.ProposalData{
 		Active:       pullRequest.State == forgejo.StateOpen,
+		Draft:        forgedomain.IsWIPTitle(gitdomain.ProposalTitle(pullRequest.Title)),
 		MergeWithAPI: pullRequest.Mergeable,
+		Merged:       pullRequest.HasMerged,
 		Number:       forgedomain.ProposalNumber(pullRequest.Index),
 		Source:       gitdomain.NewLocalBranchName(pullRequest.Head.Ref),
 		Target:       gitdomain.NewLocalBranchName(pullRequest.Base.Ref),
@@ -19,6 +19,17 @@ type CachedConnector struct {
 	Connector Connector
 }
 
+// ============================================================================
+// auto-merge proposals
+// ============================================================================
+
+var _ forgedomain.ProposalAutoMerger = &cachedConnector // type-check
+
+func (self *CachedConnector) AutoMergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message Option[gitdomain.CommitMessage]) error {
+	self.Cache.Clear(number)
+	return self.Connector.AutoMergeProposal(number, method, message)
+}
+
 // ============================================================================
 // browse the repo
 // ============================================================================
@@ -56,6 +67,17 @@ func (self *CachedConnector) FindProposal(source, target gitdomain.LocalBranchNa
 	return loadedProposal, err
 }
 
+// ============================================================================
+// mark proposals as ready for review
+// ============================================================================
+
+var _ forgedomain.ProposalReadyMarker = &cachedConnector // type-check
+
+func (self *CachedConnector) MarkProposalReady(proposalData forgedomain.ProposalInterface) error {
+	self.Cache.Clear(proposalData.Data().Number)
+	return self.Connector.MarkProposalReady(proposalData)
+}
+
 // ============================================================================
 // search proposals
 // ============================================================================
@@ -74,14 +96,24 @@ func (self *CachedConnector) SearchProposals(source gitdomain.LocalBranchName) (
 }
 
 // ============================================================================
-// squash-merge proposals
+// search proposal history
+// ============================================================================
+
+var _ forgedomain.ProposalHistorySearcher = &cachedConnector // type-check
+
+func (self *CachedConnector) SearchProposalHistory(source gitdomain.LocalBranchName) ([]forgedomain.Proposal, error) {
+	return self.Connector.SearchProposalHistory(source)
+}
+
+// ============================================================================
+// merge proposals
 // ============================================================================
 
 var _ forgedomain.ProposalMerger = &cachedConnector // type-check
 
-func (self *CachedConnector) SquashMergeProposal(number forgedomain.ProposalNumber, message gitdomain.CommitMessage) error {
+func (self *CachedConnector) MergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message gitdomain.CommitMessage) error {
 	self.Cache.Clear(number)
-	return self.Connector.SquashMergeProposal(number, message)
+	return self.Connector.MergeProposal(number, method, message)
 }
 
 // ============================================================================
@@ -32,6 +32,19 @@ type Connector struct {
 	Log      print.Logger
 }
 
+// ============================================================================
+// auto-merge proposals
+// ============================================================================
+
+var _ forgedomain.ProposalAutoMerger = ghConnector // type-check
+
+func (self Connector) AutoMergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message Option[gitdomain.CommitMessage]) error {
+	args := []string{"pr", "merge", "--auto"}
+	args = append(args, MergeArgs(method, message)...)
+	args = append(args, number.String())
+	return self.Frontend.Run("gh", args...)
+}
+
 // ============================================================================
 // browse the repo
 // ============================================================================
@@ -52,6 +65,18 @@ func (self Connector) CreateProposal(data forgedomain.CreateProposalArgs) error
 	if body, hasBody := data.ProposalBody.Get(); hasBody {
 		args = append(args, "--body="+body.String())
 	}
+	if data.Draft {
+		args = append(args, "--draft")
+	}
+	if len(data.Labels) > 0 {
+		args = append(args, "--label="+data.Labels.Join(","))
+	}
+	if len(data.Assignees) > 0 {
+		args = append(args, "--assignee="+data.Assignees.Join(","))
+	}
+	if len(data.Reviewers) > 0 {
+		args = append(args, "--reviewer="+data.Reviewers.Join(","))
+	}
 	if err := self.Frontend.Run("gh", args...); err != nil {
 		return err
 	}
@@ -102,6 +127,16 @@ func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Op
 	}
 }
 
+// ============================================================================
+// mark proposals as ready for review
+// ============================================================================
+
+var _ forgedomain.ProposalReadyMarker = ghConnector // type-check
+
+func (self Connector) MarkProposalReady(proposalData forgedomain.ProposalInterface) error {
+	return self.Frontend.Run("gh", "pr", "ready", proposalData.Data().Number.String())
+}
+
 // ============================================================================
 // search proposals
 // ============================================================================
@@ -133,13 +168,56 @@ func (self Connector) SearchProposals(branch gitdomain.LocalBranchName) ([]forge
 }
 
 // ============================================================================
-// squash-merge proposals
+// search proposal history
+// ============================================================================
+
+var _ forgedomain.ProposalHistorySearcher = ghConnector // type-check
+
+func (self Connector) SearchProposalHistory(branch gitdomain.LocalBranchName) ([]forgedomain.Proposal, error) {
+	self.Log.Start(messages.APIProposalHistorySearchStart, branch.String())
+	out, err := self.Backend.Query("gh", "pr", "list", "--head="+branch.String(), "--state=all", "--json=number,title,body,mergeable,headRefName,headRefOid,baseRefName,url,state,isDraft")
+	if err != nil {
+		self.Log.Failed(err.Error())
+		return []forgedomain.Proposal{}, err
+	}
+	proposals, err := ParseJSONOutput(out)
+	if err != nil {
+		self.Log.Failed(err.Error())
+		return []forgedomain.Proposal{}, err
+	}
+	ids := make([]string, len(proposals))
+	for p, proposal := range proposals {
+		ids[p] = colors.BoldGreen().Styled(fmt.Sprintf("#%d", proposal.Data.Data().Number))
+	}
+	if len(proposals) == 0 {
+		self.Log.Success("none")
+	} else {
+		self.Log.Log(strings.Join(ids, ", "))
+	}
+	return proposals, err
+}
+
+// ============================================================================
+// merge proposals
 // ============================================================================
 
 var _ forgedomain.ProposalMerger = ghConnector // type-check
 
-func (self Connector) SquashMergeProposal(number forgedomain.ProposalNumber, message gitdomain.CommitMessage) error {
-	return self.Frontend.Run("gh", "pr", "merge", "--squash", "--body="+message.String(), number.String())
+func (self Connector) MergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message gitdomain.CommitMessage) error {
+	args := []string{"pr", "merge"}
+	args = append(args, MergeArgs(method, Some(message))...)
+	args = append(args, number.String())
+	return self.Frontend.Run("gh", args...)
+}
+
+// MergeArgs provides the arguments for "gh pr merge" that merge using the given method and message.
+func MergeArgs(method forgedomain.ProposalMergeMethod, message Option[gitdomain.CommitMessage]) []string {
+	result := []string{"--" + method.String()}
+	// rebasing doesn't create a commit that could receive the message
+	if commitMessage, hasCommitMessage := message.Get(); hasCommitMessage && method != forgedomain.ProposalMergeMethodRebase {
+		result = append(result, "--body="+commitMessage.String())
+	}
+	return result
 }
 
 // ============================================================================
@@ -6,6 +6,7 @@ import (
 
 	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
 	"github.com/git-town/git-town/v22/internal/forge/gh"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
 	. "github.com/git-town/git-town/v22/pkg/prelude"
 	"github.com/shoenig/test/must"
 )
@@ -61,3 +62,28 @@ github.com
 		must.Eq(t, want, have)
 	})
 }
+
+func TestMergeArgs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("merge with message", func(t *testing.T) {
+		t.Parallel()
+		have := gh.MergeArgs(forgedomain.ProposalMergeMethodMerge, Some(gitdomain.CommitMessage("title\n\nbody")))
+		want := []string{"--merge", "--body=title\n\nbody"}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("rebase with message", func(t *testing.T) {
+		t.Parallel()
+		have := gh.MergeArgs(forgedomain.ProposalMergeMethodRebase, Some(gitdomain.CommitMessage("title")))
+		want := []string{"--rebase"}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("squash without message", func(t *testing.T) {
+		t.Parallel()
+		have := gh.MergeArgs(forgedomain.ProposalMergeMethodSquash, None[gitdomain.CommitMessage]())
+		want := []string{"--squash"}
+		must.Eq(t, want, have)
+	})
+}
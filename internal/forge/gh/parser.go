@@ -2,6 +2,7 @@ package gh
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
 	"github.com/git-town/git-town/v22/internal/git/gitdomain"
@@ -18,9 +19,12 @@ func ParseJSONOutput(output string) ([]forgedomain.Proposal, error) {
 	for d, data := range parsed {
 		result[d] = forgedomain.Proposal{
 			Data: forgedomain.ProposalData{
-				Active:       data.State == "open",
+				Active:       strings.EqualFold(data.State, "open"),
 				Body:         NewOption(data.Body),
+				Draft:        data.IsDraft,
+				HeadSHA:      NewOption(data.HeadRefOid),
 				MergeWithAPI: data.Mergeable == "MERGEABLE",
+				Merged:       strings.EqualFold(data.State, "merged"),
 				Number:       forgedomain.ProposalNumber(data.Number),
 				Source:       data.HeadRefName,
 				Target:       data.BaseRefName,
@@ -38,6 +42,8 @@ type jsonData struct {
 	BaseRefName gitdomain.LocalBranchName `json:"baseRefName"`
 	Body        gitdomain.ProposalBody    `json:"body"`
 	HeadRefName gitdomain.LocalBranchName `json:"headRefName"`
+	HeadRefOid  gitdomain.SHA             `json:"headRefOid"`
+	IsDraft     bool                      `json:"isDraft"`
 	Mergeable   string                    `json:"mergeable"`
 	Number      int                       `json:"number"`
 	State       string                    `json:"state"`
@@ -6,6 +6,7 @@ import (
 	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
 	"github.com/git-town/git-town/v22/internal/forge/gh"
 	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
 	"github.com/shoenig/test/must"
 )
 
@@ -62,6 +63,7 @@ func TestParseJSONOutput(t *testing.T) {
     "baseRefName": "main",
     "body": "GitLab also provides a CLI app. This PR adds support for it similar to GitHub.\n",
     "headRefName": "kg-glab",
+    "headRefOid": "2e6a0c2b9f4d5a6b7c8d9e0f1a2b3c4d5e6f7a8b",
     "mergeable": "MERGEABLE",
     "number": 5079,
     "title": "glab connector type",
@@ -74,6 +76,7 @@ func TestParseJSONOutput(t *testing.T) {
 			{
 				Data: forgedomain.ProposalData{
 					Body:         gitdomain.NewProposalBodyOpt("GitLab also provides a CLI app. This PR adds support for it similar to GitHub.\n"),
+					HeadSHA:      Some(gitdomain.NewSHA("2e6a0c2b9f4d5a6b7c8d9e0f1a2b3c4d5e6f7a8b")),
 					MergeWithAPI: true,
 					Number:       5079,
 					Source:       "kg-glab",
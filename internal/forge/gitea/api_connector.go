@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"code.gitea.io/sdk/gitea"
@@ -32,6 +33,69 @@ type AuthConnector struct {
 	log       print.Logger
 }
 
+// ============================================================================
+// create proposals
+// ============================================================================
+
+var _ forgedomain.ProposalCreator = &apiConnector // type check
+
+func (self *AuthConnector) CreateProposalViaAPI(args forgedomain.CreateProposalArgs) (forgedomain.ProposalData, error) {
+	client, err := self.getClient()
+	if err != nil {
+		return forgedomain.ProposalData{}, err
+	}
+	self.log.Start(messages.APIProposalCreateStart, args.Branch, args.ParentBranch)
+	title := args.ProposalTitle.GetOrZero()
+	if args.Draft {
+		title = forgedomain.WIPTitle(title)
+	}
+	labelIDs, err := self.labelIDs(client, args.Labels)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return forgedomain.ProposalData{}, err
+	}
+	pullRequest, _, err := client.CreatePullRequest(self.Organization, self.Repository, gitea.CreatePullRequestOption{
+		Assignees: args.Assignees.Strings(),
+		Base:      args.ParentBranch.String(),
+		Body:      args.ProposalBody.GetOrZero().String(),
+		Head:      args.Branch.String(),
+		Labels:    labelIDs,
+		Reviewers: args.Reviewers.Strings(),
+		Title:     title.String(),
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return forgedomain.ProposalData{}, err
+	}
+	proposalData := parsePullRequest(pullRequest)
+	self.log.Success("#" + proposalData.Number.String())
+	return proposalData, nil
+}
+
+// labelIDs provides the IDs of the labels with the given names in this repository.
+func (self *AuthConnector) labelIDs(client *gitea.Client, names gitdomain.ProposalMetadataNames) ([]int64, error) {
+	result := []int64{}
+	if len(names) == 0 {
+		return result, nil
+	}
+	labels, _, err := client.ListRepoLabels(self.Organization, self.Repository, gitea.ListLabelsOptions{
+		ListOptions: gitea.ListOptions{Page: -1},
+	})
+	if err != nil {
+		return result, err
+	}
+	for _, name := range names {
+		index := slices.IndexFunc(labels, func(label *gitea.Label) bool {
+			return label.Name == name.String()
+		})
+		if index < 0 {
+			return result, fmt.Errorf(messages.ForgeLabelNotFound, name)
+		}
+		result = append(result, labels[index].ID)
+	}
+	return result, nil
+}
+
 // ============================================================================
 // find proposals
 // ============================================================================
@@ -68,6 +132,26 @@ func (self *AuthConnector) FindProposal(branch, target gitdomain.LocalBranchName
 	}
 }
 
+// ============================================================================
+// mark proposals as ready for review
+// ============================================================================
+
+var _ forgedomain.ProposalReadyMarker = &apiConnector // type check
+
+func (self *AuthConnector) MarkProposalReady(proposalData forgedomain.ProposalInterface) error {
+	client, err := self.getClient()
+	if err != nil {
+		return err
+	}
+	data := proposalData.Data()
+	self.log.Start(messages.APIProposalMarkReady, colors.BoldGreen().Styled("#"+data.Number.String()))
+	_, _, err = client.EditPullRequest(self.Organization, self.Repository, data.Number.Int64(), gitea.EditPullRequestOption{
+		Title: forgedomain.WithoutWIPPrefix(data.Title).String(),
+	})
+	self.log.Finished(err)
+	return err
+}
+
 // ============================================================================
 // search proposals
 // ============================================================================
@@ -108,12 +192,12 @@ func (self *AuthConnector) SearchProposals(branch gitdomain.LocalBranchName) ([]
 }
 
 // ============================================================================
-// squash-merge proposals
+// merge proposals
 // ============================================================================
 
 var _ forgedomain.ProposalMerger = &apiConnector // type check
 
-func (self *AuthConnector) SquashMergeProposal(number forgedomain.ProposalNumber, message gitdomain.CommitMessage) error {
+func (self *AuthConnector) MergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message gitdomain.CommitMessage) error {
 	client, err := self.getClient()
 	if err != nil {
 		return err
@@ -124,7 +208,7 @@ func (self *AuthConnector) SquashMergeProposal(number forgedomain.ProposalNumber
 	commitMessageParts := message.Parts()
 	self.log.Start(messages.ForgeGithubMergingViaAPI, colors.BoldGreen().Styled(number.String()))
 	_, _, err = client.MergePullRequest(self.Organization, self.Repository, number.Int64(), gitea.MergePullRequestOption{
-		Style:   gitea.MergeStyleSquash,
+		Style:   mergeStyle(method),
 		Title:   commitMessageParts.Title.String(),
 		Message: commitMessageParts.Body,
 	})
@@ -136,6 +220,19 @@ func (self *AuthConnector) SquashMergeProposal(number forgedomain.ProposalNumber
 	return nil
 }
 
+// mergeStyle provides the Gitea merge style for the given merge method.
+func mergeStyle(method forgedomain.ProposalMergeMethod) gitea.MergeStyle {
+	switch method {
+	case forgedomain.ProposalMergeMethodMerge:
+		return gitea.MergeStyleMerge
+	case forgedomain.ProposalMergeMethodRebase:
+		return gitea.MergeStyleRebase
+	case forgedomain.ProposalMergeMethodSquash:
+		return gitea.MergeStyleSquash
+	}
+	panic("unhandled proposal merge method: " + method.String())
+}
+
 // ============================================================================
 // update proposal body
 // ============================================================================
@@ -29,7 +29,9 @@ func FilterPullRequests2(pullRequests []*gitea.PullRequest, branch gitdomain.Loc
 func parsePullRequest(pullRequest *gitea.PullRequest) forgedomain.ProposalData {
 	return forgedomain.ProposalData{
 		Active:       pullRequest.State == "open",
+		Draft:        pullRequest.Draft,
 		MergeWithAPI: pullRequest.Mergeable,
+		Merged:       pullRequest.HasMerged,
 		Number:       forgedomain.ProposalNumber(pullRequest.Index),
 		Source:       gitdomain.NewLocalBranchName(pullRequest.Head.Ref),
 		Target:       gitdomain.NewLocalBranchName(pullRequest.Base.Ref),
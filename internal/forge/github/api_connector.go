@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/git-town/git-town/v22/internal/cli/print"
@@ -28,6 +29,98 @@ type APIConnector struct {
 	log    print.Logger
 }
 
+// ============================================================================
+// auto-merge proposals
+// ============================================================================
+
+var _ forgedomain.ProposalAutoMerger = apiConnector // type check
+
+func (self APIConnector) AutoMergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message Option[gitdomain.CommitMessage]) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.APIProposalAutoMerge, colors.BoldGreen().Styled("#"+number.String()))
+	ctx := context.Background()
+	pullRequest, _, err := self.client.Value.PullRequests.Get(ctx, self.Organization, self.Repository, number.Int())
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+	// The REST API cannot enable auto-merge, only the GraphQL API can.
+	// If the target branch requires a merge queue, GitHub adds the pull request to it once all checks pass.
+	input := map[string]any{
+		"mergeMethod":   strings.ToUpper(method.String()),
+		"pullRequestId": pullRequest.GetNodeID(),
+	}
+	if commitMessage, hasCommitMessage := message.Get(); hasCommitMessage {
+		commitMessageParts := commitMessage.Parts()
+		input["commitBody"] = commitMessageParts.Body
+		input["commitHeadline"] = commitMessageParts.Title.String()
+	}
+	err = self.runGraphQLMutation(ctx, "mutation($input: EnablePullRequestAutoMergeInput!) { enablePullRequestAutoMerge(input: $input) { clientMutationId } }", map[string]any{
+		"input": input,
+	})
+	self.log.Finished(err)
+	return err
+}
+
+// ============================================================================
+// create proposals
+// ============================================================================
+
+var _ forgedomain.ProposalCreator = apiConnector // type check
+
+func (self APIConnector) CreateProposalViaAPI(args forgedomain.CreateProposalArgs) (forgedomain.ProposalData, error) {
+	self.log.Start(messages.APIProposalCreateStart, args.Branch, args.ParentBranch)
+	pullRequest, _, err := self.client.Value.PullRequests.Create(context.Background(), self.Organization, self.Repository, &github.NewPullRequest{
+		Base:  new(args.ParentBranch.String()),
+		Body:  new(args.ProposalBody.GetOrZero().String()),
+		Draft: new(args.Draft),
+		Head:  new(args.Branch.String()),
+		Title: new(args.ProposalTitle.GetOrZero().String()),
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return forgedomain.ProposalData{}, err
+	}
+	proposalData := parsePullRequest(pullRequest)
+	self.log.Success("#" + proposalData.Number.String())
+	return proposalData, self.addProposalMetadata(proposalData.Number, args)
+}
+
+// addProposalMetadata adds the assignees, labels, and reviewers in the given args to the proposal with the given number.
+func (self APIConnector) addProposalMetadata(number forgedomain.ProposalNumber, args forgedomain.CreateProposalArgs) error {
+	ctx := context.Background()
+	proposalName := colors.BoldGreen().Styled("#" + number.String())
+	if len(args.Labels) > 0 {
+		self.log.Start(messages.APIProposalAddLabels, args.Labels.Join(", "), proposalName)
+		_, _, err := self.client.Value.Issues.AddLabelsToIssue(ctx, self.Organization, self.Repository, number.Int(), args.Labels.Strings())
+		self.log.Finished(err)
+		if err != nil {
+			return err
+		}
+	}
+	if len(args.Assignees) > 0 {
+		self.log.Start(messages.APIProposalAddAssignees, args.Assignees.Join(", "), proposalName)
+		_, _, err := self.client.Value.Issues.AddAssignees(ctx, self.Organization, self.Repository, number.Int(), args.Assignees.Strings())
+		self.log.Finished(err)
+		if err != nil {
+			return err
+		}
+	}
+	if len(args.Reviewers) > 0 {
+		self.log.Start(messages.APIProposalRequestReviewers, args.Reviewers.Join(", "), proposalName)
+		_, _, err := self.client.Value.PullRequests.RequestReviewers(ctx, self.Organization, self.Repository, number.Int(), github.ReviewersRequest{
+			Reviewers: args.Reviewers.Strings(),
+		})
+		self.log.Finished(err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ============================================================================
 // find proposals
 // ============================================================================
@@ -58,6 +151,53 @@ func (self APIConnector) FindProposal(branch, target gitdomain.LocalBranchName)
 	return Some(proposal), nil
 }
 
+// ============================================================================
+// mark proposals as ready for review
+// ============================================================================
+
+var _ forgedomain.ProposalReadyMarker = apiConnector // type check
+
+func (self APIConnector) MarkProposalReady(proposalData forgedomain.ProposalInterface) error {
+	data := proposalData.Data()
+	self.log.Start(messages.APIProposalMarkReady, colors.BoldGreen().Styled("#"+data.Number.String()))
+	ctx := context.Background()
+	pullRequest, _, err := self.client.Value.PullRequests.Get(ctx, self.Organization, self.Repository, data.Number.Int())
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+	// The REST API cannot change the draft status of pull requests, only the GraphQL API can.
+	err = self.runGraphQLMutation(ctx, "mutation($id: ID!) { markPullRequestReadyForReview(input: {pullRequestId: $id}) { clientMutationId } }", map[string]any{
+		"id": pullRequest.GetNodeID(),
+	})
+	self.log.Finished(err)
+	return err
+}
+
+// runGraphQLMutation executes the given mutation against the GraphQL API of GitHub.
+// The GraphQL endpoint is a sibling of the REST endpoint on both github.com and GitHub Enterprise.
+func (self APIConnector) runGraphQLMutation(ctx context.Context, mutation string, variables map[string]any) error {
+	request, err := self.client.Value.NewRequest(http.MethodPost, "../graphql", map[string]any{
+		"query":     mutation,
+		"variables": variables,
+	})
+	if err != nil {
+		return err
+	}
+	var response struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if _, err = self.client.Value.Do(ctx, request, &response); err != nil {
+		return err
+	}
+	if len(response.Errors) > 0 {
+		return errors.New(response.Errors[0].Message)
+	}
+	return nil
+}
+
 // ============================================================================
 // search proposals
 // ============================================================================
@@ -91,19 +231,51 @@ func (self APIConnector) SearchProposals(branch gitdomain.LocalBranchName) ([]fo
 }
 
 // ============================================================================
-// squash-merge proposals
+// search proposal history
+// ============================================================================
+
+var _ forgedomain.ProposalHistorySearcher = apiConnector // type check
+
+func (self APIConnector) SearchProposalHistory(branch gitdomain.LocalBranchName) ([]forgedomain.Proposal, error) {
+	self.log.Start(messages.APIProposalHistorySearchStart, branch.String())
+	pullRequests, _, err := self.client.Value.PullRequests.List(context.Background(), self.Organization, self.Repository, &github.PullRequestListOptions{
+		Head:  self.Organization + ":" + branch.String(),
+		State: "all",
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return []forgedomain.Proposal{}, err
+	}
+	result := make([]forgedomain.Proposal, len(pullRequests))
+	ids := make([]string, len(pullRequests))
+	for p, pullRequest := range pullRequests {
+		proposalData := parsePullRequest(pullRequest)
+		proposal := forgedomain.Proposal{Data: proposalData, ForgeType: forgedomain.ForgeTypeGithub}
+		result[p] = proposal
+		ids[p] = colors.BoldGreen().Styled(fmt.Sprintf("#%d", proposalData.Number))
+	}
+	if len(pullRequests) == 0 {
+		self.log.Success("none")
+	} else {
+		self.log.Log(strings.Join(ids, ", "))
+	}
+	return result, nil
+}
+
+// ============================================================================
+// merge proposals
 // ============================================================================
 
 var _ forgedomain.ProposalMerger = apiConnector // type check
 
-func (self APIConnector) SquashMergeProposal(number forgedomain.ProposalNumber, message gitdomain.CommitMessage) error {
+func (self APIConnector) MergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message gitdomain.CommitMessage) error {
 	if number <= 0 {
 		return errors.New(messages.ProposalNoNumberGiven)
 	}
 	self.log.Start(messages.ForgeGithubMergingViaAPI, colors.BoldGreen().Styled("#"+number.String()))
 	commitMessageParts := message.Parts()
 	_, _, err := self.client.Value.PullRequests.Merge(context.Background(), self.Organization, self.Repository, number.Int(), commitMessageParts.Body, &github.PullRequestOptions{
-		MergeMethod: "squash",
+		MergeMethod: method.String(),
 		CommitTitle: commitMessageParts.Title.String(),
 	})
 	self.log.Finished(err)
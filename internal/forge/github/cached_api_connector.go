@@ -31,6 +31,32 @@ func (self *CachedAPIConnector) DefaultProposalMessage(proposalData forgedomain.
 	return self.api.DefaultProposalMessage(proposalData)
 }
 
+// ============================================================================
+// auto-merge proposals
+// ============================================================================
+
+var _ forgedomain.ProposalAutoMerger = &cachedAPIConnector // type check
+
+func (self *CachedAPIConnector) AutoMergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message Option[gitdomain.CommitMessage]) error {
+	self.cache.Clear(number)
+	return self.api.AutoMergeProposal(number, method, message)
+}
+
+// ============================================================================
+// create proposals
+// ============================================================================
+
+var _ forgedomain.ProposalCreator = &cachedAPIConnector // type check
+
+func (self *CachedAPIConnector) CreateProposalViaAPI(args forgedomain.CreateProposalArgs) (forgedomain.ProposalData, error) {
+	proposalData, err := self.api.CreateProposalViaAPI(args)
+	if err == nil {
+		self.cache.Clear(proposalData.Number)
+		self.cache.RegisterLookupResult(proposalData.Source, proposalData.Target, Some(forgedomain.Proposal{Data: proposalData, ForgeType: forgedomain.ForgeTypeGithub}))
+	}
+	return proposalData, err
+}
+
 // ============================================================================
 // find proposals
 // ============================================================================
@@ -48,6 +74,17 @@ func (self *CachedAPIConnector) FindProposal(source, target gitdomain.LocalBranc
 	return loadedProposal, err
 }
 
+// ============================================================================
+// mark proposals as ready for review
+// ============================================================================
+
+var _ forgedomain.ProposalReadyMarker = &cachedAPIConnector // type check
+
+func (self *CachedAPIConnector) MarkProposalReady(proposalData forgedomain.ProposalInterface) error {
+	self.cache.Clear(proposalData.Data().Number)
+	return self.api.MarkProposalReady(proposalData)
+}
+
 // ============================================================================
 // search proposals
 // ============================================================================
@@ -66,14 +103,24 @@ func (self *CachedAPIConnector) SearchProposals(source gitdomain.LocalBranchName
 }
 
 // ============================================================================
-// squash-merge proposals
+// search proposal history
+// ============================================================================
+
+var _ forgedomain.ProposalHistorySearcher = &cachedAPIConnector // type check
+
+func (self *CachedAPIConnector) SearchProposalHistory(source gitdomain.LocalBranchName) ([]forgedomain.Proposal, error) {
+	return self.api.SearchProposalHistory(source)
+}
+
+// ============================================================================
+// merge proposals
 // ============================================================================
 
 var _ forgedomain.ProposalMerger = &cachedAPIConnector // type check
 
-func (self *CachedAPIConnector) SquashMergeProposal(number forgedomain.ProposalNumber, message gitdomain.CommitMessage) error {
+func (self *CachedAPIConnector) MergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message gitdomain.CommitMessage) error {
 	self.cache.Clear(number)
-	return self.api.SquashMergeProposal(number, message)
+	return self.api.MergeProposal(number, method, message)
 }
 
 // ============================================================================
@@ -27,6 +27,64 @@ type MockConnector struct {
 	log           print.Logger
 }
 
+// ============================================================================
+// auto-merge proposals
+// ============================================================================
+
+var _ forgedomain.ProposalAutoMerger = &mockAPIConnector // type check
+
+func (self *MockConnector) AutoMergeProposal(number forgedomain.ProposalNumber, _ forgedomain.ProposalMergeMethod, _ Option[gitdomain.CommitMessage]) error {
+	self.cache.Clear(number)
+	self.log.Start(messages.APIProposalAutoMerge, colors.BoldGreen().Styled("#"+number.String()))
+	if self.Proposals.FindByID(number).IsNone() {
+		return fmt.Errorf("proposal with id %d not found", number)
+	}
+	self.log.Finished(nil)
+	return nil
+}
+
+// ============================================================================
+// create proposals
+// ============================================================================
+
+var _ forgedomain.ProposalCreator = &mockAPIConnector // type check
+
+func (self *MockConnector) CreateProposalViaAPI(args forgedomain.CreateProposalArgs) (forgedomain.ProposalData, error) {
+	self.log.Start(messages.APIProposalCreateStart, args.Branch, args.ParentBranch)
+	number := self.Proposals.NextID()
+	proposalData := forgedomain.ProposalData{
+		Active:       true,
+		Body:         args.ProposalBody,
+		Draft:        args.Draft,
+		MergeWithAPI: true,
+		Merged:       false,
+		Number:       number,
+		Source:       args.Branch,
+		Target:       args.ParentBranch,
+		Title:        args.ProposalTitle.GetOrZero(),
+		URL:          fmt.Sprintf("%s/pull/%d", self.RepositoryURL(), number),
+	}
+	self.Proposals = append(self.Proposals, proposalData)
+	mockproposals.Save(self.ProposalsPath, self.Proposals)
+	self.cache.Clear(number)
+	self.cache.RegisterLookupResult(proposalData.Source, proposalData.Target, Some(forgedomain.Proposal{Data: proposalData, ForgeType: forgedomain.ForgeTypeGithub}))
+	self.log.Success("#" + number.String())
+	proposalName := colors.BoldGreen().Styled("#" + number.String())
+	if len(args.Labels) > 0 {
+		self.log.Start(messages.APIProposalAddLabels, args.Labels.Join(", "), proposalName)
+		self.log.Ok()
+	}
+	if len(args.Assignees) > 0 {
+		self.log.Start(messages.APIProposalAddAssignees, args.Assignees.Join(", "), proposalName)
+		self.log.Ok()
+	}
+	if len(args.Reviewers) > 0 {
+		self.log.Start(messages.APIProposalRequestReviewers, args.Reviewers.Join(", "), proposalName)
+		self.log.Ok()
+	}
+	return proposalData, nil
+}
+
 // ============================================================================
 // find proposals
 // ============================================================================
@@ -39,7 +97,7 @@ func (self *MockConnector) FindProposal(source, target gitdomain.LocalBranchName
 	}
 	self.log.Start(messages.APIProposalFindStart, source, target)
 	data, has := self.Proposals.FindBySourceAndTarget(source, target).Get()
-	if !has {
+	if !has || !data.Active {
 		self.log.Success("none")
 		self.cache.RegisterLookupResult(source, target, None[forgedomain.Proposal]())
 		return None[forgedomain.Proposal](), nil
@@ -50,6 +108,26 @@ func (self *MockConnector) FindProposal(source, target gitdomain.LocalBranchName
 	return Some(proposal), nil
 }
 
+// ============================================================================
+// mark proposals as ready for review
+// ============================================================================
+
+var _ forgedomain.ProposalReadyMarker = &mockAPIConnector // type check
+
+func (self *MockConnector) MarkProposalReady(proposalData forgedomain.ProposalInterface) error {
+	self.cache.Clear(proposalData.Data().Number)
+	self.log.Start(messages.APIProposalMarkReady, colors.BoldGreen().Styled("#"+proposalData.Data().Number.String()))
+	proposal, hasProposal := self.Proposals.FindByID(proposalData.Data().Number).Get()
+	if !hasProposal {
+		return fmt.Errorf("proposal with id %d not found", proposalData.Data().Number)
+	}
+	proposal.Draft = false
+	self.Proposals.Update(proposal)
+	mockproposals.Save(self.ProposalsPath, self.Proposals)
+	self.log.Finished(nil)
+	return nil
+}
+
 // ============================================================================
 // search proposals
 // ============================================================================
@@ -62,6 +140,28 @@ func (self *MockConnector) SearchProposals(source gitdomain.LocalBranchName) ([]
 	}
 	self.log.Start(messages.APIProposalSearchStart, source.String())
 	result := []forgedomain.Proposal{}
+	for _, data := range self.Proposals.FindBySource(source) {
+		if !data.Active {
+			continue
+		}
+		self.log.Success(data.Target.String())
+		result = append(result, forgedomain.Proposal{Data: data, ForgeType: forgedomain.ForgeTypeGithub})
+	}
+	if len(result) == 0 {
+		self.log.Success("none")
+	}
+	return result, nil
+}
+
+// ============================================================================
+// search proposal history
+// ============================================================================
+
+var _ forgedomain.ProposalHistorySearcher = &mockAPIConnector // type check
+
+func (self *MockConnector) SearchProposalHistory(source gitdomain.LocalBranchName) ([]forgedomain.Proposal, error) {
+	self.log.Start(messages.APIProposalHistorySearchStart, source.String())
+	result := []forgedomain.Proposal{}
 	for _, data := range self.Proposals.FindBySource(source) {
 		self.log.Success(data.Target.String())
 		result = append(result, forgedomain.Proposal{Data: data, ForgeType: forgedomain.ForgeTypeGithub})
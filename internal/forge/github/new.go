@@ -89,7 +89,10 @@ func parsePullRequest(pullRequest *github.PullRequest) forgedomain.ProposalData
 		Source:       gitdomain.NewLocalBranchName(pullRequest.Head.GetRef()),
 		Target:       gitdomain.NewLocalBranchName(pullRequest.Base.GetRef()),
 		Title:        gitdomain.ProposalTitle(pullRequest.GetTitle()),
+		Draft:        pullRequest.GetDraft(),
+		HeadSHA:      NewOption(gitdomain.SHA(pullRequest.Head.GetSHA())),
 		MergeWithAPI: pullRequest.GetMergeableState() == "clean",
+		Merged:       pullRequest.MergedAt != nil,
 		URL:          *pullRequest.HTMLURL,
 	}
 }
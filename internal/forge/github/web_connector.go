@@ -48,6 +48,13 @@ func (self WebConnector) NewProposalURL(data forgedomain.CreateProposalArgs) str
 	if body, hasBody := data.ProposalBody.Get(); hasBody {
 		result += "&body=" + url.QueryEscape(body.String())
 	}
+	// GitHub pre-populates labels and assignees from the URL, but not reviewers
+	if len(data.Labels) > 0 {
+		result += "&labels=" + url.QueryEscape(data.Labels.Join(","))
+	}
+	if len(data.Assignees) > 0 {
+		result += "&assignees=" + url.QueryEscape(data.Assignees.Join(","))
+	}
 	return result
 }
 
@@ -114,6 +114,27 @@ func TestWebConnector(t *testing.T) {
 		}
 	})
 
+	t.Run("NewProposalURL with labels and assignees", func(t *testing.T) {
+		t.Parallel()
+		connector := github.WebConnector{
+			HostedRepoInfo: forgedomain.HostedRepoInfo{
+				Hostname:     "github.com",
+				Organization: "organization",
+				Repository:   "repo",
+			},
+		}
+		have := connector.NewProposalURL(forgedomain.CreateProposalArgs{
+			Assignees:    gitdomain.NewProposalMetadataNames("alice"),
+			Branch:       "feature",
+			Labels:       gitdomain.NewProposalMetadataNames("team-a", "backend"),
+			MainBranch:   "main",
+			ParentBranch: "main",
+			Reviewers:    gitdomain.NewProposalMetadataNames("bob"),
+		})
+		want := "https://github.com/organization/repo/compare/feature?expand=1&labels=team-a%2Cbackend&assignees=alice"
+		must.EqOp(t, want, have)
+	})
+
 	t.Run("RepositoryURL", func(t *testing.T) {
 		t.Parallel()
 		connector := github.WebConnector{
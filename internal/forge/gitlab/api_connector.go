@@ -27,6 +27,95 @@ type APIConnector struct {
 	log    print.Logger
 }
 
+// ============================================================================
+// auto-merge proposals
+// ============================================================================
+
+var _ forgedomain.ProposalAutoMerger = apiConnector
+
+func (self APIConnector) AutoMergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message Option[gitdomain.CommitMessage]) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.APIProposalAutoMerge, colors.BoldGreen().Styled("!"+number.String()))
+	// If the project uses merge trains, GitLab adds the merge request to the merge train once its pipeline succeeds.
+	options := gitlab.AcceptMergeRequestOptions{
+		AutoMerge: new(true),
+		// Git Town cannot delete the branch because GitLab merges it later.
+		// Deleting it at the forge allows "git town sync" to remove the local branch afterwards.
+		ShouldRemoveSourceBranch: new(true),
+	}
+	setMergeMethod(&options, method, message)
+	_, _, err := self.client.MergeRequests.AcceptMergeRequest(self.projectPath(), number.Int(), &options)
+	self.log.Finished(err)
+	return err
+}
+
+// ============================================================================
+// create proposals
+// ============================================================================
+
+var _ forgedomain.ProposalCreator = apiConnector
+
+func (self APIConnector) CreateProposalViaAPI(args forgedomain.CreateProposalArgs) (forgedomain.ProposalData, error) {
+	self.log.Start(messages.APIProposalCreateStart, args.Branch, args.ParentBranch)
+	title := args.ProposalTitle.GetOrZero()
+	if args.Draft {
+		title = DraftTitle(title)
+	}
+	options := gitlab.CreateMergeRequestOptions{
+		Description:  new(args.ProposalBody.GetOrZero().String()),
+		SourceBranch: new(args.Branch.String()),
+		TargetBranch: new(args.ParentBranch.String()),
+		Title:        new(title.String()),
+	}
+	if len(args.Labels) > 0 {
+		options.Labels = new(gitlab.LabelOptions(args.Labels.Strings()))
+	}
+	if len(args.Assignees) > 0 {
+		assigneeIDs, err := self.userIDs(args.Assignees.Strings())
+		if err != nil {
+			self.log.Failed(err.Error())
+			return forgedomain.ProposalData{}, err
+		}
+		options.AssigneeIDs = &assigneeIDs
+	}
+	if len(args.Reviewers) > 0 {
+		reviewerIDs, err := self.userIDs(args.Reviewers.Strings())
+		if err != nil {
+			self.log.Failed(err.Error())
+			return forgedomain.ProposalData{}, err
+		}
+		options.ReviewerIDs = &reviewerIDs
+	}
+	mergeRequest, _, err := self.client.MergeRequests.CreateMergeRequest(self.projectPath(), &options)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return forgedomain.ProposalData{}, err
+	}
+	proposalData := parseMergeRequest(&mergeRequest.BasicMergeRequest)
+	self.log.Success("#" + proposalData.Number.String())
+	return proposalData, nil
+}
+
+// userIDs provides the GitLab IDs of the users with the given usernames.
+func (self APIConnector) userIDs(usernames []string) ([]int, error) {
+	result := make([]int, len(usernames))
+	for u, username := range usernames {
+		users, _, err := self.client.Users.ListUsers(&gitlab.ListUsersOptions{
+			Username: new(username),
+		})
+		if err != nil {
+			return result, err
+		}
+		if len(users) == 0 {
+			return result, fmt.Errorf(messages.ForgeUserNotFound, username)
+		}
+		result[u] = users[0].ID
+	}
+	return result, nil
+}
+
 // ============================================================================
 // find proposals
 // ============================================================================
@@ -58,6 +147,22 @@ func (self APIConnector) FindProposal(branch, target gitdomain.LocalBranchName)
 	}
 }
 
+// ============================================================================
+// mark proposals as ready for review
+// ============================================================================
+
+var _ forgedomain.ProposalReadyMarker = apiConnector
+
+func (self APIConnector) MarkProposalReady(proposalData forgedomain.ProposalInterface) error {
+	data := proposalData.Data()
+	self.log.Start(messages.APIProposalMarkReady, colors.BoldGreen().Styled("#"+data.Number.String()))
+	_, _, err := self.client.MergeRequests.UpdateMergeRequest(self.projectPath(), data.Number.Int(), &gitlab.UpdateMergeRequestOptions{
+		Title: new(ReadyTitle(data.Title).String()),
+	})
+	self.log.Finished(err)
+	return err
+}
+
 // ============================================================================
 // search proposals
 // ============================================================================
@@ -92,27 +197,78 @@ func (self APIConnector) SearchProposals(branch gitdomain.LocalBranchName) ([]fo
 }
 
 // ============================================================================
-// squash-merge proposals
+// search proposal history
+// ============================================================================
+
+var _ forgedomain.ProposalHistorySearcher = apiConnector
+
+func (self APIConnector) SearchProposalHistory(branch gitdomain.LocalBranchName) ([]forgedomain.Proposal, error) {
+	self.log.Start(messages.APIProposalHistorySearchStart, branch.String())
+	opts := &gitlab.ListProjectMergeRequestsOptions{
+		State:        new("all"),
+		SourceBranch: new(branch.String()),
+	}
+	mergeRequests, _, err := self.client.MergeRequests.ListProjectMergeRequests(self.projectPath(), opts)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return []forgedomain.Proposal{}, err
+	}
+	result := make([]forgedomain.Proposal, len(mergeRequests))
+	ids := make([]string, len(mergeRequests))
+	for m, mergeRequest := range mergeRequests {
+		proposalData := parseMergeRequest(mergeRequest)
+		proposal := forgedomain.Proposal{Data: proposalData, ForgeType: forgedomain.ForgeTypeGitlab}
+		result[m] = proposal
+		ids[m] = colors.BoldGreen().Styled(fmt.Sprintf("#%d", proposalData.Number))
+	}
+	if len(result) == 0 {
+		self.log.Success("none")
+	} else {
+		self.log.Log(strings.Join(ids, ", "))
+	}
+	return result, nil
+}
+
+// ============================================================================
+// merge proposals
 // ============================================================================
 
 var _ forgedomain.ProposalMerger = apiConnector
 
-func (self APIConnector) SquashMergeProposal(number forgedomain.ProposalNumber, message gitdomain.CommitMessage) error {
+func (self APIConnector) MergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message gitdomain.CommitMessage) error {
 	if number <= 0 {
 		return errors.New(messages.ProposalNoNumberGiven)
 	}
 	self.log.Start(messages.ForgeGitlabMergingViaAPI, number)
-	// the GitLab API wants the full commit message in the body
-	_, _, err := self.client.MergeRequests.AcceptMergeRequest(self.projectPath(), number.Int(), &gitlab.AcceptMergeRequestOptions{
-		SquashCommitMessage: new(message.String()),
-		Squash:              new(true),
+	options := gitlab.AcceptMergeRequestOptions{
 		// the branch will be deleted by Git Town
 		ShouldRemoveSourceBranch: new(false),
-	})
+	}
+	setMergeMethod(&options, method, Some(message))
+	_, _, err := self.client.MergeRequests.AcceptMergeRequest(self.projectPath(), number.Int(), &options)
 	self.log.Finished(err)
 	return err
 }
 
+// setMergeMethod configures the given options to merge using the given method and the given commit message if provided.
+// GitLab has no per-request setting for rebasing: the merge method of the project determines
+// whether non-squashed merges create a merge commit or fast-forward the target branch.
+func setMergeMethod(options *gitlab.AcceptMergeRequestOptions, method forgedomain.ProposalMergeMethod, message Option[gitdomain.CommitMessage]) {
+	options.Squash = new(method == forgedomain.ProposalMergeMethodSquash)
+	commitMessage, hasCommitMessage := message.Get()
+	if !hasCommitMessage {
+		return
+	}
+	// the GitLab API wants the full commit message in the body
+	switch method {
+	case forgedomain.ProposalMergeMethodSquash:
+		options.SquashCommitMessage = new(commitMessage.String())
+	case forgedomain.ProposalMergeMethodMerge:
+		options.MergeCommitMessage = new(commitMessage.String())
+	case forgedomain.ProposalMergeMethodRebase:
+	}
+}
+
 // ============================================================================
 // update proposal body
 // ============================================================================
@@ -0,0 +1,21 @@
+package gitlab
+
+import (
+	"regexp"
+
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+)
+
+// GitLab has no dedicated API field for draft merge requests.
+// It marks merge requests as drafts if their title starts with one of these prefixes.
+var draftPrefixRE = regexp.MustCompile(`(?i)^\s*(\[draft\]|\(draft\)|draft:|draft\s+-)\s*`)
+
+// DraftTitle provides the title that marks a merge request with the given title as a draft.
+func DraftTitle(title gitdomain.ProposalTitle) gitdomain.ProposalTitle {
+	return "Draft: " + ReadyTitle(title)
+}
+
+// ReadyTitle provides the given merge request title without the prefix that marks it as a draft.
+func ReadyTitle(title gitdomain.ProposalTitle) gitdomain.ProposalTitle {
+	return gitdomain.ProposalTitle(draftPrefixRE.ReplaceAllString(title.String(), ""))
+}
@@ -0,0 +1,43 @@
+package gitlab_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/forge/gitlab"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestDraft(t *testing.T) {
+	t.Parallel()
+
+	t.Run("DraftTitle", func(t *testing.T) {
+		t.Parallel()
+		tests := map[gitdomain.ProposalTitle]gitdomain.ProposalTitle{
+			"my title":        "Draft: my title",
+			"Draft: my title": "Draft: my title",
+			"[Draft] title":   "Draft: title",
+		}
+		for give, want := range tests {
+			have := gitlab.DraftTitle(give)
+			must.EqOp(t, want, have)
+		}
+	})
+
+	t.Run("ReadyTitle", func(t *testing.T) {
+		t.Parallel()
+		tests := map[gitdomain.ProposalTitle]gitdomain.ProposalTitle{
+			"my title":          "my title",
+			"Draft: my title":   "my title",
+			"draft:my title":    "my title",
+			"[Draft] my title":  "my title",
+			"(draft) my title":  "my title",
+			"Draft - my title":  "my title",
+			"my title (Draft:)": "my title (Draft:)",
+		}
+		for give, want := range tests {
+			have := gitlab.ReadyTitle(give)
+			must.EqOp(t, want, have)
+		}
+	})
+}
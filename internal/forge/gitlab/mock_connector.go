@@ -27,6 +27,51 @@ type MockConnector struct {
 	log           print.Logger
 }
 
+// ============================================================================
+// auto-merge proposals
+// ============================================================================
+
+var _ forgedomain.ProposalAutoMerger = &mockAPIConnector // type check
+
+func (self *MockConnector) AutoMergeProposal(number forgedomain.ProposalNumber, _ forgedomain.ProposalMergeMethod, _ Option[gitdomain.CommitMessage]) error {
+	self.cache.Clear(number)
+	self.log.Start(messages.APIProposalAutoMerge, colors.BoldGreen().Styled("#"+number.String()))
+	if self.Proposals.FindByID(number).IsNone() {
+		return fmt.Errorf("proposal with id %d not found", number)
+	}
+	self.log.Finished(nil)
+	return nil
+}
+
+// ============================================================================
+// create proposals
+// ============================================================================
+
+var _ forgedomain.ProposalCreator = &mockAPIConnector // type check
+
+func (self *MockConnector) CreateProposalViaAPI(args forgedomain.CreateProposalArgs) (forgedomain.ProposalData, error) {
+	self.log.Start(messages.APIProposalCreateStart, args.Branch, args.ParentBranch)
+	number := self.Proposals.NextID()
+	proposalData := forgedomain.ProposalData{
+		Active:       true,
+		Body:         args.ProposalBody,
+		Draft:        args.Draft,
+		MergeWithAPI: true,
+		Merged:       false,
+		Number:       number,
+		Source:       args.Branch,
+		Target:       args.ParentBranch,
+		Title:        args.ProposalTitle.GetOrZero(),
+		URL:          fmt.Sprintf("%s/-/merge_requests/%d", self.RepositoryURL(), number),
+	}
+	self.Proposals = append(self.Proposals, proposalData)
+	mockproposals.Save(self.ProposalsPath, self.Proposals)
+	self.cache.Clear(number)
+	self.cache.RegisterLookupResult(proposalData.Source, proposalData.Target, Some(forgedomain.Proposal{Data: proposalData, ForgeType: forgedomain.ForgeTypeGitlab}))
+	self.log.Success("#" + number.String())
+	return proposalData, nil
+}
+
 // ============================================================================
 // find proposals
 // ============================================================================
@@ -49,3 +94,23 @@ func (self *MockConnector) FindProposal(source, target gitdomain.LocalBranchName
 	self.cache.RegisterLookupResult(source, target, Some(proposal))
 	return Some(proposal), nil
 }
+
+// ============================================================================
+// mark proposals as ready for review
+// ============================================================================
+
+var _ forgedomain.ProposalReadyMarker = &mockAPIConnector // type check
+
+func (self *MockConnector) MarkProposalReady(proposalData forgedomain.ProposalInterface) error {
+	self.cache.Clear(proposalData.Data().Number)
+	self.log.Start(messages.APIProposalMarkReady, colors.BoldGreen().Styled("#"+proposalData.Data().Number.String()))
+	proposal, hasProposal := self.Proposals.FindByID(proposalData.Data().Number).Get()
+	if !hasProposal {
+		return fmt.Errorf("proposal with id %d not found", proposalData.Data().Number)
+	}
+	proposal.Draft = false
+	self.Proposals.Update(proposal)
+	mockproposals.Save(self.ProposalsPath, self.Proposals)
+	self.log.Finished(nil)
+	return nil
+}
@@ -3,13 +3,17 @@ package gitlab
 import (
 	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
 	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 )
 
 func parseMergeRequest(mergeRequest *gitlab.BasicMergeRequest) forgedomain.ProposalData {
 	return forgedomain.ProposalData{
 		Active:       mergeRequest.State == "opened",
+		Draft:        mergeRequest.Draft,
+		HeadSHA:      NewOption(gitdomain.SHA(mergeRequest.SHA)),
 		MergeWithAPI: true,
+		Merged:       mergeRequest.State == "merged",
 		Number:       forgedomain.ProposalNumber(mergeRequest.IID),
 		Source:       gitdomain.NewLocalBranchName(mergeRequest.SourceBranch),
 		Target:       gitdomain.NewLocalBranchName(mergeRequest.TargetBranch),
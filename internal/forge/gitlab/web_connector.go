@@ -42,6 +42,9 @@ func (self WebConnector) NewProposalURL(data forgedomain.CreateProposalArgs) str
 	query.Add("merge_request[source_branch]", data.Branch.String())
 	query.Add("merge_request[target_branch]", data.ParentBranch.String())
 	if title, hasTitle := data.ProposalTitle.Get(); hasTitle {
+		if data.Draft {
+			title = DraftTitle(title)
+		}
 		query.Add("merge_request[title]", title.String())
 	}
 	if body, hasBody := data.ProposalBody.Get(); hasBody {
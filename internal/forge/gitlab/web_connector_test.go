@@ -47,6 +47,7 @@ func TestGitlabWebConnector(t *testing.T) {
 			parent gitdomain.LocalBranchName
 			title  Option[gitdomain.ProposalTitle]
 			body   Option[gitdomain.ProposalBody]
+			draft  bool
 			want   string
 		}{
 			"top-level branch": {
@@ -85,6 +86,13 @@ func TestGitlabWebConnector(t *testing.T) {
 				body:   gitdomain.NewProposalBodyOpt("my body"),
 				want:   "https://gitlab.com/organization/repo/-/merge_requests/new?merge_request%5Bdescription%5D=my+body&merge_request%5Bsource_branch%5D=feature&merge_request%5Btarget_branch%5D=main&merge_request%5Btitle%5D=my+title",
 			},
+			"draft proposal with title": {
+				branch: "feature",
+				parent: "main",
+				title:  Some(gitdomain.ProposalTitle("my title")),
+				draft:  true,
+				want:   "https://gitlab.com/organization/repo/-/merge_requests/new?merge_request%5Bsource_branch%5D=feature&merge_request%5Btarget_branch%5D=main&merge_request%5Btitle%5D=Draft%3A+my+title",
+			},
 		}
 		for name, tt := range tests {
 			t.Run(name, func(t *testing.T) {
@@ -98,6 +106,7 @@ func TestGitlabWebConnector(t *testing.T) {
 				}
 				have := connector.NewProposalURL(forgedomain.CreateProposalArgs{
 					Branch:        tt.branch,
+					Draft:         tt.draft,
 					MainBranch:    "main",
 					ParentBranch:  tt.parent,
 					ProposalBody:  tt.body,
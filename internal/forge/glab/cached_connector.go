@@ -20,6 +20,17 @@ type CachedConnector struct {
 	Connector Connector
 }
 
+// ============================================================================
+// auto-merge proposals
+// ============================================================================
+
+var _ forgedomain.ProposalAutoMerger = &cachedConnector // type check
+
+func (self *CachedConnector) AutoMergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message Option[gitdomain.CommitMessage]) error {
+	self.Cache.Clear(number)
+	return self.Connector.AutoMergeProposal(number, method, message)
+}
+
 // ============================================================================
 // browse the repo
 // ============================================================================
@@ -57,6 +68,17 @@ func (self *CachedConnector) FindProposal(source, target gitdomain.LocalBranchNa
 	return loadedProposal, err
 }
 
+// ============================================================================
+// mark proposals as ready for review
+// ============================================================================
+
+var _ forgedomain.ProposalReadyMarker = &cachedConnector // type check
+
+func (self *CachedConnector) MarkProposalReady(proposalData forgedomain.ProposalInterface) error {
+	self.Cache.Clear(proposalData.Data().Number)
+	return self.Connector.MarkProposalReady(proposalData)
+}
+
 // ============================================================================
 // search proposals
 // ============================================================================
@@ -75,14 +97,14 @@ func (self *CachedConnector) SearchProposals(source gitdomain.LocalBranchName) (
 }
 
 // ============================================================================
-// squash-merge proposals
+// merge proposals
 // ============================================================================
 
 var _ forgedomain.ProposalMerger = &cachedConnector // type check
 
-func (self *CachedConnector) SquashMergeProposal(number forgedomain.ProposalNumber, message gitdomain.CommitMessage) error {
+func (self *CachedConnector) MergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message gitdomain.CommitMessage) error {
 	self.Cache.Clear(number)
-	return self.Connector.SquashMergeProposal(number, message)
+	return self.Connector.MergeProposal(number, method, message)
 }
 
 // ============================================================================
@@ -28,6 +28,19 @@ type Connector struct {
 	Log      print.Logger
 }
 
+// ============================================================================
+// auto-merge proposals
+// ============================================================================
+
+var _ forgedomain.ProposalAutoMerger = glabConnector // type check
+
+func (self Connector) AutoMergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message Option[gitdomain.CommitMessage]) error {
+	args := []string{"mr", "merge", "--auto-merge", "--remove-source-branch"}
+	args = append(args, MergeArgs(method, message)...)
+	args = append(args, number.String())
+	return self.Frontend.Run("glab", args...)
+}
+
 // ============================================================================
 // browse the repo
 // ============================================================================
@@ -53,6 +66,18 @@ func (self Connector) CreateProposal(data forgedomain.CreateProposalArgs) error
 	if !hasTitle || !hasBody {
 		args = append(args, "--fill")
 	}
+	if data.Draft {
+		args = append(args, "--draft")
+	}
+	if len(data.Labels) > 0 {
+		args = append(args, "--label="+data.Labels.Join(","))
+	}
+	if len(data.Assignees) > 0 {
+		args = append(args, "--assignee="+data.Assignees.Join(","))
+	}
+	if len(data.Reviewers) > 0 {
+		args = append(args, "--reviewer="+data.Reviewers.Join(","))
+	}
 	args = append(args, "--web")
 	return self.Frontend.Run("glab", args...)
 }
@@ -93,6 +118,16 @@ func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Op
 	}
 }
 
+// ============================================================================
+// mark proposals as ready for review
+// ============================================================================
+
+var _ forgedomain.ProposalReadyMarker = glabConnector // type check
+
+func (self Connector) MarkProposalReady(proposalData forgedomain.ProposalInterface) error {
+	return self.Frontend.Run("glab", "mr", "update", proposalData.Data().Number.String(), "--ready")
+}
+
 // ============================================================================
 // search proposals
 // ============================================================================
@@ -124,13 +159,36 @@ func (self Connector) SearchProposals(branch gitdomain.LocalBranchName) ([]forge
 }
 
 // ============================================================================
-// squash-merge proposals
+// merge proposals
 // ============================================================================
 
 var _ forgedomain.ProposalMerger = glabConnector // type check
 
-func (self Connector) SquashMergeProposal(number forgedomain.ProposalNumber, message gitdomain.CommitMessage) error {
-	return self.Frontend.Run("glab", "mr", "merge", "--squash", "--squash-message="+message.String(), number.String())
+func (self Connector) MergeProposal(number forgedomain.ProposalNumber, method forgedomain.ProposalMergeMethod, message gitdomain.CommitMessage) error {
+	args := []string{"mr", "merge"}
+	args = append(args, MergeArgs(method, Some(message))...)
+	args = append(args, number.String())
+	return self.Frontend.Run("glab", args...)
+}
+
+// MergeArgs provides the arguments for "glab mr merge" that merge using the given method and message.
+func MergeArgs(method forgedomain.ProposalMergeMethod, message Option[gitdomain.CommitMessage]) []string {
+	commitMessage, hasCommitMessage := message.Get()
+	switch method {
+	case forgedomain.ProposalMergeMethodMerge:
+		if hasCommitMessage {
+			return []string{"--message=" + commitMessage.String()}
+		}
+		return []string{}
+	case forgedomain.ProposalMergeMethodRebase:
+		return []string{"--rebase"}
+	case forgedomain.ProposalMergeMethodSquash:
+		if hasCommitMessage {
+			return []string{"--squash", "--squash-message=" + commitMessage.String()}
+		}
+		return []string{"--squash"}
+	}
+	panic("unhandled proposal merge method: " + method.String())
 }
 
 // ============================================================================
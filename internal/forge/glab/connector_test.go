@@ -6,6 +6,7 @@ import (
 
 	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
 	"github.com/git-town/git-town/v22/internal/forge/glab"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
 	. "github.com/git-town/git-town/v22/pkg/prelude"
 	"github.com/shoenig/test/must"
 )
@@ -53,3 +54,35 @@ x could not authenticate to one or more of the configured GitLab instances.`[1:]
 		must.Eq(t, want, have)
 	})
 }
+
+func TestMergeArgs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("merge with message", func(t *testing.T) {
+		t.Parallel()
+		have := glab.MergeArgs(forgedomain.ProposalMergeMethodMerge, Some(gitdomain.CommitMessage("title\n\nbody")))
+		want := []string{"--message=title\n\nbody"}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("rebase", func(t *testing.T) {
+		t.Parallel()
+		have := glab.MergeArgs(forgedomain.ProposalMergeMethodRebase, Some(gitdomain.CommitMessage("title")))
+		want := []string{"--rebase"}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("squash with message", func(t *testing.T) {
+		t.Parallel()
+		have := glab.MergeArgs(forgedomain.ProposalMergeMethodSquash, Some(gitdomain.CommitMessage("title\n\nbody")))
+		want := []string{"--squash", "--squash-message=title\n\nbody"}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("squash without message", func(t *testing.T) {
+		t.Parallel()
+		have := glab.MergeArgs(forgedomain.ProposalMergeMethodSquash, None[gitdomain.CommitMessage]())
+		want := []string{"--squash"}
+		must.Eq(t, want, have)
+	})
+}
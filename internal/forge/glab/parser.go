@@ -56,6 +56,7 @@ var (
 
 type jsonData struct {
 	Description  gitdomain.ProposalBody    `json:"description"`
+	Draft        bool                      `json:"draft"`
 	Mergeable    string                    `json:"detailed_merge_status"` //nolint:tagliatelle
 	Number       int                       `json:"iid"`                   //nolint:tagliatelle
 	SourceBranch gitdomain.LocalBranchName `json:"source_branch"`         //nolint:tagliatelle
@@ -70,7 +71,9 @@ func createProposal(data jsonData) forgedomain.Proposal {
 		Data: forgedomain.ProposalData{
 			Active:       data.State == "open",
 			Body:         NewOption(data.Description),
+			Draft:        data.Draft,
 			MergeWithAPI: data.Mergeable == "mergeable",
+			Merged:       data.State == "merged",
 			Number:       forgedomain.ProposalNumber(data.Number),
 			Source:       data.SourceBranch,
 			Target:       data.TargetBranch,
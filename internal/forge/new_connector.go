@@ -30,7 +30,10 @@ func NewConnector(args NewConnectorArgs) (Option[forgedomain.Connector], error)
 	switch forgeType {
 	case forgedomain.ForgeTypeAzuredevops:
 		connector = azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+			APIToken:  args.AzuredevopsToken,
 			Browser:   args.Browser,
+			ConfigDir: args.ConfigDir,
+			Log:       args.Log,
 			RemoteURL: remoteURL,
 		})
 	case forgedomain.ForgeTypeBitbucket:
@@ -112,6 +115,7 @@ func NewConnector(args NewConnectorArgs) (Option[forgedomain.Connector], error)
 }
 
 type NewConnectorArgs struct {
+	AzuredevopsToken     Option[forgedomain.AzuredevopsToken]
 	Backend              subshelldomain.Querier
 	BitbucketAppPassword Option[forgedomain.BitbucketAppPassword]
 	BitbucketUsername    Option[forgedomain.BitbucketUsername]
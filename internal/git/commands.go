@@ -53,6 +53,12 @@ func (self *Commands) BranchAuthors(querier subshelldomain.Querier, branch, pare
 	return result, nil
 }
 
+// BranchContainsCommit indicates whether the given commit is part of the given branch.
+func (self *Commands) BranchContainsCommit(querier subshelldomain.Querier, branch gitdomain.LocalBranchName, sha gitdomain.SHA) (bool, error) {
+	output, err := querier.QueryTrim("git", "log", "--format=%H", sha.String(), "^"+branch.RefName())
+	return len(output) == 0, err
+}
+
 func (self *Commands) BranchContainsMerges(querier subshelldomain.Querier, branch, parent gitdomain.LocalBranchName) (bool, error) {
 	output, err := querier.QueryTrim("git", "log", "--merges", "--format=%H", fmt.Sprintf("%s..%s", parent, branch))
 	return len(output) > 0, err
@@ -705,6 +711,19 @@ func (self *Commands) Pull(runner subshelldomain.Runner) error {
 	return runner.Run("git", "pull")
 }
 
+// PushBranches pushes the given branches to the given remote in a single "git push" invocation.
+func (self *Commands) PushBranches(runner subshelldomain.Runner, remote gitdomain.Remote, branches []gitdomain.BranchPush, mode gitdomain.PushMode, pushHook configdomain.PushHook) error {
+	args := append([]string{"push"}, mode.GitFlags()...)
+	if !pushHook {
+		args = append(args, "--no-verify")
+	}
+	args = append(args, remote.String())
+	for _, branch := range branches {
+		args = append(args, branch.Refspec())
+	}
+	return runner.Run("git", args...)
+}
+
 // PushCurrentBranch pushes the current branch to its tracking branch.
 func (self *Commands) PushCurrentBranch(runner subshelldomain.Runner, pushHook configdomain.PushHook) error {
 	args := []string{"push"}
@@ -806,6 +825,10 @@ func (self *Commands) ResetBranch(runner subshelldomain.Runner, target gitdomain
 	return runner.Run("git", "reset", "--soft", target.String(), "--")
 }
 
+func (self *Commands) ResetCurrentBranchToBranch(runner subshelldomain.Runner, branch gitdomain.LocalBranchName) error {
+	return runner.Run("git", "reset", "--hard", branch.String())
+}
+
 func (self *Commands) ResetCurrentBranchToSHA(runner subshelldomain.Runner, sha gitdomain.SHA) error {
 	return runner.Run("git", "reset", "--hard", sha.String())
 }
@@ -879,6 +902,47 @@ func (self *Commands) UnstageAll(runner subshelldomain.Runner) error {
 	return runner.Run("git", "restore", "--staged", ".")
 }
 
+// WorktreeAdd creates a new worktree at the given path that has the given branch checked out.
+func (self *Commands) WorktreeAdd(runner subshelldomain.Runner, path gitdomain.WorktreePath, branch gitdomain.LocalBranchName) error {
+	return runner.Run("git", "worktree", "add", path.String(), branch.String())
+}
+
+// WorktreeRemove removes the worktree at the given path.
+func (self *Commands) WorktreeRemove(runner subshelldomain.Runner, path gitdomain.WorktreePath) error {
+	return runner.Run("git", "worktree", "remove", path.String())
+}
+
+// Worktrees provides all worktrees of this Git repository.
+func (self *Commands) Worktrees(querier subshelldomain.Querier) (gitdomain.Worktrees, error) {
+	output, err := querier.QueryTrim("git", "worktree", "list", "--porcelain")
+	if err != nil {
+		return gitdomain.Worktrees{}, err
+	}
+	result := gitdomain.Worktrees{}
+	for _, block := range strings.Split(output, "\n\n") {
+		worktree := gitdomain.Worktree{
+			Branch: None[gitdomain.LocalBranchName](),
+			Path:   "",
+			SHA:    "",
+		}
+		for _, line := range stringslice.Lines(block) {
+			key, value, _ := strings.Cut(line, " ")
+			switch key {
+			case "worktree":
+				worktree.Path = gitdomain.WorktreePath(filepath.FromSlash(value))
+			case "HEAD":
+				worktree.SHA = gitdomain.NewSHA(value)
+			case "branch":
+				worktree.Branch = Some(gitdomain.NewLocalBranchName(strings.TrimPrefix(value, "refs/heads/")))
+			}
+		}
+		if worktree.Path != "" {
+			result = append(result, worktree)
+		}
+	}
+	return result, nil
+}
+
 func LastBranchInRef(output string) string {
 	index := strings.LastIndex(output, "/")
 	return output[index+1:]
@@ -1,6 +1,7 @@
 package git_test
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/git-town/git-town/v22/internal/config/configdomain"
@@ -42,6 +43,46 @@ func TestBackendCommands(t *testing.T) {
 		must.Eq(t, []gitdomain.Author{"user <email@example.com>"}, authors)
 	})
 
+	t.Run("BranchContainsCommit", func(t *testing.T) {
+		t.Parallel()
+		t.Run("branch contains the commit", func(t *testing.T) {
+			t.Parallel()
+			local := testruntime.Create(t)
+			asserts.NoError(local.Git.CreateAndCheckoutBranch(local.TestRunner, "parent"))
+			local.CreateCommit(testgit.Commit{
+				Branch:      "parent",
+				FileContent: "content",
+				FileName:    "parent_file",
+				Message:     "add parent file",
+			})
+			sha := asserts.NoError1(local.Git.SHAForBranch(local.TestRunner, "parent"))
+			asserts.NoError(local.Git.CreateAndCheckoutBranch(local.TestRunner, "child"))
+			local.CreateCommit(testgit.Commit{
+				Branch:      "child",
+				FileContent: "content",
+				FileName:    "child_file",
+				Message:     "add child file",
+			})
+			have := asserts.NoError1(local.Git.BranchContainsCommit(local.TestRunner, "child", sha))
+			must.True(t, have)
+		})
+		t.Run("branch doesn't contain the commit", func(t *testing.T) {
+			t.Parallel()
+			local := testruntime.Create(t)
+			asserts.NoError(local.Git.CreateAndCheckoutBranch(local.TestRunner, "child"))
+			asserts.NoError(local.Git.CreateAndCheckoutBranch(local.TestRunner, "parent"))
+			local.CreateCommit(testgit.Commit{
+				Branch:      "parent",
+				FileContent: "content",
+				FileName:    "parent_file",
+				Message:     "add parent file",
+			})
+			sha := asserts.NoError1(local.Git.SHAForBranch(local.TestRunner, "parent"))
+			have := asserts.NoError1(local.Git.BranchContainsCommit(local.TestRunner, "child", sha))
+			must.False(t, have)
+		})
+	})
+
 	t.Run("BranchContainsMerges", func(t *testing.T) {
 		t.Parallel()
 		t.Run("branch has a merge commit", func(t *testing.T) {
@@ -1601,6 +1642,31 @@ func TestBackendCommands(t *testing.T) {
 		})
 	})
 
+	t.Run("Worktrees", func(t *testing.T) {
+		t.Parallel()
+		t.Run("only the main worktree", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			have := asserts.NoError1(runtime.Git.Worktrees(runtime.TestRunner))
+			must.Len(t, 1, have)
+			must.Eq(t, Some(gitdomain.NewLocalBranchName("initial")), have[0].Branch)
+		})
+		t.Run("branch checked out in another worktree", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			runtime.CreateBranch("branch1", initial.BranchName())
+			worktreeDir := asserts.NoError1(filepath.EvalSymlinks(t.TempDir()))
+			runtime.AddWorktree(worktreeDir, "branch1")
+			have := asserts.NoError1(runtime.Git.Worktrees(runtime.TestRunner))
+			must.Len(t, 2, have)
+			worktree, hasWorktree := have.FindByBranch("branch1").Get()
+			must.True(t, hasWorktree)
+			must.EqOp(t, gitdomain.WorktreePath(worktreeDir), worktree.Path)
+			must.EqOp(t, runtime.SHAforBranch("branch1"), worktree.SHA)
+			must.True(t, have.FindByBranch("branch2").IsNone())
+		})
+	})
+
 	t.Run("lastBranchInRef", func(t *testing.T) {
 		t.Parallel()
 		tests := map[string]string{
@@ -0,0 +1,17 @@
+package gitdomain
+
+// BranchPush describes a local branch that needs to be pushed to its tracking branch.
+type BranchPush struct {
+	Branch         LocalBranchName
+	Mode           PushMode
+	TrackingBranch RemoteBranchName
+}
+
+// Refspec provides the refspec that pushes this branch to its tracking branch.
+func (self BranchPush) Refspec() string {
+	remoteBranch := self.TrackingBranch.LocalBranchName()
+	if remoteBranch == self.Branch {
+		return self.Branch.String()
+	}
+	return self.Branch.String() + ":refs/heads/" + remoteBranch.String()
+}
@@ -0,0 +1,34 @@
+package gitdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestBranchPush(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Refspec", func(t *testing.T) {
+		t.Parallel()
+		t.Run("tracking branch has the same name", func(t *testing.T) {
+			t.Parallel()
+			branchPush := gitdomain.BranchPush{
+				Branch:         "branch",
+				Mode:           gitdomain.PushModeFastForward,
+				TrackingBranch: "origin/branch",
+			}
+			must.EqOp(t, "branch", branchPush.Refspec())
+		})
+		t.Run("tracking branch has a different name", func(t *testing.T) {
+			t.Parallel()
+			branchPush := gitdomain.BranchPush{
+				Branch:         "branch",
+				Mode:           gitdomain.PushModeFastForward,
+				TrackingBranch: "origin/other",
+			}
+			must.EqOp(t, "branch:refs/heads/other", branchPush.Refspec())
+		})
+	})
+}
@@ -0,0 +1,38 @@
+package gitdomain
+
+import "strings"
+
+// ProposalMetadataName is the forge username of a person assigned to or reviewing a proposal,
+// or the name of a label attached to a proposal.
+type ProposalMetadataName string
+
+// String implements the fmt.Stringer interface.
+func (self ProposalMetadataName) String() string {
+	return string(self)
+}
+
+// ProposalMetadataNames is a collection of ProposalMetadataName instances.
+type ProposalMetadataNames []ProposalMetadataName
+
+// NewProposalMetadataNames provides ProposalMetadataNames with the given names.
+func NewProposalMetadataNames(names ...string) ProposalMetadataNames {
+	result := make(ProposalMetadataNames, len(names))
+	for n, name := range names {
+		result[n] = ProposalMetadataName(name)
+	}
+	return result
+}
+
+// Join provides the names of all entries, separated by the given separator.
+func (self ProposalMetadataNames) Join(sep string) string {
+	return strings.Join(self.Strings(), sep)
+}
+
+// Strings provides the names of all entries as strings.
+func (self ProposalMetadataNames) Strings() []string {
+	result := make([]string, len(self))
+	for n, entry := range self {
+		result[n] = entry.String()
+	}
+	return result
+}
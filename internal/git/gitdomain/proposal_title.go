@@ -1,5 +1,9 @@
 package gitdomain
 
+import (
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
 // ProposalTitle is the title of a proposal
 type ProposalTitle string
 
@@ -7,3 +11,10 @@ type ProposalTitle string
 func (self ProposalTitle) String() string {
 	return string(self)
 }
+
+func NewProposalTitleOpt(text string) Option[ProposalTitle] {
+	if text == "" {
+		return None[ProposalTitle]()
+	}
+	return Some(ProposalTitle(text))
+}
@@ -0,0 +1,36 @@
+package gitdomain
+
+// PushMode describes how Git Town updates a tracking branch with the commits of its local branch.
+type PushMode string
+
+const (
+	PushModeFastForward     PushMode = "fast-forward"      // regular push that only fast-forwards the tracking branch
+	PushModeForceIfIncludes PushMode = "force-if-includes" // force-push with lease that also requires the local branch to contain the tracking branch
+	PushModeForceWithLease  PushMode = "force-with-lease"  // force-push that only succeeds if the tracking branch is where we saw it last
+)
+
+// PushModes provides all PushMode values, in the order in which Git Town executes them.
+func PushModes() []PushMode {
+	return []PushMode{
+		PushModeFastForward,
+		PushModeForceWithLease,
+		PushModeForceIfIncludes,
+	}
+}
+
+// GitFlags provides the flags that make "git push" update tracking branches in this mode.
+func (self PushMode) GitFlags() []string {
+	switch self {
+	case PushModeFastForward:
+		return []string{}
+	case PushModeForceWithLease:
+		return []string{"--force-with-lease"}
+	case PushModeForceIfIncludes:
+		return []string{"--force-with-lease", "--force-if-includes"}
+	}
+	panic("unhandled push mode: " + self.String())
+}
+
+func (self PushMode) String() string {
+	return string(self)
+}
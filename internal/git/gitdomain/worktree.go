@@ -0,0 +1,37 @@
+package gitdomain
+
+import (
+	"path/filepath"
+	"strings"
+
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// Worktree describes a Git worktree.
+type Worktree struct {
+	Branch Option[LocalBranchName] // the branch checked out in this worktree, None if the worktree has a detached HEAD
+	Path   WorktreePath            // the directory of this worktree
+	SHA    SHA                     // the commit checked out in this worktree
+}
+
+// WorktreePath is the directory of a Git worktree.
+type WorktreePath string
+
+// IsInside indicates whether this worktree is located inside the given directory.
+func (self WorktreePath) IsInside(dir string) bool {
+	rel, err := filepath.Rel(dir, self.String())
+	return err == nil && rel != "." && rel != ".." && !filepath.IsAbs(rel) && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// RelativeTo provides this path relative to the given directory.
+func (self WorktreePath) RelativeTo(dir RepoRootDir) WorktreePath {
+	rel, err := filepath.Rel(dir.String(), self.String())
+	if err != nil {
+		return self
+	}
+	return WorktreePath(rel)
+}
+
+func (self WorktreePath) String() string {
+	return string(self)
+}
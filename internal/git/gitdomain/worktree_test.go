@@ -0,0 +1,37 @@
+package gitdomain_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestWorktreePath(t *testing.T) {
+	t.Parallel()
+
+	t.Run("IsInside", func(t *testing.T) {
+		t.Parallel()
+		dir := filepath.FromSlash("/code/repo.worktrees")
+		tests := map[string]bool{
+			"/code/repo.worktrees/feature":     true,
+			"/code/repo.worktrees/feature/one": true,
+			"/code/repo.worktrees":             false,
+			"/code/repo":                       false,
+			"/code/repo.worktrees-other/one":   false,
+		}
+		for give, want := range tests {
+			have := gitdomain.WorktreePath(filepath.FromSlash(give)).IsInside(dir)
+			must.EqOp(t, want, have)
+		}
+	})
+
+	t.Run("RelativeTo", func(t *testing.T) {
+		t.Parallel()
+		path := gitdomain.WorktreePath(filepath.FromSlash("/code/repo.worktrees/feature"))
+		have := path.RelativeTo(gitdomain.NewRepoRootDir(filepath.FromSlash("/code/repo")))
+		want := gitdomain.WorktreePath(filepath.FromSlash("../repo.worktrees/feature"))
+		must.EqOp(t, want, have)
+	})
+}
@@ -0,0 +1,24 @@
+package gitdomain
+
+import . "github.com/git-town/git-town/v22/pkg/prelude"
+
+// Worktrees describes all worktrees of a Git repository.
+type Worktrees []Worktree
+
+// FindByBranch provides the worktree that has the given branch checked out.
+func (self Worktrees) FindByBranch(branch LocalBranchName) Option[Worktree] {
+	for _, worktree := range self {
+		if worktreeBranch, hasBranch := worktree.Branch.Get(); hasBranch && worktreeBranch == branch {
+			return Some(worktree)
+		}
+	}
+	return None[Worktree]()
+}
+
+// Main provides the main worktree of the repository.
+func (self Worktrees) Main() Option[Worktree] {
+	if len(self) == 0 {
+		return None[Worktree]()
+	}
+	return Some(self[0])
+}
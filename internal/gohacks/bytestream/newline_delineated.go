@@ -12,6 +12,7 @@ type NewlineDelineated []byte
 func (self NewlineDelineated) Sanitize() Sanitized {
 	lines := bytes.Split(self, []byte("\n"))
 	secretKeys := [][]byte{
+		[]byte(configdomain.KeyAzuredevopsToken),
 		[]byte(configdomain.KeyBitbucketAppPassword),
 		[]byte(configdomain.KeyDeprecatedCodebergToken),
 		[]byte(configdomain.KeyForgejoToken),
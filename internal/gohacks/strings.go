@@ -2,6 +2,30 @@ package gohacks
 
 import "strings"
 
+// EditDistance provides the number of single-character insertions, deletions,
+// or substitutions needed to turn the given strings into each other.
+func EditDistance(a, b string) int {
+	runesA := []rune(a)
+	runesB := []rune(b)
+	previous := make([]int, len(runesB)+1)
+	current := make([]int, len(runesB)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := range runesA {
+		current[0] = i + 1
+		for j := range runesB {
+			cost := 1
+			if runesA[i] == runesB[j] {
+				cost = 0
+			}
+			current[j+1] = min(previous[j+1]+1, current[j]+1, previous[j]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(runesB)]
+}
+
 func EscapeNewLines(text string) string {
 	return strings.ReplaceAll(text, "\n", "\\n")
 }
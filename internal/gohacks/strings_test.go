@@ -7,6 +7,23 @@ import (
 	"github.com/shoenig/test/must"
 )
 
+func TestEditDistance(t *testing.T) {
+	t.Parallel()
+	tests := map[[2]string]int{
+		{"", ""}:                                0,
+		{"", "abc"}:                             3,
+		{"abc", ""}:                             3,
+		{"tags", "tags"}:                        0,
+		{"tgas", "tags"}:                        2,
+		{"feature-stratgy", "feature-strategy"}: 1,
+		{"kitten", "sitting"}:                   3,
+	}
+	for give, want := range tests {
+		have := gohacks.EditDistance(give[0], give[1])
+		must.EqOp(t, want, have)
+	}
+}
+
 func TestEscapeNewLines(t *testing.T) {
 	t.Parallel()
 	tests := map[string]string{
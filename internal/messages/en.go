@@ -1,8 +1,16 @@
 package messages
 
 const (
+	AliasableCommandUnknown          = "unknown aliasable command in %s: %q"
 	AliasedCommands                  = "Aliased commands: %s\n"
+	APIProposalAddAssignees          = "Assigning %s to %s ... "
+	APIProposalAddLabels             = "Adding labels %s to %s ... "
+	APIProposalAutoMerge             = "Enabling auto-merge for proposal %s ... "
+	APIProposalCreateStart           = "Creating proposal from %s into %s ... "
 	APIProposalFindStart             = "Finding proposal from %s into %s ... "
+	APIProposalHistorySearchStart    = "Finding open, merged, and closed proposals for %s ... "
+	APIProposalMarkReady             = "Marking proposal %s as ready for review ... "
+	APIProposalRequestReviewers      = "Requesting reviews from %s for %s ... "
 	APIProposalSearchStart           = "Finding all proposals for %s ... "
 	APIProposalUpdateBody            = "Update body for %s ... "
 	APIProposalUpdateStart           = "Updating proposal online ... "
@@ -15,47 +23,51 @@ const (
 	AuthorizationMissing             = `cannot find "repo" scope: %v`
 	AutoDetect                       = "auto-detect"
 	AutoSync                         = "auto-sync: %s\n"
+	AzuredevopsTokenPrompt           = "Azure DevOps personal access token: "
+	AzuredevopsTokenResult           = "Azure DevOps token: %s\n"
 
-	BitbucketAppPasswordPrompt      = "Bitbucket App Password: "
-	BitbucketAppPasswordResult      = "Bitbucket App Password: %s"
-	BitbucketUsernamePrompt         = "Bitbucket username: "
-	BitbucketUsernameResult         = "Bitbucket username: %s"
-	BranchAlreadyExistsLocally      = "there is already a branch %s"
-	BranchAlreadyExistsRemotely     = "there is already a branch %s at the %s remote"
-	BranchAuthorMultiple            = "\nMultiple people authored the %s branch.\n\n"
-	BranchCheckoutProblem           = "cannot check out branch %s: %w"
-	BranchContainsMergeCommits      = "branch %s contains merge commits, please compress and try again"
-	BranchCurrentProblem            = "cannot determine current branch: %w"
-	BranchDeleted                   = "deleted branch %s"
-	BranchDeletedAtRemote           = "branch %s was deleted at the remote"
-	BranchDeletedHasUnmergedChanges = "Branch %s was deleted at the remote but the local branch contains unshipped changes.\nI am therefore not removing this branch. You can see the unshipped changes by running \"git town diff-parent\"."
-	BranchDiffProblem               = "cannot determine if branch %s has unmerged commits: %w"
-	BranchDoesntContainCommit       = "branch %s does not contain commit %s. Found commits %s"
-	BranchDoesntExist               = "there is no branch %q"
-	BranchHasWrongSHA               = "cannot reset branch %s to %s because it received additional commits in the meantime. It should have SHA %s but has %s"
-	BranchInfoNoContent             = "BranchInfo has neither a local nor remote name"
-	BranchInfoNotFound              = "cannot find branch info for %s"
-	BranchInfosNotProvided          = "An opcode that requires BranchInfos was called from the Light engine"
-	BranchIsAlreadyContribution     = "branch %s is already a contribution branch"
-	BranchIsAlreadyObserved         = "branch %s is already observed"
-	BranchIsAlreadyParked           = "branch %s is already parked"
-	BranchIsAlreadyPrototype        = "branch %s is already a prototype branch"
-	BranchIsNowContribution         = "branch %s is now a contribution branch\n"
-	BranchIsNowFeature              = "branch %s is now a feature branch\n"
-	BranchIsNowObserved             = "branch %s is now an observed branch\n"
-	BranchIsNowParked               = "branch %s is now parked\n"
-	BranchIsNowPerennial            = "branch %s is now perennial\n"
-	BranchIsNowPrototype            = "branch %s is now a prototype branch\n"
-	BranchLocalProblem              = "cannot determine whether the local branch %s exists: %w"
-	BranchLocalSHAProblem           = "cannot determine SHA of local branch %s: %w"
-	BranchNotAvailable              = "there is no other branch to switch to"
-	BranchNotInSyncWithParent       = `branch %s is not in sync with its parent, please run "git town sync" and try again`
-	BranchOtherWorktree             = `branch %s is active in another worktree`
-	BranchParentChanged             = "branch %s is now a child of %s"
-	BranchPrefixPrompt              = "Branch prefix: "
-	BranchPrefixResult              = "Branch prefix: %s\n"
-	BranchTypeCannotDetermine       = "cannot determine type of branch %s"
-	BrowserOpen                     = "Please open in a browser: %s\n"
+	BitbucketAppPasswordPrompt               = "Bitbucket App Password: "
+	BitbucketAppPasswordResult               = "Bitbucket App Password: %s"
+	BitbucketCloudLabelsAssigneesUnsupported = "Bitbucket Cloud does not support labels and assignees on pull requests"
+	BitbucketUsernamePrompt                  = "Bitbucket username: "
+	BitbucketUsernameResult                  = "Bitbucket username: %s"
+	BranchAlreadyExistsLocally               = "there is already a branch %s"
+	BranchAlreadyExistsRemotely              = "there is already a branch %s at the %s remote"
+	BranchAuthorMultiple                     = "\nMultiple people authored the %s branch.\n\n"
+	BranchCheckoutProblem                    = "cannot check out branch %s: %w"
+	BranchContainsMergeCommits               = "branch %s contains merge commits, please compress and try again"
+	BranchCurrentProblem                     = "cannot determine current branch: %w"
+	BranchDeleted                            = "deleted branch %s"
+	BranchDeletedAtRemote                    = "branch %s was deleted at the remote"
+	BranchDeletedHasUnmergedChanges          = "Branch %s was deleted at the remote but the local branch contains unshipped changes.\nI am therefore not removing this branch. You can see the unshipped changes by running \"git town diff-parent\"."
+	BranchDiffProblem                        = "cannot determine if branch %s has unmerged commits: %w"
+	BranchDoesntContainCommit                = "branch %s does not contain commit %s. Found commits %s"
+	BranchDoesntExist                        = "there is no branch %q"
+	BranchHasWrongSHA                        = "cannot reset branch %s to %s because it received additional commits in the meantime. It should have SHA %s but has %s"
+	BranchInfoNoContent                      = "BranchInfo has neither a local nor remote name"
+	BranchInfoNotFound                       = "cannot find branch info for %s"
+	BranchInfosNotProvided                   = "An opcode that requires BranchInfos was called from the Light engine"
+	BranchIsAlreadyContribution              = "branch %s is already a contribution branch"
+	BranchIsAlreadyObserved                  = "branch %s is already observed"
+	BranchIsAlreadyParked                    = "branch %s is already parked"
+	BranchIsAlreadyPrototype                 = "branch %s is already a prototype branch"
+	BranchIsNowContribution                  = "branch %s is now a contribution branch\n"
+	BranchIsNowFeature                       = "branch %s is now a feature branch\n"
+	BranchIsNowObserved                      = "branch %s is now an observed branch\n"
+	BranchIsNowParked                        = "branch %s is now parked\n"
+	BranchIsNowPerennial                     = "branch %s is now perennial\n"
+	BranchIsNowPrototype                     = "branch %s is now a prototype branch\n"
+	BranchLocalProblem                       = "cannot determine whether the local branch %s exists: %w"
+	BranchLocalSHAProblem                    = "cannot determine SHA of local branch %s: %w"
+	BranchNotAvailable                       = "there is no other branch to switch to"
+	BranchNotInSyncWithParent                = `branch %s is not in sync with its parent, please run "git town sync" and try again`
+	BranchOtherWorktree                      = `branch %s is active in another worktree`
+	BranchParentChanged                      = "branch %s is now a child of %s"
+	BranchPatternInvalid                     = "invalid branch pattern in %s: %q"
+	BranchPrefixPrompt                       = "Branch prefix: "
+	BranchPrefixResult                       = "Branch prefix: %s\n"
+	BranchTypeCannotDetermine                = "cannot determine type of branch %s"
+	BrowserOpen                              = "Please open in a browser: %s\n"
 
 	CacheUnitialized                   = "using a cached value before initialization"
 	CannotParse                        = "cannot parse %s: %w"
@@ -82,10 +94,28 @@ const (
 	ConfigDirUserCannotDetermine       = "cannot determine the user configuration directory: %w"
 	ConfigFile                         = "config file"
 	ConfigFileCannotRead               = "cannot read the configuration file %s: %w"
+	ConfigFileCredentials              = "Ignoring the forge credentials in the configuration file %s. Please store them in the user configuration file %s or in the Git metadata."
+	ConfigFileCredentialsKey           = "forge credentials in %s, please store them in the user configuration file or in the Git metadata"
+	ConfigFileDeprecatedKey            = "deprecated key %s, please use %s instead"
+	ConfigFileIncludeCycle             = "the configuration file %s includes itself"
 	ConfigFileInvalidContent           = "the configuration file %s does not contain TOML-formatted content: %w"
+	ConfigFileMissing                  = "this repository has no configuration file, please provide the file to validate"
+	ConfigFileProblems                 = "the configuration file %s contains problems"
+	ConfigFileUnknownKey               = "unknown key %s"
+	ConfigFileUnknownKeySuggestion     = "unknown key %s, did you mean %s?"
+	ConfigFileUnsupportedKey           = "the configuration file cannot store %q, please store it in the Git metadata"
+	ConfigFileValid                    = "the configuration file %s is valid"
+	ConfigKeyDeprecated                = "the configuration key %q is deprecated"
+	ConfigKeyUnknown                   = "unknown configuration key: %q"
 	ConfigLineageEmptyChild            = "removing empty lineage entry"
 	ConfigLineageParentIsChild         = "removing lineage entry for %s because the parent is the child"
 	ConfigMainbranchInConfigFile       = "please configure the main branch in the config file"
+	ConfigMigratedToFile               = "moved the settings from the local Git metadata into %s"
+	ConfigMigratedToGit                = "moved the settings from %s into the local Git metadata"
+	ConfigMigrateIncludes              = "cannot migrate %s into the local Git metadata because it includes other configuration files, please remove its \"include\" entry first"
+	ConfigMigrateNoFile                = "this repository has no configuration file to migrate"
+	ConfigMigrateNothing               = "the local Git metadata contains no settings to migrate"
+	ConfigMigrateTargetUnknown         = "unknown migration target %q, please use \"file\" or \"git\""
 	ConfigNeeded                       = "Git Town needs to be configured\n\n"
 	ConfigRemoveError                  = "unexpected error while removing the 'git-town' section from the Git configuration: %w"
 	ConfigScopeUnknown                 = "unknown configuration scope"
@@ -144,27 +174,31 @@ Please upgrade to the new format: create.new-branch-type = "prototype"`
 	DownNoParent                        = "branch %s has no parent"
 	DryRun                              = "In dry run mode. No commands will be run. When run in normal mode, the command output will appear beneath the command. Some commands will only be run if necessary. For example: 'git push' will run if and only if there are local commits not on origin."
 
-	FeatureDetachedHead          = "please check out the branch to make a feature branch"
-	FeatureRegexPrompt           = "Feature regex: "
-	FeatureRegexResult           = "Feature regex: %s\n"
-	FileContentInvalidJSON       = "cannot parse JSON content of file %s: %w"
-	FileDeleteProblem            = "cannot delete file %s: %w"
-	FileReadProblem              = "cannot read file %s: %w"
-	FileStatProblem              = "cannot check file %s: %w"
-	FileWriteProblem             = "cannot write file %s: %w"
-	Forge                        = "Forge: %s\n"
-	ForgeAPITokenLocation        = "API token scope: %s\n"
-	ForgeBitbucketMergingViaAPI  = "Bitbucket API: merging PR %s ... "
-	ForgeBitbucketNotImplemented = "shipping pull requests via the Bitbucket API is currently not supported. If you need this functionality, please vote for it by opening a ticket at https://github.com/git-town/git-town/issues"
-	ForgeForgejoMergingViaAPI    = "Forgejo API: merging PR %s ... "
-	ForgeGiteaNotImplemented     = "shipping pull requests via the Gitea API is currently not supported. If you need this functionality, please vote for it by opening a ticket at https://github.com/git-town/git-town/issues"
-	ForgeGiteaUpdatePRViaAPI     = "Gitea API: Updating base branch for PR #%d to #%s"
-	ForgeGithubMergingViaAPI     = "GitHub API: merging PR %s ... "
-	ForgeGitlabMergingViaAPI     = "Merging MR !%d ... "
-	ForgeGitlabUpdateMRViaAPI    = "Updating target branch for MR !%d to %s ... "
-	ForgejoTokenPrompt           = "Forgejo API token: "
-	ForgejoTokenResult           = "Forgejo token: %s\n"
-	ForgeTypeUnknown             = "unknown forge type defined in %s: %q"
+	FeatureDetachedHead                   = "please check out the branch to make a feature branch"
+	FeatureRegexPrompt                    = "Feature regex: "
+	FeatureRegexResult                    = "Feature regex: %s\n"
+	FileContentInvalidJSON                = "cannot parse JSON content of file %s: %w"
+	FileDeleteProblem                     = "cannot delete file %s: %w"
+	FileReadProblem                       = "cannot read file %s: %w"
+	FileStatProblem                       = "cannot check file %s: %w"
+	FileWriteProblem                      = "cannot write file %s: %w"
+	Forge                                 = "Forge: %s\n"
+	ForgeAPITokenLocation                 = "API token scope: %s\n"
+	ForgeAzuredevopsMergingViaAPI         = "Azure DevOps API: merging PR %s ... "
+	ForgeBitbucketDatacenterMergingViaAPI = "Bitbucket Data Center API: merging PR %s ... "
+	ForgeBitbucketMergingViaAPI           = "Bitbucket API: merging PR %s ... "
+	ForgeBitbucketNotImplemented          = "shipping pull requests via the Bitbucket API is currently not supported. If you need this functionality, please vote for it by opening a ticket at https://github.com/git-town/git-town/issues"
+	ForgeForgejoMergingViaAPI             = "Forgejo API: merging PR %s ... "
+	ForgeGiteaNotImplemented              = "shipping pull requests via the Gitea API is currently not supported. If you need this functionality, please vote for it by opening a ticket at https://github.com/git-town/git-town/issues"
+	ForgeGiteaUpdatePRViaAPI              = "Gitea API: Updating base branch for PR #%d to #%s"
+	ForgeGithubMergingViaAPI              = "GitHub API: merging PR %s ... "
+	ForgeGitlabMergingViaAPI              = "Merging MR !%d ... "
+	ForgeGitlabUpdateMRViaAPI             = "Updating target branch for MR !%d to %s ... "
+	ForgejoTokenPrompt                    = "Forgejo API token: "
+	ForgejoTokenResult                    = "Forgejo token: %s\n"
+	ForgeLabelNotFound                    = "cannot find a label named %q at your forge"
+	ForgeTypeUnknown                      = "unknown forge type defined in %s: %q"
+	ForgeUserNotFound                     = "cannot find a user with username %q at your forge"
 
 	GitAnotherProcessIsRunningRetry = "another git process seems to be running in this repository, retrying in 1 sec ..."
 	GitDirMissing                   = "cannot determine the '.git' directory: %w"
@@ -206,6 +240,8 @@ Please upgrade to the new format: create.new-branch-type = "prototype"`
 	MainBranchNotFound               = "cannot find the main branch"
 	MergeBranchNotLocal              = "cannot merge: branch %s is not local"
 	MergeDetachedHead                = "please check out the branch to merge"
+	MergeDownMultipleChildren        = "cannot merge branch %s down because it has more than one child branch"
+	MergeDownNoChild                 = "cannot merge branch %s down because it has no child branch"
 	MergeNoGrandParent               = "cannot merge branch %s because its parent branch %s has no parent"
 	MergeNoParent                    = "cannot merge branch %s because it has no parent"
 	MergeNotInSyncWithTracking       = `branch %s is not in sync with its tracking branch, please run "git town sync" and try again`
@@ -233,6 +269,7 @@ Please upgrade to the new format: create.new-branch-type = "prototype"`
 	OrderInvalid                = "invalid order defined in %s: %q"
 	OriginHostnamePrompt        = "Origin hostname override: "
 	OriginHostnameResult        = "Origin hostname: %s\n"
+	OutputFormatInvalid         = "invalid output format defined in %s: %q"
 
 	ParentBranchTitle                       = `Parent branch for %s`
 	ParkDetachedHead                        = "please check out the branch to park"
@@ -256,16 +293,23 @@ Please upgrade to the new format: create.new-branch-type = "prototype"`
 	ProposalBreadcrumbDirection             = "Proposals breadcrumb direction: %s\n"
 	ProposalBreadcrumbDirectionInvalid      = "invalid value for proposal-breadcrumb-direction in %s: %q, expected \"down\" or \"up\""
 	ProposalBreadcrumbInvalid               = "invalid value for proposal breadcrumb in %s: %q. Valid values are: none, stacks, branches"
+	ProposalCreateUnsupported               = "the Git Town driver for your forge does not support creating proposals via the API"
 	ProposalFindProblem                     = "cannot find proposal: %s"
 	ProposalLineageUnsupportedForBranchType = "Proposal stack lineage unsupported for branch type %s"
+	ProposalMarkReadyUnsupported            = "the Git Town driver for your forge does not support marking proposals as ready for review"
+	ProposalMergeMethodUnknown              = "unknown proposal merge method in %s: %q"
 	ProposalMultipleFromFound               = "found %d proposals for branch %s"
 	ProposalMultipleFromToFound             = "found %d proposals from branch %s to branch %s"
 	ProposalNoNumberGiven                   = "no proposal number given"
 	ProposalNoParent                        = "branch %s has no parent and can therefore not be proposed"
+	ProposalNotDraft                        = "proposal %s is not a draft"
+	ProposalNotFound                        = "branch %q has no proposal into %q"
 	ProposalSourceCannotUpdate              = "cannot update the proposal source branch on your forge"
 	ProposalTargetBranchUpdateProblem       = "cannot update the target branch of proposal %d on your forge"
 	ProposalURLProblem                      = "cannot determine proposal URL from %s to %s: %w"
 	ProposeDetached                         = "please check out the branch to propose"
+	ProposeTemplateCannotRead               = "cannot read the proposal template %q: %w"
+	ProposeTemplateInvalid                  = "cannot render the proposal template %q: %w"
 	PrototypeDetachedHead                   = "please check out the branch to make a prototype branch"
 	PrototypeRemoved                        = "branch %s is no longer a prototype branch"
 	PullRequestDeprecation                  = `DEPRECATION NOTICE
@@ -288,15 +332,42 @@ and will be removed in future versions of Git Town.`
 	RenameMainBranch             = "the main branch cannot be renamed"
 	RenamePerennialBranchWarning = "%s is a perennial branch. Renaming a perennial branch typically requires other updates. If you are sure you want to do this, use '--force'"
 	RenameToSameName             = "cannot rename branch to current name"
+	ReorderDetachedHead          = "please check out a branch of the stack to reorder"
+	ReorderNeedsCompress         = "cannot reorder because branch %s contains merge commits - please compress and try again"
+	ReorderNeedsSync             = "please sync your branches before reordering"
+	ReorderNonLinearStack        = "cannot reorder because branch %s has several child branches"
+	ReorderNoStack               = "please check out a feature branch of the stack to reorder"
+	ReorderOtherWorkTree         = "cannot reorder because branch %s is active in another worktree"
+	ReorderRemoteBranch          = "cannot reorder: branch %s is remote"
+	ReorderSingleBranch          = "the stack contains only branch %s, nothing to reorder"
+	ReorderUnchanged             = "The order of the stack is unchanged."
+	ReorderUnsupportedBranchType = "cannot reorder: branch %s is a %s branch"
 	RepoOutside                  = "this is not a Git repository"
+	RestackDetachedHead          = "please check out the branch whose descendants to restack"
+	RestackNothingToDo           = "All descendants of branch %s are up to date.\n"
+	RestackUnknownParentSHA      = `cannot restack branch %s because the previous location of its parent branch %s is unknown, please run "git town sync --stack"`
 	RunAutoUndo                  = "%s\nAuto-undo... "
 	RunCommandProblem            = "error running command %q: %w"
+	RunhistoryDisplaying         = "Git Town command history, most recent first:\n"
+	RunhistoryEmpty              = "Git Town hasn't recorded any commands yet."
+	RunhistoryEntry              = "%2d  %s  %s  %s\n"
+	RunhistoryLoadProblem        = "cannot load run history: %w"
+	RunhistorySaveProblem        = "cannot save run history: %w"
+	RunhistorySerializeProblem   = "cannot encode run history: %w"
 	RunLogCannotOpen             = "cannot open runlog file %q: %w"
 	RunLogCannotRead             = "cannot read runlog file %q: %w"
 	RunLogCannotWrite            = "cannot write to runlog file %q: %w"
 	RunLogDeleted                = "Runlog deleted."
 	RunlogDisplaying             = "Displaying runlog at %s\n"
 	RunLogDoesntExist            = "Runlog doesn't exist."
+	RunlogEntries                = "Runlog entries, most recent first:\n"
+	RunlogEntry                  = "%2d  %s  %-5s  %s\n"
+	RunlogRestoreBranchChange    = "  %s: %s -> %s\n"
+	RunlogRestoreBranchCreate    = "  %s: re-create at %s\n"
+	RunlogRestoreDetachedHead    = "please check out a branch before restoring a runlog entry"
+	RunlogRestoreIndexInvalid    = "runlog entry %q doesn't exist, please provide a number between 1 and %d"
+	RunlogRestoreNothingToDo     = "All branches already match runlog entry %d.\n"
+	RunlogRestorePreview         = "Restoring the branches to runlog entry %d (%s of %q):\n"
 	RunLogSerializeProblem       = "cannot encode runlog: %w"
 	RunstateDeleted              = "Runstate file deleted."
 	RunstateDeleteProblem        = "cannot delete previous run state: %w"
@@ -316,6 +387,8 @@ and will be removed in future versions of Git Town.`
 	SettingSunsetBranchList               = "Inlining deprecated branch list %s"
 	SettingSunsetDeleted                  = "Deleting obsolete setting %s"
 	ShareNewBranches                      = "Share new branches: %s\n"
+	ShipAPIAutoMergeEnabled               = "Your forge will merge branch %s once its proposal is ready. Afterwards, run \"git town sync\" to remove the branch locally."
+	ShipAPIAutoMergeUnsupported           = "the Git Town driver for your forge does not support auto-merging proposals"
 	ShipAPIConnectorRequired              = "please configure API access to your forge, more info at https://www.git-town.com/configuration#access-tokens"
 	ShipAPIConnectorUnsupported           = "the Git Town driver for your forge does not support shipping via the API"
 	ShipAPINoProposal                     = "cannot ship branch %s via API because it has no proposal"
@@ -331,6 +404,9 @@ and will be removed in future versions of Git Town.`
 	ShipNoBranchToShip                    = "please provide the branch to ship"
 	ShipOpenChanges                       = "you have uncommitted changes. Did you mean to commit them before shipping?"
 	ShipRepoHasDetachedHead               = "please check out the branch to ship"
+	ShipStackWithAutoMerge                = "cannot ship a stack with the api-auto-merge ship strategy because the forge merges each proposal later, please ship the branches of the stack one at a time"
+	ShipStackWithBranch                   = "shipping a stack ships the current branch and its ancestors, please check out the branch to ship instead of providing it"
+	ShipStackWithMessage                  = "shipping a stack creates a separate commit for each branch and therefore does not use the given commit message"
 	ShipStrategy                          = "Ship strategy: %s\n"
 	ShipStrategyMissing                   = "no ship strategy provided"
 	SkipBranchHasConflicts                = "cannot skip branch that resulted in conflicts"
@@ -339,11 +415,26 @@ and will be removed in future versions of Git Town.`
 	SkipNoFinalSnapshot                   = "found no final snapshot"
 	SkipNoInitialBranchInfo               = "found no information about branch %s in the initial snapshot"
 	SkipNothingToDo                       = "nothing to skip"
+	SplitBranchListedTwice                = "branch %s is listed more than once"
+	SplitDetachedHead                     = "please check out the branch to split"
+	SplitNoCommits                        = "branch %s has no commits to split"
+	SplitNoCommitsSelected                = "please select at least one commit for branch %s"
+	SplitNonContiguousCommits             = "the commits for branch %s must be a contiguous range"
+	SplitNoParent                         = "cannot split branch %s because it has no parent"
+	SplitUnsupportedBranchType            = "cannot split %s branches since you don't own them"
 	SquashCannotReadFile                  = "cannot read squash message file %s: %w"
 	SquashCommitAuthorProblem             = "error getting squash commit author: %w"
 	SquashCommitAuthorQuery               = "Please choose an author for the squash commit:"
 	SquashCommitAuthorSelection           = "Selected squash commit author: %s\n"
 	SquashMessageProblem                  = "cannot comment out the squash commit message: %w"
+	StackStatusDetachedHead               = "please check out a branch of the stack to display"
+	StackStatusDraft                      = "draft"
+	StackStatusFixHint                    = "\nTo update the proposal targets to match the branch lineage, run \"git town stack status --fix\".\n"
+	StackStatusMergedButExists            = "proposal is merged but the branch still exists locally, run \"git town sync\" to delete it"
+	StackStatusNoProposal                 = "no proposal"
+	StackStatusNothingToFix               = "The proposal targets already match the branch lineage."
+	StackStatusProposal                   = "#%s (%s) into %s"
+	StackStatusTargetMismatch             = "proposal targets %s but the parent branch is %s"
 	StashResult                           = "Stash: %s\n"
 	StatusFileNotFound                    = "No status file found for this repository."
 	SwapNeedsCompress                     = "cannot swap because branch %s contains merge commits - please compress and try again"
@@ -358,6 +449,7 @@ and will be removed in future versions of Git Town.`
 	SwapUnsupportedBranchType             = "cannot swap: branch %s is a %s branch"
 	SwitchNoBranches                      = "no branches to switch to"
 	SwitchUncommittedChanges              = "uncommitted changes"
+	SyncBranchMergedAtForge               = "branch %s was merged at the forge"
 	SyncFeatureBranches                   = "Sync feature branches: %s\n"
 	SyncPerennialBranches                 = "Sync perennial branches: %s\n"
 	SyncPerennialBranchHasUnpushedCommits = `cannot sync branch %s because it has unpushed local commits`
@@ -366,12 +458,19 @@ and will be removed in future versions of Git Town.`
 	SyncStatusNotRecognized               = "cannot determine the sync status for Git remote %s and branch name %s"
 	SyncTags                              = "Sync tags: %s\n"
 	SyncWithUpstream                      = "Sync with upstream: %s\n"
+	SyncWorktreeHasOpenChanges            = `cannot sync branch %s because its worktree %s has uncommitted changes`
 
 	UndoCannotRevertCommitOnPerennialBranch = "Cannot undo commit %s because it is on a perennial branch"
 	UndoContinueGuidance                    = "\n\nTo continue after having resolved conflicts, run \"git town continue\".\nTo go back to where you started, run \"git town undo\".\n"
 	UndoCreateOpcodeProblem                 = "cannot create undo operations for %q: %w"
 	UndoMessage                             = `You can run "git town undo" to go back to where you started.`
 	UndoNothingToDo                         = "nothing to undo"
+	UndoNothingToDoOlderCommands            = "nothing to undo, the most recent Git Town command has already been undone.\nRun \"git town history\" to see older commands and \"git town undo --steps <number>\" to undo them."
+	UndoStepsFailed                         = "could not fully undo the %q command, stopped undoing older commands"
+	UndoStepsInconsistentChanges            = "cannot undo the %q command because branch %s was changed both locally and at its tracking branch afterwards"
+	UndoStepsTooMany                        = "cannot undo %d commands because Git Town remembers only %d"
+	UndoStepsUnfinished                     = "cannot undo multiple commands while the %q command is unfinished, please run \"git town undo\" first"
+	UndoStepsZero                           = "the number of commands to undo must be at least 1"
 	UnfinishedCommandHandle                 = "Handle unfinished command: %s\n"
 	UnfinishedRunStateBoth                  = `Continue the old %s command and then run the current command`
 	UnfinishedRunStateContinue              = "Continue the \"%s\" command after having resolved conflicts"
@@ -390,4 +489,10 @@ and will be removed in future versions of Git Town.`
 	WalkDetachedHead = "please check out the branch from which to walk"
 	WalkDone         = "Branch walk done."
 	WalkNoDryRun     = "there is no dry-run mode for walking through branches on your shell, please call with a command to run on each branch"
+
+	WorktreeAddDetachedHead      = "please check out the branch that should become the parent of the new branch"
+	WorktreeHasOpenChanges       = "cannot remove worktree %s of branch %s because it has uncommitted changes"
+	WorktreeMainNotFound         = "cannot determine the main worktree of this repository"
+	WorktreePruneNothingToDo     = "no worktrees to prune"
+	WorktreePruneUnmergedChanges = "Branch %s was deleted at the remote but the local branch contains unshipped changes.\nI am therefore not removing its worktree %s. You can see the unshipped changes by running \"git town diff-parent\" in that worktree."
 )
@@ -0,0 +1,38 @@
+package programs
+
+import (
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/vm/opcodes"
+	"github.com/git-town/git-town/v22/internal/vm/program"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// MoveBranchOverridesProgram adds opcodes that move the settings specific to the given old branch to the given new branch.
+// The given overrides must come from the local Git metadata, settings in configuration files remain untouched.
+func MoveBranchOverridesProgram(prog Mutable[program.Program], overrides configdomain.BranchOverrides, oldBranch, newBranch gitdomain.LocalBranchName) {
+	override, hasOverride := overrides[configdomain.BranchPattern(oldBranch)]
+	if !hasOverride {
+		return
+	}
+	for _, setting := range configdomain.BranchOverridableKeys {
+		if value, hasValue := override.Value(setting).Get(); hasValue {
+			prog.Value.Add(&opcodes.BranchOverrideSet{Branch: newBranch, Setting: setting, Value: value})
+		}
+	}
+	RemoveBranchOverridesProgram(prog, overrides, oldBranch)
+}
+
+// RemoveBranchOverridesProgram adds opcodes that remove the settings specific to the given branch.
+// The given overrides must come from the local Git metadata, settings in configuration files remain untouched.
+func RemoveBranchOverridesProgram(prog Mutable[program.Program], overrides configdomain.BranchOverrides, branch gitdomain.LocalBranchName) {
+	override, hasOverride := overrides[configdomain.BranchPattern(branch)]
+	if !hasOverride {
+		return
+	}
+	for _, setting := range configdomain.BranchOverridableKeys {
+		if override.Value(setting).IsSome() {
+			prog.Value.Add(&opcodes.BranchOverrideRemove{Branch: branch, Setting: setting})
+		}
+	}
+}
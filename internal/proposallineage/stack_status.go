@@ -0,0 +1,79 @@
+package proposallineage
+
+import (
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// StackStatusEntry describes the proposal of a branch in a stack
+// and how that proposal deviates from the branch lineage.
+type StackStatusEntry struct {
+	Branch          gitdomain.LocalBranchName
+	Depth           int                               // how deep in the stack this branch is
+	MergedButExists bool                              // whether the proposal is merged but the branch still exists locally
+	Parent          Option[gitdomain.LocalBranchName] // the parent branch according to the lineage
+	Proposal        Option[forgedomain.Proposal]      // the most relevant proposal for this branch
+	TargetMismatch  bool                              // whether the open proposal targets a different branch than the parent
+}
+
+// HasMismatch indicates whether the proposal of this branch is out of sync with the lineage.
+func (self StackStatusEntry) HasMismatch() bool {
+	return self.MergedButExists || self.TargetMismatch
+}
+
+// CalculateStackStatus provides the proposal status of all branches in the given tree.
+// The given proposals contain all known proposals, indexed by their source branch.
+func CalculateStackStatus(tree TreeNode, proposals map[gitdomain.LocalBranchName][]forgedomain.Proposal, localBranches gitdomain.LocalBranchNames) []StackStatusEntry {
+	result := []StackStatusEntry{}
+	return calculateStackStatusHelper(tree, None[gitdomain.LocalBranchName](), 0, proposals, localBranches, result)
+}
+
+func calculateStackStatusHelper(node TreeNode, parentOpt Option[gitdomain.LocalBranchName], depth int, proposals map[gitdomain.LocalBranchName][]forgedomain.Proposal, localBranches gitdomain.LocalBranchNames, result []StackStatusEntry) []StackStatusEntry {
+	entry := StackStatusEntry{
+		Branch:          node.Branch,
+		Depth:           depth,
+		MergedButExists: false,
+		Parent:          parentOpt,
+		Proposal:        None[forgedomain.Proposal](),
+		TargetMismatch:  false,
+	}
+	if parent, hasParent := parentOpt.Get(); hasParent {
+		entry.Proposal = selectProposal(proposals[node.Branch], parent)
+		if proposal, hasProposal := entry.Proposal.Get(); hasProposal {
+			data := proposal.Data.Data()
+			switch data.State() {
+			case forgedomain.ProposalStateOpen:
+				entry.TargetMismatch = data.Target != parent
+			case forgedomain.ProposalStateMerged:
+				entry.MergedButExists = localBranches.Contains(node.Branch)
+			case forgedomain.ProposalStateClosed:
+			}
+		}
+	}
+	result = append(result, entry)
+	for _, child := range node.Children {
+		result = calculateStackStatusHelper(child, Some(node.Branch), depth+1, proposals, localBranches, result)
+	}
+	return result
+}
+
+// selectProposal provides the most relevant of the given proposals:
+// open proposals into the given parent branch, then other open proposals,
+// then merged proposals, then closed proposals.
+func selectProposal(proposals []forgedomain.Proposal, parent gitdomain.LocalBranchName) Option[forgedomain.Proposal] {
+	for _, proposal := range proposals {
+		data := proposal.Data.Data()
+		if data.State() == forgedomain.ProposalStateOpen && data.Target == parent {
+			return Some(proposal)
+		}
+	}
+	for _, state := range []forgedomain.ProposalState{forgedomain.ProposalStateOpen, forgedomain.ProposalStateMerged, forgedomain.ProposalStateClosed} {
+		for _, proposal := range proposals {
+			if proposal.Data.Data().State() == state {
+				return Some(proposal)
+			}
+		}
+	}
+	return None[forgedomain.Proposal]()
+}
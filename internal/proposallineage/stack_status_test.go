@@ -0,0 +1,154 @@
+package proposallineage_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/proposallineage"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestCalculateStackStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("merged proposal for a branch that no longer exists locally", func(t *testing.T) {
+		t.Parallel()
+		tree := proposallineage.TreeNode{
+			Branch: "main",
+			Children: []proposallineage.TreeNode{
+				{Branch: "branch-1"},
+			},
+		}
+		proposal := forgedomain.Proposal{
+			Data:      forgedomain.ProposalData{Active: false, Merged: true, Number: 1, Source: "branch-1", Target: "main"},
+			ForgeType: forgedomain.ForgeTypeGithub,
+		}
+		proposals := map[gitdomain.LocalBranchName][]forgedomain.Proposal{
+			"branch-1": {proposal},
+		}
+		have := proposallineage.CalculateStackStatus(tree, proposals, gitdomain.LocalBranchNames{"main"})
+		must.False(t, have[1].MergedButExists)
+		must.False(t, have[1].HasMismatch())
+	})
+
+	t.Run("merged proposal for a branch that still exists locally", func(t *testing.T) {
+		t.Parallel()
+		tree := proposallineage.TreeNode{
+			Branch: "main",
+			Children: []proposallineage.TreeNode{
+				{Branch: "branch-1"},
+			},
+		}
+		proposal := forgedomain.Proposal{
+			Data:      forgedomain.ProposalData{Active: false, Merged: true, Number: 1, Source: "branch-1", Target: "main"},
+			ForgeType: forgedomain.ForgeTypeGithub,
+		}
+		proposals := map[gitdomain.LocalBranchName][]forgedomain.Proposal{
+			"branch-1": {proposal},
+		}
+		have := proposallineage.CalculateStackStatus(tree, proposals, gitdomain.LocalBranchNames{"main", "branch-1"})
+		want := []proposallineage.StackStatusEntry{
+			{
+				Branch:          "main",
+				Depth:           0,
+				MergedButExists: false,
+				Parent:          None[gitdomain.LocalBranchName](),
+				Proposal:        None[forgedomain.Proposal](),
+				TargetMismatch:  false,
+			},
+			{
+				Branch:          "branch-1",
+				Depth:           1,
+				MergedButExists: true,
+				Parent:          Some(gitdomain.NewLocalBranchName("main")),
+				Proposal:        Some(proposal),
+				TargetMismatch:  false,
+			},
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("prefers the open proposal into the parent branch", func(t *testing.T) {
+		t.Parallel()
+		tree := proposallineage.TreeNode{
+			Branch: "main",
+			Children: []proposallineage.TreeNode{
+				{Branch: "branch-1", Children: []proposallineage.TreeNode{
+					{Branch: "branch-2"},
+				}},
+			},
+		}
+		closedProposal := forgedomain.Proposal{
+			Data:      forgedomain.ProposalData{Active: false, Number: 2, Source: "branch-2", Target: "branch-1"},
+			ForgeType: forgedomain.ForgeTypeGithub,
+		}
+		otherProposal := forgedomain.Proposal{
+			Data:      forgedomain.ProposalData{Active: true, Number: 3, Source: "branch-2", Target: "main"},
+			ForgeType: forgedomain.ForgeTypeGithub,
+		}
+		matchingProposal := forgedomain.Proposal{
+			Data:      forgedomain.ProposalData{Active: true, Number: 4, Source: "branch-2", Target: "branch-1"},
+			ForgeType: forgedomain.ForgeTypeGithub,
+		}
+		proposals := map[gitdomain.LocalBranchName][]forgedomain.Proposal{
+			"branch-2": {closedProposal, otherProposal, matchingProposal},
+		}
+		have := proposallineage.CalculateStackStatus(tree, proposals, gitdomain.LocalBranchNames{"main", "branch-1", "branch-2"})
+		must.Eq(t, Some(matchingProposal), have[2].Proposal)
+		must.False(t, have[2].HasMismatch())
+	})
+
+	t.Run("proposal targets a different branch than the parent", func(t *testing.T) {
+		t.Parallel()
+		tree := proposallineage.TreeNode{
+			Branch: "main",
+			Children: []proposallineage.TreeNode{
+				{Branch: "branch-1", Children: []proposallineage.TreeNode{
+					{Branch: "branch-2"},
+				}},
+			},
+		}
+		proposal1 := forgedomain.Proposal{
+			Data:      forgedomain.ProposalData{Active: true, Number: 1, Source: "branch-1", Target: "main"},
+			ForgeType: forgedomain.ForgeTypeGithub,
+		}
+		proposal2 := forgedomain.Proposal{
+			Data:      forgedomain.ProposalData{Active: true, Number: 2, Source: "branch-2", Target: "main"},
+			ForgeType: forgedomain.ForgeTypeGithub,
+		}
+		proposals := map[gitdomain.LocalBranchName][]forgedomain.Proposal{
+			"branch-1": {proposal1},
+			"branch-2": {proposal2},
+		}
+		have := proposallineage.CalculateStackStatus(tree, proposals, gitdomain.LocalBranchNames{"main", "branch-1", "branch-2"})
+		want := []proposallineage.StackStatusEntry{
+			{
+				Branch:          "main",
+				Depth:           0,
+				MergedButExists: false,
+				Parent:          None[gitdomain.LocalBranchName](),
+				Proposal:        None[forgedomain.Proposal](),
+				TargetMismatch:  false,
+			},
+			{
+				Branch:          "branch-1",
+				Depth:           1,
+				MergedButExists: false,
+				Parent:          Some(gitdomain.NewLocalBranchName("main")),
+				Proposal:        Some(proposal1),
+				TargetMismatch:  false,
+			},
+			{
+				Branch:          "branch-2",
+				Depth:           2,
+				MergedButExists: false,
+				Parent:          Some(gitdomain.NewLocalBranchName("branch-1")),
+				Proposal:        Some(proposal2),
+				TargetMismatch:  true,
+			},
+		}
+		must.Eq(t, want, have)
+	})
+}
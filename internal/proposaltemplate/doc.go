@@ -0,0 +1,3 @@
+// Package proposaltemplate renders the proposal template configured via "propose.template"
+// into the title and body of new proposals.
+package proposaltemplate
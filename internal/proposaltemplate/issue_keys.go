@@ -0,0 +1,22 @@
+package proposaltemplate
+
+import (
+	"regexp"
+	"slices"
+
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+)
+
+// matches issue keys in the format used by Jira, Linear, YouTrack, and similar issue trackers
+var issueKeyRE = regexp.MustCompile(`\b[A-Z][A-Z0-9]+-[0-9]+\b`)
+
+// IssueKeys provides the issue keys like "ABC-123" that the given branch name contains.
+func IssueKeys(branch gitdomain.LocalBranchName) []string {
+	result := []string{}
+	for _, key := range issueKeyRE.FindAllString(branch.String(), -1) {
+		if !slices.Contains(result, key) {
+			result = append(result, key)
+		}
+	}
+	return result
+}
@@ -0,0 +1,27 @@
+package proposaltemplate_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/proposaltemplate"
+	"github.com/shoenig/test/must"
+)
+
+func TestIssueKeys(t *testing.T) {
+	t.Parallel()
+	tests := map[gitdomain.LocalBranchName][]string{
+		"ABC-123":                     {"ABC-123"},
+		"feature/ABC-123-login":       {"ABC-123"},
+		"kg/ABC-123-DEF-45-combined":  {"ABC-123", "DEF-45"},
+		"ABC-123-followup-to-ABC-123": {"ABC-123"},
+		"fix-123":                     {},
+		"feature":                     {},
+		"PROJ2-7":                     {"PROJ2-7"},
+		"release-v1.2":                {},
+	}
+	for give, want := range tests {
+		have := proposaltemplate.IssueKeys(give)
+		must.Eq(t, want, have)
+	}
+}
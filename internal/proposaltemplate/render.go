@@ -0,0 +1,67 @@
+package proposaltemplate
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// Commit describes a commit in the branch to propose.
+type Commit struct {
+	Body  string // the commit message without the title
+	Title string // the first line of the commit message
+}
+
+// Data contains the information that proposal templates can access.
+type Data struct {
+	Branch    string   // name of the branch to propose
+	Commits   []Commit // the commits in the branch to propose, oldest first
+	IssueKeys []string // issue keys like "ABC-123" contained in the branch name
+	Parent    string   // name of the branch into which to propose
+	lineage   func() string
+}
+
+func NewData(branch, parent gitdomain.LocalBranchName, commitMessages gitdomain.CommitMessages, lineage func() string) Data {
+	commits := make([]Commit, len(commitMessages))
+	for c, commitMessage := range commitMessages {
+		parts := commitMessage.Parts()
+		commits[c] = Commit{
+			Body:  parts.Body,
+			Title: parts.Title.String(),
+		}
+	}
+	return Data{
+		Branch:    branch.String(),
+		Commits:   commits,
+		IssueKeys: IssueKeys(branch),
+		Parent:    parent.String(),
+		lineage:   lineage,
+	}
+}
+
+// Lineage provides the lineage breadcrumb of the branch to propose.
+// Templates access it as {{.Lineage}}.
+// It is only loaded when a template uses it because loading it queries the forge.
+func (self Data) Lineage() string {
+	if self.lineage == nil {
+		return ""
+	}
+	return self.lineage()
+}
+
+// Render renders the given template text using the given data.
+// The first line of the result becomes the proposal title, the remaining text the proposal body.
+func Render(text string, data Data) (Option[gitdomain.ProposalTitle], Option[gitdomain.ProposalBody], error) {
+	tmpl, err := template.New("proposal").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return None[gitdomain.ProposalTitle](), None[gitdomain.ProposalBody](), err
+	}
+	var rendered strings.Builder
+	if err = tmpl.Execute(&rendered, data); err != nil {
+		return None[gitdomain.ProposalTitle](), None[gitdomain.ProposalBody](), err
+	}
+	parts := gitdomain.CommitMessage(strings.TrimSpace(rendered.String())).Parts()
+	return gitdomain.NewProposalTitleOpt(strings.TrimSpace(parts.Title.String())), gitdomain.NewProposalBodyOpt(strings.TrimSpace(parts.Body)), nil
+}
@@ -0,0 +1,82 @@
+package proposaltemplate_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/proposaltemplate"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("branch, parent, commits, and issue keys", func(t *testing.T) {
+		t.Parallel()
+		text := `
+{{ range .IssueKeys }}[{{ . }}] {{ end }}{{ (index .Commits 0).Title }}
+
+Merges {{ .Branch }} into {{ .Parent }}.
+
+{{ range .Commits -}}
+- {{ .Title }}
+{{ end }}
+{{- (index .Commits 1).Body }}
+`[1:]
+		commits := gitdomain.NewCommitMessages("add login form", "validate input\n\nrejects empty passwords")
+		data := proposaltemplate.NewData("ABC-123-login", "main", commits, nil)
+		title, body, err := proposaltemplate.Render(text, data)
+		must.NoError(t, err)
+		must.Eq(t, Some(gitdomain.ProposalTitle("[ABC-123] add login form")), title)
+		wantBody := `
+Merges ABC-123-login into main.
+
+- add login form
+- validate input
+rejects empty passwords`[1:]
+		must.Eq(t, Some(gitdomain.ProposalBody(wantBody)), body)
+	})
+
+	t.Run("lineage", func(t *testing.T) {
+		t.Parallel()
+		data := proposaltemplate.NewData("feature", "main", gitdomain.CommitMessages{}, func() string { return "main\n  feature" })
+		title, body, err := proposaltemplate.Render("{{ .Branch }}\n\n{{ .Lineage }}", data)
+		must.NoError(t, err)
+		must.Eq(t, Some(gitdomain.ProposalTitle("feature")), title)
+		must.Eq(t, Some(gitdomain.ProposalBody("main\n  feature")), body)
+	})
+
+	t.Run("lineage not loaded if unused", func(t *testing.T) {
+		t.Parallel()
+		data := proposaltemplate.NewData("feature", "main", gitdomain.CommitMessages{}, func() string {
+			t.Fatal("loaded the lineage")
+			return ""
+		})
+		_, _, err := proposaltemplate.Render("{{ .Branch }}", data)
+		must.NoError(t, err)
+	})
+
+	t.Run("only a title", func(t *testing.T) {
+		t.Parallel()
+		data := proposaltemplate.NewData("feature", "main", gitdomain.CommitMessages{}, nil)
+		title, body, err := proposaltemplate.Render("{{ .Branch }}\n", data)
+		must.NoError(t, err)
+		must.Eq(t, Some(gitdomain.ProposalTitle("feature")), title)
+		must.Eq(t, None[gitdomain.ProposalBody](), body)
+	})
+
+	t.Run("syntax error", func(t *testing.T) {
+		t.Parallel()
+		data := proposaltemplate.NewData("feature", "main", gitdomain.CommitMessages{}, nil)
+		_, _, err := proposaltemplate.Render("{{ .Branch", data)
+		must.Error(t, err)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		t.Parallel()
+		data := proposaltemplate.NewData("feature", "main", gitdomain.CommitMessages{}, nil)
+		_, _, err := proposaltemplate.Render("{{ .Unknown }}", data)
+		must.Error(t, err)
+	})
+}
@@ -53,6 +53,7 @@ EnterForgeData:
 	}
 	devURL := data.Config.NormalConfig.DevURL(data.Backend)
 	actualForgeType := determineForgeType(enteredForgeType.Or(data.Config.File.ForgeType), devURL)
+	azuredevopsToken := None[forgedomain.AzuredevopsToken]()
 	bitbucketUsername := None[forgedomain.BitbucketUsername]()
 	bitbucketAppPassword := None[forgedomain.BitbucketAppPassword]()
 	forgejoToken := None[forgedomain.ForgejoToken]()
@@ -64,7 +65,10 @@ EnterForgeData:
 	if forgeType, hasForgeType := actualForgeType.Get(); hasForgeType {
 		switch forgeType {
 		case forgedomain.ForgeTypeAzuredevops:
-			// the Azure DevOps connector doesn't have connectivity to the API implemented for now
+			azuredevopsToken, exit, err = enterAzuredevopsToken(data)
+			if err != nil || exit {
+				return emptyResult, exit, false, err
+			}
 		case forgedomain.ForgeTypeBitbucket, forgedomain.ForgeTypeBitbucketDatacenter:
 			bitbucketUsername, exit, err = enterBitbucketUserName(data)
 			if err != nil || exit {
@@ -117,6 +121,7 @@ EnterForgeData:
 		}
 	}
 	flow, exit, err := testForgeAuth(testForgeAuthArgs{
+		azuredevopsToken:     azuredevopsToken.Or(data.Config.GitGlobal.AzuredevopsToken),
 		backend:              data.Backend,
 		bitbucketAppPassword: bitbucketAppPassword.Or(data.Config.GitGlobal.BitbucketAppPassword),
 		bitbucketUsername:    bitbucketUsername.Or(data.Config.GitGlobal.BitbucketUsername),
@@ -140,6 +145,7 @@ EnterForgeData:
 		goto EnterForgeData
 	}
 	tokenScope, exit, err := enterTokenScope(enterTokenScopeArgs{
+		azuredevopsToken:     azuredevopsToken,
 		bitbucketAppPassword: bitbucketAppPassword,
 		bitbucketUsername:    bitbucketUsername,
 		data:                 data,
@@ -288,8 +294,10 @@ EnterForgeData:
 		Aliases:                     aliases,
 		AutoResolve:                 None[configdomain.AutoResolve](),
 		AutoSync:                    autoSync,
+		AzuredevopsToken:            azuredevopsToken,
 		BitbucketAppPassword:        bitbucketAppPassword,
 		BitbucketUsername:           bitbucketUsername,
+		BranchOverrides:             configdomain.BranchOverrides{}, // the setup assistant doesn't ask for this
 		BranchPrefix:                branchPrefix,
 		BranchTypeOverrides:         configdomain.BranchTypeOverrides{}, // the setup assistant doesn't ask for this
 		Browser:                     None[configdomain.Browser](),
@@ -321,9 +329,15 @@ EnterForgeData:
 		PerennialRegex:              perennialRegex,
 		ProposalBreadcrumb:          proposalBreadcrumb,
 		ProposalBreadcrumbDirection: proposalBreadcrumbDirection,
+		ProposeAssignees:            gitdomain.ProposalMetadataNames{},    // the setup assistant doesn't ask for this
+		ProposeDraft:                None[configdomain.ProposeDraft](),    // the setup assistant doesn't ask for this
+		ProposeLabels:               gitdomain.ProposalMetadataNames{},    // the setup assistant doesn't ask for this
+		ProposeReviewers:            gitdomain.ProposalMetadataNames{},    // the setup assistant doesn't ask for this
+		ProposeTemplate:             None[configdomain.ProposeTemplate](), // the setup assistant doesn't ask for this
 		PushBranches:                pushBranches,
 		PushHook:                    pushHook,
 		ShareNewBranches:            shareNewBranches,
+		ShipAPIMergeMethod:          None[forgedomain.ProposalMergeMethod](), // the setup assistant doesn't ask for this
 		ShipDeleteTrackingBranch:    shipDeleteTrackingBranch,
 		ShipStrategy:                shipStrategy,
 		Stash:                       stash,
@@ -333,7 +347,8 @@ EnterForgeData:
 		SyncTags:                    syncTags,
 		SyncUpstream:                syncUpstream,
 		UnknownBranchType:           unknownBranchType,
-		Verbose:                     None[configdomain.Verbose](), // the setup assistant doesn't ask for this
+		Verbose:                     None[configdomain.Verbose](),      // the setup assistant doesn't ask for this
+		WorktreeRoot:                None[configdomain.WorktreeRoot](), // the setup assistant doesn't ask for this
 	}
 	validatedData := configdomain.ValidatedConfigData{
 		MainBranch: mainBranchResult.ActualMainBranch,
@@ -382,6 +397,18 @@ func enterAutoSync(data Data) (Option[configdomain.AutoSync], dialogdomain.Exit,
 	})
 }
 
+func enterAzuredevopsToken(data Data) (Option[forgedomain.AzuredevopsToken], dialogdomain.Exit, error) {
+	if data.Config.File.AzuredevopsToken.IsSome() {
+		return None[forgedomain.AzuredevopsToken](), false, nil
+	}
+	return dialog.AzuredevopsToken(dialog.Args[forgedomain.AzuredevopsToken]{
+		DisplayDialogs: data.Config.NormalConfig.DisplayDialogs,
+		Global:         data.Config.GitGlobal.AzuredevopsToken,
+		Inputs:         data.Inputs,
+		Local:          data.Config.GitLocal.AzuredevopsToken,
+	})
+}
+
 func enterBitbucketAppPassword(data Data) (Option[forgedomain.BitbucketAppPassword], dialogdomain.Exit, error) {
 	if data.Config.File.BitbucketUsername.IsSome() {
 		return None[forgedomain.BitbucketAppPassword](), false, nil
@@ -817,6 +844,7 @@ func enterTokenScope(args enterTokenScopeArgs) (configdomain.ConfigScope, dialog
 }
 
 type enterTokenScopeArgs struct {
+	azuredevopsToken     Option[forgedomain.AzuredevopsToken]
 	bitbucketAppPassword Option[forgedomain.BitbucketAppPassword]
 	bitbucketUsername    Option[forgedomain.BitbucketUsername]
 	data                 Data
@@ -849,7 +877,7 @@ func shouldAskForScope(args enterTokenScopeArgs) bool {
 	if forgeType, hasForgeType := args.determinedForgeType.Get(); hasForgeType {
 		switch forgeType {
 		case forgedomain.ForgeTypeAzuredevops:
-			return false
+			return existsAndChanged(args.azuredevopsToken, args.existingConfig.AzuredevopsToken)
 		case forgedomain.ForgeTypeBitbucket, forgedomain.ForgeTypeBitbucketDatacenter:
 			return existsAndChanged(args.bitbucketUsername, args.existingConfig.BitbucketUsername) &&
 				existsAndChanged(args.bitbucketAppPassword, args.existingConfig.BitbucketAppPassword)
@@ -871,6 +899,7 @@ func testForgeAuth(args testForgeAuthArgs) (configdomain.ProgramFlow, dialogdoma
 		return configdomain.ProgramFlowContinue, false, nil
 	}
 	connectorOpt, err := forge.NewConnector(forge.NewConnectorArgs{
+		AzuredevopsToken:     args.azuredevopsToken,
 		Backend:              args.backend,
 		BitbucketAppPassword: args.bitbucketAppPassword,
 		BitbucketUsername:    args.bitbucketUsername,
@@ -911,6 +940,7 @@ func testForgeAuth(args testForgeAuthArgs) (configdomain.ProgramFlow, dialogdoma
 }
 
 type testForgeAuthArgs struct {
+	azuredevopsToken     Option[forgedomain.AzuredevopsToken]
 	backend              subshelldomain.RunnerQuerier
 	bitbucketAppPassword Option[forgedomain.BitbucketAppPassword]
 	bitbucketUsername    Option[forgedomain.BitbucketUsername]
@@ -932,7 +962,8 @@ func tokenScopeDialog(args enterTokenScopeArgs) (configdomain.ConfigScope, dialo
 	if forgeType, hasForgeType := args.determinedForgeType.Get(); hasForgeType {
 		switch forgeType {
 		case forgedomain.ForgeTypeAzuredevops:
-			return configdomain.ConfigScopeLocal, false, nil
+			existingScope := determineExistingScope(args.data.Snapshot, configdomain.KeyAzuredevopsToken, args.data.Config.NormalConfig.AzuredevopsToken)
+			return dialog.TokenScope(existingScope, args.inputs, args.data.Config.NormalConfig.DisplayDialogs)
 		case forgedomain.ForgeTypeBitbucket, forgedomain.ForgeTypeBitbucketDatacenter:
 			existingScope := determineExistingScope(args.data.Snapshot, configdomain.KeyBitbucketUsername, args.data.Config.NormalConfig.BitbucketUsername)
 			return dialog.TokenScope(existingScope, args.inputs, args.data.Config.NormalConfig.DisplayDialogs)
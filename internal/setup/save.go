@@ -23,7 +23,9 @@ func Save(userInput UserInput, unvalidatedConfig config.UnvalidatedConfig, data
 	if forgeType, hasForgeType := userInput.DeterminedForgeType.Get(); hasForgeType {
 		switch forgeType {
 		case forgedomain.ForgeTypeAzuredevops:
-			// no API token for now
+			fc.Check(
+				saveAzuredevopsToken(userInput.Data.AzuredevopsToken, unvalidatedConfig.GitLocal.AzuredevopsToken, userInput.Scope, frontend),
+			)
 		case forgedomain.ForgeTypeBitbucket, forgedomain.ForgeTypeBitbucketDatacenter:
 			fc.Check(
 				saveBitbucketUsername(userInput.Data.BitbucketUsername, unvalidatedConfig.GitLocal.BitbucketUsername, userInput.Scope, frontend),
@@ -366,6 +368,16 @@ func saveAutoSync(valueToWriteToGit Option[configdomain.AutoSync], valueAlreadyI
 	return nil
 }
 
+func saveAzuredevopsToken(valueToWriteToGit Option[forgedomain.AzuredevopsToken], valueAlreadyInGit Option[forgedomain.AzuredevopsToken], scope configdomain.ConfigScope, frontend subshelldomain.Runner) error {
+	if valueToWriteToGit.Equal(valueAlreadyInGit) {
+		return nil
+	}
+	if value, has := valueToWriteToGit.Get(); has {
+		return gitconfig.SetAzuredevopsToken(frontend, value, scope)
+	}
+	return gitconfig.RemoveAzuredevopsToken(frontend)
+}
+
 func saveBitbucketAppPassword(valueToWriteToGit Option[forgedomain.BitbucketAppPassword], valueAlreadyInGit Option[forgedomain.BitbucketAppPassword], scope configdomain.ConfigScope, runner subshelldomain.Runner) error {
 	if valueToWriteToGit.Equal(valueAlreadyInGit) {
 		return nil
@@ -3,6 +3,7 @@ package skip
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/git-town/git-town/v22/internal/cli/dialog/dialogcomponents"
 	"github.com/git-town/git-town/v22/internal/config"
@@ -60,10 +61,18 @@ func Execute(args ExecuteArgs) error {
 		Prog:          skipProgram,
 	})
 	args.RunState.AbortProgram = program.Program{}
-	if err := revertChangesToCurrentBranch(args); err != nil {
-		return err
+	if args.RunState.Command == "ship" {
+		// the branches stacked on top of the skipped branch contain its changes,
+		// so skipping a branch while shipping a stack skips these branches as well
+		// and keeps the branches that have already shipped
+		args.RunState.RunProgram = RemoveOpcodesForRemainingBranches(args.RunState.RunProgram)
+	} else {
+		if err := revertChangesToCurrentBranch(args); err != nil {
+			return err
+		}
+		args.RunState.RunProgram = RemoveOpcodesForCurrentBranch(args.RunState.RunProgram)
+		args.RunState.RunProgram = RemoveBatchedPush(args.RunState.RunProgram, args.InitialBranch)
 	}
-	args.RunState.RunProgram = RemoveOpcodesForCurrentBranch(args.RunState.RunProgram)
 	return fullinterpreter.Execute(fullinterpreter.ExecuteArgs{
 		Backend:                 args.Backend,
 		CommandsCounter:         args.CommandsCounter,
@@ -101,6 +110,38 @@ func RemoveOpcodesForCurrentBranch(prog program.Program) program.Program {
 	return result
 }
 
+// removes the given branch from the batched pushes in the given program
+func RemoveBatchedPush(prog program.Program, branch gitdomain.LocalBranchName) program.Program {
+	result := make(program.Program, 0, len(prog))
+	for _, opcode := range prog {
+		batchedPush, isBatchedPush := opcode.(*opcodes.PushBranchesIfNeeded)
+		if !isBatchedPush {
+			result.Add(opcode)
+			continue
+		}
+		branchPushes := make([]gitdomain.BranchPush, 0, len(batchedPush.Branches))
+		for _, branchPush := range batchedPush.Branches {
+			if branchPush.Branch != branch {
+				branchPushes = append(branchPushes, branchPush)
+			}
+		}
+		if len(branchPushes) > 0 {
+			result.Add(&opcodes.PushBranchesIfNeeded{Branches: branchPushes})
+		}
+	}
+	return result
+}
+
+// removes the remaining opcodes for all branches from the given program
+func RemoveOpcodesForRemainingBranches(prog program.Program) program.Program {
+	for i := len(prog) - 1; i >= 0; i-- {
+		if opcodes.IsEndOfBranchProgramOpcode(prog[i]) {
+			return slices.Clone(prog[i+1:])
+		}
+	}
+	return program.Program{}
+}
+
 func revertChangesToCurrentBranch(args ExecuteArgs) error {
 	before := args.RunState.BeginBranchesSnapshot.Branches.FindByLocalName(args.InitialBranch)
 	if before.IsNone() {
@@ -124,6 +165,7 @@ func revertChangesToCurrentBranch(args ExecuteArgs) error {
 		FinalMessages:            args.FinalMessages,
 		UndoAPIProgram:           args.RunState.UndoAPIProgram,
 		UndoablePerennialCommits: args.RunState.UndoablePerennialCommits,
+		Worktrees:                args.RunState.Worktrees,
 	})
 	lightinterpreter.Execute(lightinterpreter.ExecuteArgs{
 		Backend:       args.Backend,
@@ -3,12 +3,59 @@ package skip_test
 import (
 	"testing"
 
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
 	"github.com/git-town/git-town/v22/internal/skip"
 	"github.com/git-town/git-town/v22/internal/vm/opcodes"
 	"github.com/git-town/git-town/v22/internal/vm/program"
 	"github.com/shoenig/test/must"
 )
 
+func TestRemoveBatchedPush(t *testing.T) {
+	t.Parallel()
+
+	t.Run("batched push contains the branch", func(t *testing.T) {
+		t.Parallel()
+		give := program.Program{
+			&opcodes.Checkout{Branch: "branch-2"},
+			&opcodes.ProgramEndOfBranch{},
+			&opcodes.PushBranchesIfNeeded{
+				Branches: []gitdomain.BranchPush{
+					{Branch: "branch-1", Mode: gitdomain.PushModeFastForward, TrackingBranch: "origin/branch-1"},
+					{Branch: "branch-2", Mode: gitdomain.PushModeForceWithLease, TrackingBranch: "origin/branch-2"},
+				},
+			},
+		}
+		have := skip.RemoveBatchedPush(give, "branch-2")
+		want := program.Program{
+			&opcodes.Checkout{Branch: "branch-2"},
+			&opcodes.ProgramEndOfBranch{},
+			&opcodes.PushBranchesIfNeeded{
+				Branches: []gitdomain.BranchPush{
+					{Branch: "branch-1", Mode: gitdomain.PushModeFastForward, TrackingBranch: "origin/branch-1"},
+				},
+			},
+		}
+		must.Eq(t, want.String(), have.String())
+	})
+
+	t.Run("batched push contains only the branch", func(t *testing.T) {
+		t.Parallel()
+		give := program.Program{
+			&opcodes.PushBranchesIfNeeded{
+				Branches: []gitdomain.BranchPush{
+					{Branch: "branch-1", Mode: gitdomain.PushModeFastForward, TrackingBranch: "origin/branch-1"},
+				},
+			},
+			&opcodes.PushTags{},
+		}
+		have := skip.RemoveBatchedPush(give, "branch-1")
+		want := program.Program{
+			&opcodes.PushTags{},
+		}
+		must.Eq(t, want.String(), have.String())
+	})
+}
+
 func TestRemoveOpcodesForCurrentBranch(t *testing.T) {
 	t.Parallel()
 
@@ -52,3 +99,36 @@ func TestRemoveOpcodesForCurrentBranch(t *testing.T) {
 		must.Eq(t, want.String(), have.String())
 	})
 }
+
+func TestRemoveOpcodesForRemainingBranches(t *testing.T) {
+	t.Parallel()
+
+	t.Run("program contains multiple branches", func(t *testing.T) {
+		t.Parallel()
+		give := program.Program{
+			&opcodes.Checkout{Branch: "branch-1"},
+			&opcodes.PullCurrentBranch{},
+			&opcodes.ProgramEndOfBranch{},
+			&opcodes.Checkout{Branch: "branch-2"},
+			&opcodes.PullCurrentBranch{},
+			&opcodes.ProgramEndOfBranch{},
+			&opcodes.CheckoutIfExists{Branch: "branch-2"},
+		}
+		have := skip.RemoveOpcodesForRemainingBranches(give)
+		want := program.Program{
+			&opcodes.CheckoutIfExists{Branch: "branch-2"},
+		}
+		must.Eq(t, want.String(), have.String())
+	})
+
+	t.Run("program contains no end of branch markers", func(t *testing.T) {
+		t.Parallel()
+		give := program.Program{
+			&opcodes.Checkout{Branch: "branch-1"},
+			&opcodes.PullCurrentBranch{},
+		}
+		have := skip.RemoveOpcodesForRemainingBranches(give)
+		want := program.Program{}
+		must.Eq(t, want.String(), have.String())
+	})
+}
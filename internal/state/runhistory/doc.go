@@ -0,0 +1,3 @@
+// Package runhistory persists the run states of the most recent Git Town commands,
+// so that "git town undo" can undo more than the last command.
+package runhistory
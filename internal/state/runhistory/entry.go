@@ -0,0 +1,13 @@
+package runhistory
+
+import (
+	"time"
+
+	"github.com/git-town/git-town/v22/internal/state/runstate"
+)
+
+// Entry is a finished Git Town command in the run history.
+type Entry struct {
+	RunState runstate.RunState // the run state of the finished Git Town command
+	Time     time.Time         // when the Git Town command finished
+}
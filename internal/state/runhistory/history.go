@@ -0,0 +1,33 @@
+package runhistory
+
+// MaxEntries is the number of Git Town commands that the run history remembers.
+const MaxEntries = 10
+
+// History contains the most recent finished Git Town commands, oldest first.
+type History []Entry
+
+// Add provides a copy of this History that contains the given entry as the most recent one.
+// The result contains at most MaxEntries entries.
+func (self History) Add(entry Entry) History {
+	result := append(self[:len(self):len(self)], entry)
+	if len(result) > MaxEntries {
+		result = result[len(result)-MaxEntries:]
+	}
+	return result
+}
+
+// Newest provides the given number of most recent entries, most recent first.
+func (self History) Newest(count int) History {
+	count = min(count, len(self))
+	result := make(History, count)
+	for i := range count {
+		result[i] = self[len(self)-1-i]
+	}
+	return result
+}
+
+// RemoveNewest provides a copy of this History without the given number of most recent entries.
+func (self History) RemoveNewest(count int) History {
+	count = min(count, len(self))
+	return self[: len(self)-count : len(self)-count]
+}
@@ -0,0 +1,67 @@
+package runhistory_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/state/runhistory"
+	"github.com/git-town/git-town/v22/internal/state/runstate"
+	"github.com/shoenig/test/must"
+)
+
+func TestHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Add", func(t *testing.T) {
+		t.Parallel()
+		t.Run("appends the entry as the most recent one", func(t *testing.T) {
+			t.Parallel()
+			history := runhistory.History{entry("hack")}
+			have := history.Add(entry("sync"))
+			want := runhistory.History{entry("hack"), entry("sync")}
+			must.Eq(t, want, have)
+			must.Eq(t, runhistory.History{entry("hack")}, history)
+		})
+		t.Run("removes the oldest entries beyond the maximum", func(t *testing.T) {
+			t.Parallel()
+			history := runhistory.History{}
+			for range runhistory.MaxEntries {
+				history = history.Add(entry("hack"))
+			}
+			have := history.Add(entry("sync"))
+			must.Len(t, runhistory.MaxEntries, have)
+			must.Eq(t, "sync", have[len(have)-1].RunState.Command)
+		})
+	})
+
+	t.Run("Newest", func(t *testing.T) {
+		t.Parallel()
+		t.Run("fewer entries than requested", func(t *testing.T) {
+			t.Parallel()
+			history := runhistory.History{entry("hack")}
+			have := history.Newest(3)
+			want := runhistory.History{entry("hack")}
+			must.Eq(t, want, have)
+		})
+		t.Run("more entries than requested", func(t *testing.T) {
+			t.Parallel()
+			history := runhistory.History{entry("hack"), entry("sync"), entry("ship")}
+			have := history.Newest(2)
+			want := runhistory.History{entry("ship"), entry("sync")}
+			must.Eq(t, want, have)
+		})
+	})
+
+	t.Run("RemoveNewest", func(t *testing.T) {
+		t.Parallel()
+		history := runhistory.History{entry("hack"), entry("sync"), entry("ship")}
+		have := history.RemoveNewest(2)
+		want := runhistory.History{entry("hack")}
+		must.Eq(t, want, have)
+	})
+}
+
+func entry(command string) runhistory.Entry {
+	return runhistory.Entry{
+		RunState: runstate.RunState{Command: command},
+	}
+}
@@ -0,0 +1,26 @@
+package runhistory
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v22/internal/messages"
+)
+
+// Load loads the run history for the given Git repo from disk.
+// Returns an empty History if there is no saved run history.
+func Load(runhistoryPath FilePath) (History, error) {
+	content, err := os.ReadFile(runhistoryPath.String())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return History{}, nil
+		}
+		return History{}, fmt.Errorf(messages.FileReadProblem, runhistoryPath, err)
+	}
+	var history History
+	if err = json.Unmarshal(content, &history); err != nil {
+		return History{}, fmt.Errorf(messages.FileContentInvalidJSON, runhistoryPath, err)
+	}
+	return history, nil
+}
@@ -0,0 +1,18 @@
+package runhistory
+
+import (
+	"path/filepath"
+
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+)
+
+// FilePath is the path to the runhistory file.
+type FilePath string
+
+func (self FilePath) String() string {
+	return string(self)
+}
+
+func NewRunhistoryPath(repoConfigDir configdomain.RepoConfigDir) FilePath {
+	return FilePath(filepath.Join(repoConfigDir.String(), "runhistory.json"))
+}
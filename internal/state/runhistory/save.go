@@ -0,0 +1,41 @@
+package runhistory
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/state/runstate"
+)
+
+// Append adds the given run state of a finished Git Town command to the run history on disk.
+func Append(runState runstate.RunState, runhistoryPath FilePath) error {
+	history, err := Load(runhistoryPath)
+	if err != nil {
+		return err
+	}
+	history = history.Add(Entry{
+		RunState: runState,
+		Time:     time.Now(),
+	})
+	return Save(history, runhistoryPath)
+}
+
+// Save stores the given run history for the given Git repo to disk.
+func Save(history History, runhistoryPath FilePath) error {
+	content, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return fmt.Errorf(messages.RunhistorySerializeProblem, err)
+	}
+	persistenceDir := filepath.Dir(runhistoryPath.String())
+	if err = os.MkdirAll(persistenceDir, 0o700); err != nil {
+		return err
+	}
+	if err = os.WriteFile(runhistoryPath.String(), content, 0o600); err != nil {
+		return fmt.Errorf(messages.FileWriteProblem, runhistoryPath, err)
+	}
+	return nil
+}
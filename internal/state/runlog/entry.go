@@ -2,6 +2,7 @@ package runlog
 
 import (
 	"os"
+	"strings"
 	"time"
 
 	"github.com/git-town/git-town/v22/internal/git/gitdomain"
@@ -36,3 +37,31 @@ func NewEntry(event Event, branchInfos gitdomain.BranchInfos, pendingCommand Opt
 		Time:           time.Now(),
 	}
 }
+
+// LocalBranches provides the local branches in this entry and their SHAs.
+func (self Entry) LocalBranches(remotes gitdomain.Remotes) map[gitdomain.LocalBranchName]gitdomain.SHA {
+	result := map[gitdomain.LocalBranchName]gitdomain.SHA{}
+	for branch, sha := range self.Branches {
+		if !isRemoteBranch(branch, remotes) {
+			result[gitdomain.NewLocalBranchName(branch.String())] = sha
+		}
+	}
+	return result
+}
+
+// RemoteBranches provides the remote branches in this entry and their SHAs.
+func (self Entry) RemoteBranches(remotes gitdomain.Remotes) map[gitdomain.RemoteBranchName]gitdomain.SHA {
+	result := map[gitdomain.RemoteBranchName]gitdomain.SHA{}
+	for branch, sha := range self.Branches {
+		if isRemoteBranch(branch, remotes) {
+			result[gitdomain.NewRemoteBranchName(branch.String())] = sha
+		}
+	}
+	return result
+}
+
+// indicates whether the given branch name refers to a branch at one of the given remotes
+func isRemoteBranch(branch gitdomain.BranchName, remotes gitdomain.Remotes) bool {
+	remote, _, hasSlash := strings.Cut(branch.String(), "/")
+	return hasSlash && remotes.HasRemote(gitdomain.Remote(remote))
+}
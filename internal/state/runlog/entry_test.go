@@ -15,6 +15,45 @@ import (
 func TestEntry(t *testing.T) {
 	t.Parallel()
 
+	t.Run("LocalBranches", func(t *testing.T) {
+		t.Parallel()
+		entry := runlog.Entry{
+			Branches: map[gitdomain.BranchName]gitdomain.SHA{
+				"main":               "111111",
+				"origin/main":        "111111",
+				"feature/one":        "222222",
+				"origin/feature/one": "333333",
+				"upstream/main":      "444444",
+			},
+		}
+		have := entry.LocalBranches(gitdomain.Remotes{gitdomain.RemoteOrigin, gitdomain.RemoteUpstream})
+		want := map[gitdomain.LocalBranchName]gitdomain.SHA{
+			"main":        "111111",
+			"feature/one": "222222",
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("RemoteBranches", func(t *testing.T) {
+		t.Parallel()
+		entry := runlog.Entry{
+			Branches: map[gitdomain.BranchName]gitdomain.SHA{
+				"main":               "111111",
+				"origin/main":        "111111",
+				"feature/one":        "222222",
+				"origin/feature/one": "333333",
+				"upstream/main":      "444444",
+			},
+		}
+		have := entry.RemoteBranches(gitdomain.Remotes{gitdomain.RemoteOrigin, gitdomain.RemoteUpstream})
+		want := map[gitdomain.RemoteBranchName]gitdomain.SHA{
+			"origin/main":        "111111",
+			"origin/feature/one": "333333",
+			"upstream/main":      "444444",
+		}
+		must.Eq(t, want, have)
+	})
+
 	t.Run("serialize", func(t *testing.T) {
 		t.Parallel()
 		entry := runlog.Entry{
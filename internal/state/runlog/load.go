@@ -0,0 +1,42 @@
+package runlog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/git-town/git-town/v22/internal/messages"
+)
+
+// Load provides all entries in the runlog for this repo, oldest first.
+// Returns no entries if there is no runlog.
+func Load(runlogPath FilePath) ([]Entry, error) {
+	file, err := os.Open(runlogPath.String())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Entry{}, nil
+		}
+		return []Entry{}, fmt.Errorf(messages.RunLogCannotRead, runlogPath, err)
+	}
+	defer file.Close()
+	return Parse(file, runlogPath)
+}
+
+// Parse provides the runlog entries contained in the given runlog content, oldest first.
+func Parse(content io.Reader, runlogPath FilePath) ([]Entry, error) {
+	result := []Entry{}
+	decoder := json.NewDecoder(content)
+	for {
+		var entry Entry
+		err := decoder.Decode(&entry)
+		if errors.Is(err, io.EOF) {
+			return result, nil
+		}
+		if err != nil {
+			return result, fmt.Errorf(messages.FileContentInvalidJSON, runlogPath, err)
+		}
+		result = append(result, entry)
+	}
+}
@@ -0,0 +1,84 @@
+package runlog_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/state/runlog"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty runlog", func(t *testing.T) {
+		t.Parallel()
+		have, err := runlog.Parse(strings.NewReader(""), "runlog.json")
+		must.NoError(t, err)
+		must.Len(t, 0, have)
+	})
+
+	t.Run("invalid content", func(t *testing.T) {
+		t.Parallel()
+		_, err := runlog.Parse(strings.NewReader("{ zonk"), "runlog.json")
+		must.Error(t, err)
+	})
+
+	t.Run("multiple entries", func(t *testing.T) {
+		t.Parallel()
+		give := `
+{
+  "Branches": {
+    "main": "111111",
+    "origin/main": "111111"
+  },
+  "Command": "git town hack new",
+  "Event": "start",
+  "PendingCommand": null,
+  "Time": "2025-05-28T20:34:58Z"
+}
+
+{
+  "Branches": {
+    "main": "111111",
+    "new": "111111",
+    "origin/main": "111111"
+  },
+  "Command": "git town hack new",
+  "Event": "end",
+  "PendingCommand": null,
+  "Time": "2025-05-28T20:34:59Z"
+}
+
+`[1:]
+		have, err := runlog.Parse(strings.NewReader(give), "runlog.json")
+		must.NoError(t, err)
+		want := []runlog.Entry{
+			{
+				Branches: map[gitdomain.BranchName]gitdomain.SHA{
+					"main":        "111111",
+					"origin/main": "111111",
+				},
+				Command:        "git town hack new",
+				Event:          runlog.EventStart,
+				PendingCommand: None[string](),
+				Time:           time.Date(2025, 5, 28, 20, 34, 58, 0, time.UTC),
+			},
+			{
+				Branches: map[gitdomain.BranchName]gitdomain.SHA{
+					"main":        "111111",
+					"new":         "111111",
+					"origin/main": "111111",
+				},
+				Command:        "git town hack new",
+				Event:          runlog.EventEnd,
+				PendingCommand: None[string](),
+				Time:           time.Date(2025, 5, 28, 20, 34, 59, 0, time.UTC),
+			},
+		}
+		must.Eq(t, want, have)
+	})
+}
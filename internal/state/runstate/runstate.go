@@ -28,12 +28,14 @@ type RunState struct {
 	EndBranchesSnapshot      Option[gitdomain.BranchesSnapshot]         // snapshot of the Git branches after the Git Town command that this RunState is for ran
 	EndConfigSnapshot        Option[configdomain.EndConfigSnapshot]     // snapshot of the Git configuration after the Git Town command that this RunState is for ran
 	EndStashSize             Option[gitdomain.StashSize]                // size of the Git stash after the Git Town command that this RunState is for ran
+	FinalUndoFilesProgram    program.Program                            `exhaustruct:"optional"` // additional opcodes to run after this RunState was undone and the open changes are restored, for changes to files that the stash would otherwise override
 	FinalUndoProgram         program.Program                            `exhaustruct:"optional"` // additional opcodes to run after this RunState was undone
 	RunProgram               program.Program                            // remaining opcodes of the Git Town command that this RunState is for
 	TouchedBranches          gitdomain.BranchNames                      // the branches that are touched by the Git Town command that this RunState is for
 	UndoAPIProgram           program.Program                            // opcodes to undo changes at external systems
 	UndoablePerennialCommits []gitdomain.SHA                            `exhaustruct:"optional"` // contains the SHAs of commits on perennial branches that can safely be undone
 	UnfinishedDetails        OptionalMutable[UnfinishedRunStateDetails] `exhaustruct:"optional"`
+	Worktrees                gitdomain.Worktrees                        `exhaustruct:"optional"` // the other worktrees in which the Git Town command that this RunState is for runs opcodes, or that it creates or removes
 }
 
 func EmptyRunState() RunState {
@@ -134,6 +134,7 @@ func TestRunState(t *testing.T) {
   },
   "EndConfigSnapshot": null,
   "EndStashSize": 1,
+  "FinalUndoFilesProgram": [],
   "FinalUndoProgram": [],
   "RunProgram": [
     {
@@ -150,7 +151,8 @@ func TestRunState(t *testing.T) {
   ],
   "UndoAPIProgram": [],
   "UndoablePerennialCommits": [],
-  "UnfinishedDetails": null
+  "UnfinishedDetails": null,
+  "Worktrees": null
 }`[1:]
 		must.EqOp(t, want, string(encoded))
 		newRunState := runstate.EmptyRunState()
@@ -41,6 +41,7 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.BranchCreate{Branch: "branch", StartingPoint: "123456"},
 				&opcodes.BranchCreateAndCheckoutExistingParent{Ancestors: gitdomain.NewLocalBranchNames("one", "two", "three"), Branch: "branch"},
 				&opcodes.BranchCurrentReset{Base: "branch"},
+				&opcodes.BranchCurrentResetToBranch{Branch: "branch"},
 				&opcodes.BranchCurrentResetToParent{CurrentBranch: "branch"},
 				&opcodes.BranchCurrentResetToSHA{SHA: "111111"},
 				&opcodes.BranchCurrentResetToSHAIfNeeded{MustHaveSHA: "222222", SetToSHA: "111111"},
@@ -48,6 +49,8 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.BranchLocalDelete{Branch: "branch"},
 				&opcodes.BranchLocalDeleteContent{BranchToDelete: "branch", BranchToRebaseOnto: "main"},
 				&opcodes.BranchLocalRename{NewName: "new", OldName: "old"},
+				&opcodes.BranchOverrideRemove{Branch: "branch", Setting: configdomain.KeySyncFeatureStrategy},
+				&opcodes.BranchOverrideSet{Branch: "branch", Setting: configdomain.KeySyncFeatureStrategy, Value: "rebase"},
 				&opcodes.BranchRemoteCreate{Branch: "branch", SHA: "123456"},
 				&opcodes.BranchRemoteSetToSHA{Branch: "branch", SetToSHA: "222222"},
 				&opcodes.BranchRemoteSetToSHAIfNeeded{Branch: "branch", MustHaveSHA: "111111", SetToSHA: "222222"},
@@ -75,12 +78,16 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.CommitRevert{SHA: "123456"},
 				&opcodes.CommitRevertIfNeeded{SHA: "123456"},
 				&opcodes.CommitWithMessage{AuthorOverride: Some(gitdomain.Author("user@acme.com")), Message: "my message", CommitHook: configdomain.CommitHookEnabled},
+				&opcodes.ConfigFileRemove{FilePath: "git-town.toml"},
+				&opcodes.ConfigFileWrite{Content: "[sync]\ntags = false\n", FilePath: "git-town.toml", Mode: 0o644},
 				&opcodes.ConfigRemove{Key: configdomain.KeyOffline, Scope: configdomain.ConfigScopeLocal},
+				&opcodes.ConfigRemoveFrontend{Key: configdomain.KeyOffline, Scope: configdomain.ConfigScopeLocal},
 				&opcodes.ConfigSet{Key: configdomain.KeyOffline, Scope: configdomain.ConfigScopeLocal, Value: "1"},
+				&opcodes.ConfigSetFrontend{Key: configdomain.KeyOffline, Scope: configdomain.ConfigScopeLocal, Value: "1"},
 				&opcodes.ConflictMergePhantomFinalize{},
 				&opcodes.ConflictMergePhantomResolveAll{CurrentBranch: "current", ParentBranch: gitdomain.NewLocalBranchNameOption("parent"), ParentSHA: Some(gitdomain.NewSHA("123456"))},
 				&opcodes.ConflictResolve{FilePath: "file", Resolution: gitdomain.ConflictResolutionOurs},
-				&opcodes.ConnectorProposalMerge{Branch: "branch", CommitMessage: Some(gitdomain.CommitMessage("commit message")), Proposal: forgedomain.Proposal{Data: forgedomain.BitbucketCloudProposalData{ProposalData: forgedomain.ProposalData{Active: true, Body: gitdomain.NewProposalBodyOpt("body"), MergeWithAPI: true, Number: 123, Source: "source", Target: "target", Title: "title", URL: "url"}}, ForgeType: forgedomain.ForgeTypeBitbucket}},
+				&opcodes.ConnectorProposalMerge{Branch: "branch", CommitMessage: Some(gitdomain.CommitMessage("commit message")), MergeMethod: forgedomain.ProposalMergeMethodSquash, Proposal: forgedomain.Proposal{Data: forgedomain.BitbucketCloudProposalData{ProposalData: forgedomain.ProposalData{Active: true, Body: gitdomain.NewProposalBodyOpt("body"), MergeWithAPI: true, Number: 123, Source: "source", Target: "target", Title: "title", URL: "url"}}, ForgeType: forgedomain.ForgeTypeBitbucket}},
 				&opcodes.ExecuteShellCommand{Args: []string{"arg1", "arg2"}, Executable: "executable"},
 				&opcodes.ExitToShell{},
 				&opcodes.FetchUpstream{Branch: "branch"},
@@ -101,10 +108,13 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.MessageQueue{Message: "message"},
 				&opcodes.ProgramEndOfBranch{},
 				&opcodes.ProposalCreate{Branch: "branch", MainBranch: "main"},
+				&opcodes.ProposalCreateViaAPI{Branch: "branch", MainBranch: "main"},
+				&opcodes.ProposalMarkReady{Branch: "branch"},
 				&opcodes.ProposalUpdateTarget{Proposal: forgedomain.Proposal{Data: forgedomain.ProposalData{Active: true, Body: gitdomain.NewProposalBodyOpt("body"), MergeWithAPI: true, Number: 123, Source: "source", Target: "target", Title: "title", URL: "url"}, ForgeType: forgedomain.ForgeTypeGitlab}, NewBranch: "new-target", OldBranch: "old-target"},
 				&opcodes.ProposalUpdateTargetToGrandParent{Branch: "branch", Proposal: forgedomain.Proposal{Data: forgedomain.ProposalData{Active: true, Body: gitdomain.NewProposalBodyOpt("body"), MergeWithAPI: true, Number: 123, Source: "source", Target: "target", Title: "title", URL: "url"}, ForgeType: forgedomain.ForgeTypeGitea}, OldTarget: "old-target"},
 				&opcodes.ProposalUpdateSource{Proposal: forgedomain.Proposal{Data: forgedomain.ProposalData{Active: true, Body: None[gitdomain.ProposalBody](), MergeWithAPI: false, Number: 123, Source: "source", Target: "target", Title: "title", URL: "url"}, ForgeType: forgedomain.ForgeTypeForgejo}, NewBranch: "new-target", OldBranch: "old-target"},
 				&opcodes.PullCurrentBranch{},
+				&opcodes.PushBranchesIfNeeded{Branches: []gitdomain.BranchPush{{Branch: "branch", Mode: gitdomain.PushModeForceIfIncludes, TrackingBranch: "origin/branch"}}},
 				&opcodes.PushCurrentBranch{},
 				&opcodes.PushCurrentBranchForce{ForceIfIncludes: true},
 				&opcodes.PushCurrentBranchForceIfNeeded{CurrentBranch: "branch", ForceIfIncludes: true, TrackingBranch: "origin/branch"},
@@ -129,6 +139,10 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.SyncFeatureBranchCompress{CommitMessage: Some(gitdomain.CommitMessage("commit message")), CurrentBranch: "branch", Offline: true, InitialParentName: gitdomain.NewLocalBranchNameOption("parent"), InitialParentSHA: Some(gitdomain.NewSHA("111111")), TrackingBranch: Some(gitdomain.NewRemoteBranchName("origin/branch")), PushBranches: true},
 				&opcodes.SyncFeatureBranchMerge{Branch: "branch", InitialParentName: gitdomain.NewLocalBranchNameOption("original-parent"), InitialParentSHA: Some(gitdomain.NewSHA("123456")), TrackingBranch: Some(gitdomain.NewRemoteBranchName("origin/branch"))},
 				&opcodes.SyncFeatureBranchRebase{Branch: "branch", ParentSHAPreviousRun: Some(gitdomain.NewSHA("111111")), PushBranches: true, TrackingBranch: Some(gitdomain.NewRemoteBranchName("origin/branch"))},
+				&opcodes.WorktreeAdd{Branch: "branch", Path: "/path/to/worktree"},
+				&opcodes.WorktreeEnter{Path: "/path/to/worktree"},
+				&opcodes.WorktreeLeave{},
+				&opcodes.WorktreeRemove{Path: "/path/to/worktree"},
 			},
 			TouchedBranches: []gitdomain.BranchName{"branch-1", "branch-2"},
 			UnfinishedDetails: MutableSome(&runstate.UnfinishedRunStateDetails{
@@ -137,8 +151,16 @@ func TestLoadSave(t *testing.T) {
 				EndTime:   time.Time{},
 			}),
 			UndoablePerennialCommits: []gitdomain.SHA{},
+			FinalUndoFilesProgram:    program.Program{},
 			FinalUndoProgram:         program.Program{},
 			UndoAPIProgram:           program.Program{},
+			Worktrees: gitdomain.Worktrees{
+				{
+					Branch: gitdomain.NewLocalBranchNameOption("branch"),
+					Path:   "/path/to/worktree",
+					SHA:    "111111",
+				},
+			},
 		}
 
 		wantJSON := `
@@ -161,6 +183,7 @@ func TestLoadSave(t *testing.T) {
   "EndBranchesSnapshot": null,
   "EndConfigSnapshot": null,
   "EndStashSize": 1,
+  "FinalUndoFilesProgram": [],
   "FinalUndoProgram": [],
   "RunProgram": [
     {
@@ -187,6 +210,12 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "BranchCurrentReset"
     },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "BranchCurrentResetToBranch"
+    },
     {
       "data": {
         "CurrentBranch": "branch"
@@ -233,6 +262,21 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "BranchLocalRename"
     },
+    {
+      "data": {
+        "Branch": "branch",
+        "Setting": "git-town.sync-feature-strategy"
+      },
+      "type": "BranchOverrideRemove"
+    },
+    {
+      "data": {
+        "Branch": "branch",
+        "Setting": "git-town.sync-feature-strategy",
+        "Value": "rebase"
+      },
+      "type": "BranchOverrideSet"
+    },
     {
       "data": {
         "Branch": "branch",
@@ -398,6 +442,20 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "CommitWithMessage"
     },
+    {
+      "data": {
+        "FilePath": "git-town.toml"
+      },
+      "type": "ConfigFileRemove"
+    },
+    {
+      "data": {
+        "Content": "[sync]\ntags = false\n",
+        "FilePath": "git-town.toml",
+        "Mode": 420
+      },
+      "type": "ConfigFileWrite"
+    },
     {
       "data": {
         "Key": "git-town.offline",
@@ -405,6 +463,13 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "ConfigRemove"
     },
+    {
+      "data": {
+        "Key": "git-town.offline",
+        "Scope": "local"
+      },
+      "type": "ConfigRemoveFrontend"
+    },
     {
       "data": {
         "Key": "git-town.offline",
@@ -413,6 +478,14 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "ConfigSet"
     },
+    {
+      "data": {
+        "Key": "git-town.offline",
+        "Scope": "local",
+        "Value": "1"
+      },
+      "type": "ConfigSetFrontend"
+    },
     {
       "data": {},
       "type": "ConflictMergePhantomFinalize"
@@ -436,18 +509,21 @@ func TestLoadSave(t *testing.T) {
       "data": {
         "Branch": "branch",
         "CommitMessage": "commit message",
+        "MergeMethod": "squash",
         "Proposal": {
           "data": {
             "Active": true,
             "Body": "body",
+            "Draft": false,
+            "HeadSHA": null,
             "MergeWithAPI": true,
+            "Merged": false,
             "Number": 123,
             "Source": "source",
             "Target": "target",
             "Title": "title",
             "URL": "url",
-            "CloseSourceBranch": false,
-            "Draft": false
+            "CloseSourceBranch": false
           },
           "forge-type": "bitbucket"
         }
@@ -582,13 +658,36 @@ func TestLoadSave(t *testing.T) {
     },
     {
       "data": {
+        "Assignees": null,
         "Branch": "branch",
+        "Draft": false,
+        "Labels": null,
         "MainBranch": "main",
         "ProposalBody": null,
-        "ProposalTitle": null
+        "ProposalTitle": null,
+        "Reviewers": null
       },
       "type": "ProposalCreate"
     },
+    {
+      "data": {
+        "Assignees": null,
+        "Branch": "branch",
+        "Draft": false,
+        "Labels": null,
+        "MainBranch": "main",
+        "ProposalBody": null,
+        "ProposalTitle": null,
+        "Reviewers": null
+      },
+      "type": "ProposalCreateViaAPI"
+    },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "ProposalMarkReady"
+    },
     {
       "data": {
         "NewBranch": "new-target",
@@ -597,7 +696,10 @@ func TestLoadSave(t *testing.T) {
           "data": {
             "Active": true,
             "Body": "body",
+            "Draft": false,
+            "HeadSHA": null,
             "MergeWithAPI": true,
+            "Merged": false,
             "Number": 123,
             "Source": "source",
             "Target": "target",
@@ -617,7 +719,10 @@ func TestLoadSave(t *testing.T) {
           "data": {
             "Active": true,
             "Body": "body",
+            "Draft": false,
+            "HeadSHA": null,
             "MergeWithAPI": true,
+            "Merged": false,
             "Number": 123,
             "Source": "source",
             "Target": "target",
@@ -637,7 +742,10 @@ func TestLoadSave(t *testing.T) {
           "data": {
             "Active": true,
             "Body": null,
+            "Draft": false,
+            "HeadSHA": null,
             "MergeWithAPI": false,
+            "Merged": false,
             "Number": 123,
             "Source": "source",
             "Target": "target",
@@ -653,6 +761,18 @@ func TestLoadSave(t *testing.T) {
       "data": {},
       "type": "PullCurrentBranch"
     },
+    {
+      "data": {
+        "Branches": [
+          {
+            "Branch": "branch",
+            "Mode": "force-if-includes",
+            "TrackingBranch": "origin/branch"
+          }
+        ]
+      },
+      "type": "PushBranchesIfNeeded"
+    },
     {
       "data": {},
       "type": "PushCurrentBranch"
@@ -795,6 +915,29 @@ func TestLoadSave(t *testing.T) {
         "TrackingBranch": "origin/branch"
       },
       "type": "SyncFeatureBranchRebase"
+    },
+    {
+      "data": {
+        "Branch": "branch",
+        "Path": "/path/to/worktree"
+      },
+      "type": "WorktreeAdd"
+    },
+    {
+      "data": {
+        "Path": "/path/to/worktree"
+      },
+      "type": "WorktreeEnter"
+    },
+    {
+      "data": {},
+      "type": "WorktreeLeave"
+    },
+    {
+      "data": {
+        "Path": "/path/to/worktree"
+      },
+      "type": "WorktreeRemove"
     }
   ],
   "TouchedBranches": [
@@ -807,7 +950,14 @@ func TestLoadSave(t *testing.T) {
     "CanSkip": true,
     "EndBranch": "end-branch",
     "EndTime": "0001-01-01T00:00:00Z"
-  }
+  },
+  "Worktrees": [
+    {
+      "Branch": "branch",
+      "Path": "/path/to/worktree",
+      "SHA": "111111"
+    }
+  ]
 }`[1:]
 
 		tempDir := t.TempDir()
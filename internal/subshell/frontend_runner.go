@@ -24,8 +24,11 @@ import (
 
 // FrontendRunner executes frontend shell commands.
 type FrontendRunner struct {
-	Backend          subshelldomain.Querier
-	CommandsCounter  Mutable[gohacks.Counter]
+	Backend         subshelldomain.Querier
+	CommandsCounter Mutable[gohacks.Counter]
+	// If set, runs the commands in the given directory.
+	// If not set, runs the commands in the current working directory.
+	Dir              Option[string]
 	GetCurrentBranch GetCurrentBranchFunc
 	GetCurrentSHA    GetCurrentSHAFunc
 	PrintBranchNames bool
@@ -98,6 +101,9 @@ func (self *FrontendRunner) execute(env []string, cmd string, args ...string) er
 	for {
 		subProcess := exec.CommandContext(context.Background(), cmd, args...)
 		subProcess.Env = append(subProcess.Environ(), env...)
+		if dir, has := self.Dir.Get(); has {
+			subProcess.Dir = dir
+		}
 		var stderrBuffer bytes.Buffer // we only need to look at STDERR since that's where Git will print error messages
 		subProcess.Stderr = io.MultiWriter(os.Stderr, &stderrBuffer)
 		subProcess.Stdin = os.Stdin
@@ -0,0 +1,43 @@
+package subshell
+
+import (
+	"github.com/git-town/git-town/v22/internal/subshell/subshelldomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// BackendInDir provides a version of the given backend runner that executes its commands in the given directory.
+// Runners that don't support running in another directory are returned unchanged.
+func BackendInDir(backend subshelldomain.RunnerQuerier, dir string) subshelldomain.RunnerQuerier { //nolint:ireturn
+	if backendRunner, isBackendRunner := backend.(BackendRunner); isBackendRunner {
+		backendRunner.Dir = Some(dir)
+		return backendRunner
+	}
+	return backend
+}
+
+// FrontendInDir provides a version of the given frontend runner that executes its commands in the given directory.
+// The given backend and function to determine the current branch must operate in that directory as well.
+// Runners that don't support running in another directory are returned unchanged.
+func FrontendInDir(frontend subshelldomain.Runner, dir string, backend subshelldomain.Querier, getCurrentBranch GetCurrentBranchFunc) subshelldomain.Runner { //nolint:ireturn
+	switch frontendRunner := frontend.(type) {
+	case *FrontendRunner:
+		return &FrontendRunner{
+			Backend:          backend,
+			CommandsCounter:  frontendRunner.CommandsCounter,
+			Dir:              Some(dir),
+			GetCurrentBranch: getCurrentBranch,
+			GetCurrentSHA:    frontendRunner.GetCurrentSHA,
+			PrintBranchNames: frontendRunner.PrintBranchNames,
+			PrintCommands:    frontendRunner.PrintCommands,
+		}
+	case *FrontendDryRunner:
+		return &FrontendDryRunner{
+			Backend:          backend,
+			CommandsCounter:  frontendRunner.CommandsCounter,
+			GetCurrentBranch: getCurrentBranch,
+			PrintBranchNames: frontendRunner.PrintBranchNames,
+			PrintCommands:    frontendRunner.PrintCommands,
+		}
+	}
+	return frontend
+}
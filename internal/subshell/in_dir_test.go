@@ -0,0 +1,42 @@
+package subshell_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/gohacks"
+	"github.com/git-town/git-town/v22/internal/subshell"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestInDir(t *testing.T) {
+	t.Parallel()
+
+	t.Run("runs the commands in the given directory", func(t *testing.T) {
+		t.Parallel()
+		originalDir := t.TempDir()
+		otherDir := t.TempDir()
+		backendRunner := subshell.BackendRunner{Dir: Some(originalDir), Verbose: false, CommandsCounter: NewMutable(new(gohacks.Counter))}
+		frontendRunner := &subshell.FrontendRunner{
+			Backend:          backendRunner,
+			CommandsCounter:  NewMutable(new(gohacks.Counter)),
+			Dir:              Some(originalDir),
+			GetCurrentBranch: nil,
+			GetCurrentSHA:    nil,
+			PrintBranchNames: false,
+			PrintCommands:    false,
+		}
+		backend := subshell.BackendInDir(backendRunner, otherDir)
+		frontend := subshell.FrontendInDir(frontendRunner, otherDir, backend, nil)
+		output, err := backend.QueryTrim("pwd")
+		must.NoError(t, err)
+		must.EqOp(t, otherDir, output)
+		must.NoError(t, frontend.Run("touch", "file"))
+		_, err = os.Stat(filepath.Join(otherDir, "file"))
+		must.NoError(t, err)
+		_, err = os.Stat(filepath.Join(originalDir, "file"))
+		must.True(t, os.IsNotExist(err))
+	})
+}
@@ -210,6 +210,7 @@ func TestFrontendRunner_RetryOnIndexLock(t *testing.T) {
 			PrintBranchNames: false,
 			PrintCommands:    false,
 			CommandsCounter:  NewMutable(new(gohacks.Counter)),
+			Dir:              None[string](),
 		}
 
 		// Script that fails with a different error
@@ -242,6 +243,7 @@ exit 128
 			PrintBranchNames: false,
 			PrintCommands:    false,
 			CommandsCounter:  NewMutable(new(gohacks.Counter)),
+			Dir:              None[string](),
 		}
 
 		// Create a script that always fails with lock error
@@ -274,6 +276,7 @@ exit 1
 			PrintBranchNames: false,
 			PrintCommands:    false,
 			CommandsCounter:  NewMutable(new(gohacks.Counter)),
+			Dir:              None[string](),
 		}
 
 		// Create a script that fails once with lock error, then succeeds
@@ -317,6 +320,7 @@ fi
 			PrintBranchNames: false,
 			PrintCommands:    false,
 			CommandsCounter:  NewMutable(new(gohacks.Counter)),
+			Dir:              None[string](),
 		}
 
 		start := time.Now()
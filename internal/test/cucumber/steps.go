@@ -24,6 +24,7 @@ import (
 	"github.com/git-town/git-town/v22/internal/config/gitconfig"
 	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
 	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/state/runhistory"
 	"github.com/git-town/git-town/v22/internal/test/commands"
 	"github.com/git-town/git-town/v22/internal/test/datatable"
 	"github.com/git-town/git-town/v22/internal/test/envvars"
@@ -220,6 +221,12 @@ func defineSteps(sc *godog.ScenarioContext) {
 		)
 	})
 
+	sc.Step(`^an uncommitted file in the other worktree$`, func(ctx context.Context) {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		secondWorktree := state.fixture.SecondWorktree.GetOrPanic()
+		secondWorktree.CreateFile("uncommitted file", "uncommitted content")
+	})
+
 	sc.Step(`^an uncommitted file "([^"]+)" exists now$`, func(ctx context.Context, filename string) error {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		devRepo := state.fixture.DevRepo.GetOrPanic()
@@ -1082,7 +1089,8 @@ func defineSteps(sc *godog.ScenarioContext) {
 				}
 			}
 		}
-		return nil
+		// the Git Town commands that created the branches above aren't part of the scenario
+		return os.RemoveAll(runhistory.NewRunhistoryPath(state.fixture.RepoConfigDir()).String())
 	})
 
 	sc.Step(`^the branches are now$`, func(ctx context.Context, want *godog.Table) error {
@@ -1170,6 +1178,15 @@ func defineSteps(sc *godog.ScenarioContext) {
 		return nil
 	})
 
+	sc.Step(`^the configuration file (?:now|still) doesn't exist$`, func(ctx context.Context) error {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		devRepo := state.fixture.DevRepo.GetOrPanic()
+		if _, err := devRepo.FileContentErr(configfile.FileName); err == nil {
+			return errors.New("unexpected configuration file found")
+		}
+		return nil
+	})
+
 	sc.Step(`^the coworker adds this commit to their current branch:$`, func(ctx context.Context, table *godog.Table) {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		commits := testgit.FromGherkinTable(table)
@@ -1319,6 +1336,9 @@ func defineSteps(sc *godog.ScenarioContext) {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		devRepo := state.fixture.DevRepo.GetOrPanic()
 		filePath := filepath.Join(devRepo.HomeDir, filename)
+		if err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
+			return err
+		}
 		//nolint:gosec // need permission 700 here in order for tests to work
 		return os.WriteFile(filePath, []byte(docString.Content), 0o700)
 	})
@@ -1489,7 +1509,7 @@ func defineSteps(sc *godog.ScenarioContext) {
 	sc.Step(`^the proposals$`, func(ctx context.Context, table *godog.Table) {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		devRepo := state.fixture.DevRepo.GetOrPanic()
-		proposals := mockproposals.FromGherkinTable(table, devRepo.Config.NormalConfig.Lineage)
+		proposals := mockproposals.FromGherkinTable(table, devRepo.Config.NormalConfig.Lineage, devRepo)
 		proposalFilePath := mockproposals.NewMockProposalPath(state.fixture.RepoConfigDir())
 		initialProposals := mockproposals.Save(proposalFilePath, proposals)
 		state.initialProposals = Some(initialProposals)
@@ -44,6 +44,17 @@ func (self *MockProposals) FindBySourceAndTarget(source, target gitdomain.LocalB
 	return None[forgedomain.ProposalData]()
 }
 
+// NextID provides the number for the next proposal to create.
+func (self *MockProposals) NextID() forgedomain.ProposalNumber {
+	result := forgedomain.ProposalNumber(1)
+	for _, proposal := range *self {
+		if proposal.Number >= result {
+			result = proposal.Number + 1
+		}
+	}
+	return result
+}
+
 func (self *MockProposals) Update(proposal forgedomain.ProposalData) {
 	for p, prop := range *self {
 		if prop.Number == proposal.Number {
@@ -15,7 +15,12 @@ import (
 	. "github.com/git-town/git-town/v22/pkg/prelude"
 )
 
-func FromGherkinTable(table *godog.Table, lineage configdomain.Lineage) []forgedomain.ProposalData {
+// GitCommands describes the Git operations needed to resolve commits mentioned in Gherkin tables.
+type GitCommands interface {
+	SHAsForCommit(gitdomain.CommitMessage) gitdomain.SHAs
+}
+
+func FromGherkinTable(table *godog.Table, lineage configdomain.Lineage, repo GitCommands) []forgedomain.ProposalData {
 	result := make([]forgedomain.ProposalData, 0, len(table.Rows)-1)
 	headers := helpers.TableFields(table)
 	for r := 1; r < len(table.Rows); r++ {
@@ -26,6 +31,9 @@ func FromGherkinTable(table *godog.Table, lineage configdomain.Lineage) []forged
 		title := None[gitdomain.ProposalTitle]()
 		body := None[gitdomain.ProposalBody]()
 		url := None[string]()
+		state := forgedomain.ProposalStateOpen
+		draft := false
+		headSHA := None[gitdomain.SHA]()
 		for f, field := range row.Cells {
 			switch headers[f] {
 			case "ID":
@@ -40,6 +48,16 @@ func FromGherkinTable(table *godog.Table, lineage configdomain.Lineage) []forged
 				body = Some(gitdomain.ProposalBody(field.Value))
 			case "URL":
 				url = Some(field.Value)
+			case "STATE":
+				state = forgedomain.ProposalState(field.Value)
+			case "DRAFT":
+				draft = asserts.NoError1(gohacks.ParseBool[bool](field.Value, "DRAFT column"))
+			case "HEAD COMMIT":
+				shas := repo.SHAsForCommit(gitdomain.CommitMessage(field.Value))
+				if len(shas) == 0 {
+					panic(fmt.Sprintf("test workspace has no commit %q", field.Value))
+				}
+				headSHA = Some(shas.First())
 			}
 		}
 		if source.IsNone() {
@@ -56,9 +74,12 @@ func FromGherkinTable(table *godog.Table, lineage configdomain.Lineage) []forged
 			title = Some(gitdomain.ProposalTitle(fmt.Sprintf("Proposal from %s to %s", source.GetOrPanic(), target.GetOrPanic())))
 		}
 		result = append(result, forgedomain.ProposalData{
-			Active:       true,
+			Active:       state == forgedomain.ProposalStateOpen,
 			Body:         body,
+			Draft:        draft,
+			HeadSHA:      headSHA,
 			MergeWithAPI: true,
+			Merged:       state == forgedomain.ProposalStateMerged,
 			Number:       forgedomain.ProposalNumber(id.GetOrPanic()),
 			Source:       source.GetOrPanic(),
 			Target:       target.GetOrPanic(),
@@ -83,6 +104,9 @@ func ToDocString(proposals []forgedomain.ProposalData) string {
 		result.WriteString(proposal.Source.String())
 		result.WriteString("\ntarget: ")
 		result.WriteString(proposal.Target.String())
+		if proposal.Draft {
+			result.WriteString("\ndraft: true")
+		}
 		result.WriteString("\nbody:\n")
 		if body, hasBody := proposal.Body.Get(); hasBody {
 			result.WriteString(gohacks.IndentLines(body.String(), 2))
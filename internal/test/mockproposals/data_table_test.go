@@ -12,6 +12,28 @@ import (
 func TestToDocString(t *testing.T) {
 	t.Parallel()
 
+	t.Run("draft proposal", func(t *testing.T) {
+		t.Parallel()
+		proposals := []forgedomain.ProposalData{
+			{
+				Draft:  true,
+				Number: forgedomain.ProposalNumber(3),
+				Source: gitdomain.NewLocalBranchName("feature"),
+				Target: gitdomain.NewLocalBranchName("main"),
+				URL:    "https://example.com/pr/3",
+			},
+		}
+		have := mockproposals.ToDocString(proposals)
+		want := `
+url: https://example.com/pr/3
+number: 3
+source: feature
+target: main
+draft: true
+body:`[1:]
+		must.Eq(t, want, have)
+	})
+
 	t.Run("empty slice", func(t *testing.T) {
 		t.Parallel()
 		proposals := []forgedomain.ProposalData{}
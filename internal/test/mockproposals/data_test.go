@@ -241,4 +241,25 @@ func TestMockProposals(t *testing.T) {
 			must.Eq(t, want, have)
 		})
 	})
+
+	t.Run("NextID", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("no proposals", func(t *testing.T) {
+			t.Parallel()
+			proposals := mockproposals.MockProposals{}
+			have := proposals.NextID()
+			must.EqOp(t, 1, have)
+		})
+
+		t.Run("unsorted proposals", func(t *testing.T) {
+			t.Parallel()
+			proposals := mockproposals.MockProposals{
+				{Number: 3, Source: "branch-3", Target: "main"},
+				{Number: 1, Source: "branch-1", Target: "main"},
+			}
+			have := proposals.NextID()
+			must.EqOp(t, 4, have)
+		})
+	})
 }
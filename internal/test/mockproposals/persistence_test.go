@@ -91,7 +91,10 @@ func TestPersistence(t *testing.T) {
   {
     "Active": false,
     "Body": "test body",
+    "Draft": false,
+    "HeadSHA": null,
     "MergeWithAPI": false,
+    "Merged": false,
     "Number": 123,
     "Source": "feature-branch",
     "Target": "main",
@@ -134,7 +137,10 @@ func TestPersistence(t *testing.T) {
   {
     "Active": false,
     "Body": null,
+    "Draft": false,
+    "HeadSHA": null,
     "MergeWithAPI": false,
+    "Merged": false,
     "Number": 456,
     "Source": "new-branch",
     "Target": "main",
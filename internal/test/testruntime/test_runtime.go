@@ -92,7 +92,7 @@ func New(workingDir, homeDir, binDir string) commands.TestCommands {
 	}
 	unvalidatedConfig := config.NewUnvalidatedConfig(config.NewUnvalidatedConfigArgs{
 		CliConfig:     configdomain.EmptyPartialConfig(),
-		ConfigFile:    configdomain.EmptyPartialConfig(),
+		ConfigFiles:   configdomain.ConfigFiles{},
 		Defaults:      config.DefaultNormalConfig(),
 		EnvConfig:     configdomain.EmptyPartialConfig(),
 		FinalMessages: stringslice.NewCollector(),
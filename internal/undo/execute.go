@@ -63,6 +63,12 @@ func Execute(args ExecuteArgs) error {
 	if err != nil && !os.IsNotExist(err) {
 		return fmt.Errorf(messages.RunstateDeleteProblem, err)
 	}
+	if args.RunState.IsFinished() {
+		// finished commands are also the most recent entry in the run history
+		if err = removeNewestHistoryEntries(1, args.ConfigDir); err != nil {
+			return err
+		}
+	}
 	print.Footer(args.Config.NormalConfig.Verbose, args.CommandsCounter.Immutable(), args.FinalMessages.Result())
 	return nil
 }
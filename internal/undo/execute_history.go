@@ -0,0 +1,117 @@
+package undo
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v22/internal/cli/print"
+	"github.com/git-town/git-town/v22/internal/config"
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/gohacks"
+	"github.com/git-town/git-town/v22/internal/gohacks/stringslice"
+	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/state/runhistory"
+	"github.com/git-town/git-town/v22/internal/state/runstate"
+	"github.com/git-town/git-town/v22/internal/subshell/subshelldomain"
+	"github.com/git-town/git-town/v22/internal/undo/undobranches"
+	"github.com/git-town/git-town/v22/internal/vm/interpreter/lightinterpreter"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+type ExecuteHistoryArgs struct {
+	Backend                 subshelldomain.RunnerQuerier
+	CommandsCounter         Mutable[gohacks.Counter]
+	Config                  config.ValidatedConfig
+	ConfigDir               configdomain.RepoConfigDir
+	Connector               Option[forgedomain.Connector]
+	CurrentBranchesSnapshot gitdomain.BranchesSnapshot // the branches as they are right now
+	Entries                 runhistory.History         // the entries to undo, most recent first
+	FinalMessages           stringslice.Collector
+	Frontend                subshelldomain.Runner
+	Git                     git.Commands
+	HasOpenChanges          bool
+}
+
+// undoes the given entries of the run history, most recent first
+func ExecuteHistory(args ExecuteHistoryArgs) error {
+	if err := verifyHistoryConsistent(args.Entries, args.CurrentBranchesSnapshot); err != nil {
+		return err
+	}
+	undoneCount := 0
+	var problem error
+	for _, entry := range args.Entries {
+		program := CreateUndoForFinishedProgram(CreateUndoProgramArgs{
+			Backend:        args.Backend,
+			Config:         args.Config,
+			DryRun:         entry.RunState.DryRun,
+			FinalMessages:  args.FinalMessages,
+			Git:            args.Git,
+			HasOpenChanges: args.HasOpenChanges,
+			PushHook:       args.Config.NormalConfig.PushHook,
+			RunState:       entry.RunState,
+		})
+		problems := lightinterpreter.Execute(lightinterpreter.ExecuteArgs{
+			Backend:       args.Backend,
+			BranchInfos:   entry.RunState.BeginBranchesSnapshot.Branches,
+			Config:        args.Config,
+			Connector:     args.Connector,
+			FinalMessages: args.FinalMessages,
+			Frontend:      args.Frontend,
+			Git:           args.Git,
+			Prog:          program,
+		})
+		if len(problems) > 0 {
+			// undoing older entries on top of a partially undone entry would make things worse
+			problem = fmt.Errorf(messages.UndoStepsFailed, entry.RunState.Command)
+			break
+		}
+		undoneCount++
+	}
+	runstatePath := runstate.NewRunstatePath(args.ConfigDir)
+	err := os.Remove(runstatePath.String())
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf(messages.RunstateDeleteProblem, err)
+	}
+	if err = removeNewestHistoryEntries(undoneCount, args.ConfigDir); err != nil {
+		return err
+	}
+	print.Footer(args.Config.NormalConfig.Verbose, args.CommandsCounter.Immutable(), args.FinalMessages.Result())
+	return problem
+}
+
+// removeNewestHistoryEntries removes the given number of most recent entries from the run history.
+func removeNewestHistoryEntries(count int, configDir configdomain.RepoConfigDir) error {
+	runhistoryPath := runhistory.NewRunhistoryPath(configDir)
+	history, err := runhistory.Load(runhistoryPath)
+	if err != nil {
+		return fmt.Errorf(messages.RunhistoryLoadProblem, err)
+	}
+	if len(history) == 0 {
+		return nil
+	}
+	if err = runhistory.Save(history.RemoveNewest(count), runhistoryPath); err != nil {
+		return fmt.Errorf(messages.RunhistorySaveProblem, err)
+	}
+	return nil
+}
+
+// verifyHistoryConsistent ensures that no branch was changed between the given entries
+// in a way that undoing these entries would lose changes.
+func verifyHistoryConsistent(entries runhistory.History, currentBranchesSnapshot gitdomain.BranchesSnapshot) error {
+	laterBranchesSnapshot := currentBranchesSnapshot
+	for _, entry := range entries {
+		endBranchesSnapshot, hasEndBranchesSnapshot := entry.RunState.EndBranchesSnapshot.Get()
+		if hasEndBranchesSnapshot {
+			changes := undobranches.NewBranchSpans(endBranchesSnapshot, laterBranchesSnapshot).Changes()
+			if inconsistentChanges := changes.InconsistentlyChanged; len(inconsistentChanges) > 0 {
+				branchName := inconsistentChanges[0].After.LocalName().GetOrZero()
+				return fmt.Errorf(messages.UndoStepsInconsistentChanges, entry.RunState.Command, branchName)
+			}
+		}
+		laterBranchesSnapshot = entry.RunState.BeginBranchesSnapshot
+	}
+	return nil
+}
@@ -17,9 +17,10 @@ import (
 func CreateUndoForFinishedProgram(args CreateUndoProgramArgs) program.Program {
 	result := NewMutable(&program.Program{})
 	result.Value.AddProgram(args.RunState.AbortProgram)
-	if !args.RunState.IsFinished() && args.HasOpenChanges {
+	if !args.RunState.IsFinished() && args.HasOpenChanges && !args.RunState.HasAbortProgram() {
 		// Open changes in the middle of an unfinished command will be undone as well.
 		// To achieve this, we commit them here so that they are gone when the branch is reset to the original SHA.
+		// The abort program already discards the open changes of the failed opcode.
 		result.Value.Add(&opcodes.ChangesStage{})
 		result.Value.Add(&opcodes.CommitWithMessage{
 			AuthorOverride: None[gitdomain.Author](),
@@ -33,7 +34,7 @@ func CreateUndoForFinishedProgram(args CreateUndoProgramArgs) program.Program {
 	}
 	// undo branch changes
 	if endBranchesSnapshot, hasEndBranchesSnapshot := args.RunState.EndBranchesSnapshot.Get(); hasEndBranchesSnapshot {
-		result.Value.AddProgram(undobranches.DetermineUndoBranchesProgram(args.RunState.BeginBranchesSnapshot, endBranchesSnapshot, args.RunState.UndoablePerennialCommits, args.Config, args.RunState.TouchedBranches, args.RunState.UndoAPIProgram, args.FinalMessages))
+		result.Value.AddProgram(undobranches.DetermineUndoBranchesProgram(args.RunState.BeginBranchesSnapshot, endBranchesSnapshot, args.RunState.UndoablePerennialCommits, args.Config, args.RunState.TouchedBranches, args.RunState.UndoAPIProgram, args.RunState.Worktrees, args.FinalMessages))
 		// update breadcrumb
 		updateBreadcrumb := args.Config.NormalConfig.ProposalBreadcrumb.Enabled()
 		isOnline := args.Config.NormalConfig.Offline.IsOnline()
@@ -62,5 +63,6 @@ func CreateUndoForFinishedProgram(args CreateUndoProgramArgs) program.Program {
 		StashOpenChanges:         args.RunState.IsFinished() && args.HasOpenChanges,
 		PreviousBranchCandidates: previousBranchCandidates,
 	})
+	result.Value.AddProgram(args.RunState.FinalUndoFilesProgram)
 	return result.Immutable()
 }
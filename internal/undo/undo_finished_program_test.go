@@ -0,0 +1,68 @@
+package undo_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/state/runstate"
+	"github.com/git-town/git-town/v22/internal/undo"
+	"github.com/git-town/git-town/v22/internal/vm/opcodes"
+	"github.com/git-town/git-town/v22/internal/vm/program"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestCreateUndoForFinishedProgram(t *testing.T) {
+	t.Parallel()
+
+	unfinishedRunState := func(abortProgram program.Program) runstate.RunState {
+		return runstate.RunState{
+			AbortProgram: abortProgram,
+			BeginBranchesSnapshot: gitdomain.BranchesSnapshot{
+				Active:   gitdomain.NewLocalBranchNameOption("main"),
+				Branches: gitdomain.BranchInfos{},
+			},
+			EndBranchesSnapshot: None[gitdomain.BranchesSnapshot](),
+			EndConfigSnapshot:   None[configdomain.EndConfigSnapshot](),
+			EndStashSize:        None[gitdomain.StashSize](),
+			UnfinishedDetails: MutableSome(&runstate.UnfinishedRunStateDetails{
+				CanSkip:   false,
+				EndBranch: "main",
+			}),
+		}
+	}
+
+	t.Run("unfinished program with open changes and an abort program", func(t *testing.T) {
+		t.Parallel()
+		have := undo.CreateUndoForFinishedProgram(undo.CreateUndoProgramArgs{
+			HasOpenChanges: true,
+			RunState:       unfinishedRunState(program.Program{&opcodes.MergeAbort{}}),
+		})
+		want := program.Program{
+			&opcodes.MergeAbort{},
+			&opcodes.CheckoutIfNeeded{Branch: "main"},
+			&opcodes.CheckoutHistoryPreserve{PreviousBranchCandidates: []Option[gitdomain.LocalBranchName]{gitdomain.NewLocalBranchNameOption("main")}},
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("unfinished program with open changes and no abort program", func(t *testing.T) {
+		t.Parallel()
+		have := undo.CreateUndoForFinishedProgram(undo.CreateUndoProgramArgs{
+			HasOpenChanges: true,
+			RunState:       unfinishedRunState(program.Program{}),
+		})
+		want := program.Program{
+			&opcodes.ChangesStage{},
+			&opcodes.CommitWithMessage{
+				AuthorOverride: None[gitdomain.Author](),
+				CommitHook:     configdomain.CommitHookEnabled,
+				Message:        "Committing open changes to undo them",
+			},
+			&opcodes.CheckoutIfNeeded{Branch: "main"},
+			&opcodes.CheckoutHistoryPreserve{PreviousBranchCandidates: []Option[gitdomain.LocalBranchName]{gitdomain.NewLocalBranchNameOption("main")}},
+		}
+		must.Eq(t, want, have)
+	})
+}
@@ -66,13 +66,17 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 	for _, branch := range omniChanges.Perennials.BranchNames() {
 		change := omniChanges.Perennials[branch]
 		if slices.Contains(args.UndoablePerennialCommits, change.After) {
-			result.Add(&opcodes.CheckoutIfNeeded{Branch: branch})
-			result.Add(&opcodes.CommitRevertIfNeeded{SHA: change.After})
+			branchProgram := program.Program{}
+			branchProgram.Add(&opcodes.CheckoutIfNeeded{Branch: branch})
+			for _, commit := range perennialCommitsToRevert(args.UndoablePerennialCommits, change.After) {
+				branchProgram.Add(&opcodes.CommitRevertIfNeeded{SHA: commit})
+			}
 			if branchInfo, hasBranchInfo := args.BranchInfos.FindByLocalName(branch).Get(); hasBranchInfo {
 				if tracking, hasTracking := branchInfo.RemoteName.Get(); hasTracking {
-					result.Add(&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: branch, TrackingBranch: tracking})
+					branchProgram.Add(&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: branch, TrackingBranch: tracking})
 				}
 			}
+			addInWorktree(&result, branchProgram, branch, args.Worktrees)
 		} else {
 			args.FinalMessages.Addf(messages.UndoCannotRevertCommitOnPerennialBranch, change.After)
 		}
@@ -81,13 +85,15 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 	// reset omni-changed feature branches
 	for _, branch := range omniChanges.Features.BranchNames() {
 		change := omniChanges.Features[branch]
-		result.Add(&opcodes.CheckoutIfNeeded{Branch: branch})
-		result.Add(&opcodes.BranchCurrentResetToSHAIfNeeded{MustHaveSHA: change.After, SetToSHA: change.Before})
+		branchProgram := program.Program{}
+		branchProgram.Add(&opcodes.CheckoutIfNeeded{Branch: branch})
+		branchProgram.Add(&opcodes.BranchCurrentResetToSHAIfNeeded{MustHaveSHA: change.After, SetToSHA: change.Before})
 		if branchInfo, hasBranchInfo := args.BranchInfos.FindByLocalName(branch).Get(); hasBranchInfo {
 			if tracking, hasTracking := branchInfo.RemoteName.Get(); hasTracking {
-				result.Add(&opcodes.PushCurrentBranchForceIfNeeded{CurrentBranch: branch, ForceIfIncludes: true, TrackingBranch: tracking})
+				branchProgram.Add(&opcodes.PushCurrentBranchForceIfNeeded{CurrentBranch: branch, ForceIfIncludes: true, TrackingBranch: tracking})
 			}
 		}
+		addInWorktree(&result, branchProgram, branch, args.Worktrees)
 	}
 
 	// re-create removed omni-branches
@@ -95,6 +101,7 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 		sha := self.OmniRemoved[branch]
 		result.Add(&opcodes.BranchCreate{Branch: branch, StartingPoint: sha.Location()})
 		result.Add(&opcodes.BranchTrackingCreate{Branch: branch})
+		restoreWorktree(&result, branch, args.Worktrees)
 	}
 
 	inconsistentChanges := CategorizeInconsistentChanges(self.InconsistentlyChanged, args.Config)
@@ -103,13 +110,17 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 	for _, inconsistentlyChangedPerennial := range inconsistentChanges.Perennials {
 		if omni, isOmni := inconsistentlyChangedPerennial.After.OmniBranch().Get(); isOmni {
 			if slices.Contains(args.UndoablePerennialCommits, omni.SHA) {
-				result.Add(&opcodes.CheckoutIfNeeded{Branch: omni.Name})
-				result.Add(&opcodes.CommitRevertIfNeeded{SHA: omni.SHA})
+				branchProgram := program.Program{}
+				branchProgram.Add(&opcodes.CheckoutIfNeeded{Branch: omni.Name})
+				for _, commit := range perennialCommitsToRevert(args.UndoablePerennialCommits, omni.SHA) {
+					branchProgram.Add(&opcodes.CommitRevertIfNeeded{SHA: commit})
+				}
 				if branchInfo, hasBranchInfo := args.BranchInfos.FindByLocalName(omni.Name).Get(); hasBranchInfo {
 					if tracking, hasTracking := branchInfo.RemoteName.Get(); hasTracking {
-						result.Add(&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: omni.Name, TrackingBranch: tracking})
+						branchProgram.Add(&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: omni.Name, TrackingBranch: tracking})
 					}
 				}
+				addInWorktree(&result, branchProgram, omni.Name, args.Worktrees)
 			}
 		} else {
 			args.FinalMessages.Addf(messages.UndoCannotRevertCommitOnPerennialBranch, inconsistentlyChangedPerennial.After)
@@ -122,11 +133,13 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 		hasBeforeRemote, beforeRemoteName, beforeRemoteSHA := inconsistentChange.Before.GetRemote()
 		AfterSHAs := inconsistentChange.After.GetSHAs()
 		if hasLocal && hasBeforeRemote && AfterSHAs.HasBothSHA {
-			result.Add(&opcodes.CheckoutIfNeeded{Branch: local.Name})
-			result.Add(&opcodes.BranchCurrentResetToSHAIfNeeded{
-				MustHaveSHA: AfterSHAs.LocalSHA,
-				SetToSHA:    local.SHA,
-			})
+			addInWorktree(&result, program.Program{
+				&opcodes.CheckoutIfNeeded{Branch: local.Name},
+				&opcodes.BranchCurrentResetToSHAIfNeeded{
+					MustHaveSHA: AfterSHAs.LocalSHA,
+					SetToSHA:    local.SHA,
+				},
+			}, local.Name, args.Worktrees)
 			result.Add(&opcodes.BranchRemoteSetToSHAIfNeeded{
 				Branch:      beforeRemoteName,
 				MustHaveSHA: AfterSHAs.RemoteSHA,
@@ -148,8 +161,10 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 	// reset locally changed branches
 	for _, localBranch := range self.LocalChanged.BranchNames() {
 		change := self.LocalChanged[localBranch]
-		result.Add(&opcodes.CheckoutIfNeeded{Branch: localBranch})
-		result.Add(&opcodes.BranchCurrentResetToSHAIfNeeded{MustHaveSHA: change.After, SetToSHA: change.Before})
+		addInWorktree(&result, program.Program{
+			&opcodes.CheckoutIfNeeded{Branch: localBranch},
+			&opcodes.BranchCurrentResetToSHAIfNeeded{MustHaveSHA: change.After, SetToSHA: change.Before},
+		}, localBranch, args.Worktrees)
 	}
 
 	// re-create locally removed branches
@@ -159,6 +174,7 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 			Branch:        removedLocalBranch,
 			StartingPoint: startingPoint.Location(),
 		})
+		restoreWorktree(&result, removedLocalBranch, args.Worktrees)
 	}
 
 	// restore the name of locally renamed branches
@@ -174,6 +190,9 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 		if args.EndBranch == addedLocalBranch {
 			result.Add(&opcodes.CheckoutIfNeeded{Branch: args.BeginBranch})
 		}
+		if worktree, hasWorktree := args.Worktrees.FindByBranch(addedLocalBranch).Get(); hasWorktree {
+			result.Add(&opcodes.WorktreeRemove{Path: worktree.Path})
+		}
 		result.Add(&opcodes.BranchLocalDelete{Branch: addedLocalBranch})
 	}
 
@@ -217,4 +236,39 @@ type BranchChangesUndoProgramArgs struct {
 	FinalMessages            stringslice.Collector
 	UndoAPIProgram           program.Program
 	UndoablePerennialCommits []gitdomain.SHA
+	Worktrees                gitdomain.Worktrees // other worktrees in which the Git Town command ran opcodes, or that it created or removed
+}
+
+// addInWorktree adds the given program, which operates on the given branch, to the given result.
+// If the given branch is checked out in one of the given worktrees, the program runs in that worktree.
+func addInWorktree(result *program.Program, branchProgram program.Program, branch gitdomain.LocalBranchName, worktrees gitdomain.Worktrees) {
+	worktree, hasWorktree := worktrees.FindByBranch(branch).Get()
+	if !hasWorktree {
+		result.AddProgram(branchProgram)
+		return
+	}
+	result.Add(&opcodes.WorktreeEnter{Path: worktree.Path})
+	result.AddProgram(branchProgram)
+	result.Add(&opcodes.WorktreeLeave{})
+}
+
+// restoreWorktree re-creates the worktree for the given re-created branch
+// if the Git Town command removed it together with the branch.
+func restoreWorktree(result *program.Program, branch gitdomain.LocalBranchName, worktrees gitdomain.Worktrees) {
+	if worktree, hasWorktree := worktrees.FindByBranch(branch).Get(); hasWorktree {
+		result.Add(&opcodes.WorktreeAdd{Branch: branch, Path: worktree.Path})
+	}
+}
+
+// perennialCommitsToRevert provides the undoable commits that were added to a perennial branch up to the given last commit,
+// newest first.
+// Shipping a stack adds one undoable commit per shipped branch.
+func perennialCommitsToRevert(undoableCommits []gitdomain.SHA, lastCommit gitdomain.SHA) []gitdomain.SHA {
+	index := slices.Index(undoableCommits, lastCommit)
+	if index < 0 {
+		return []gitdomain.SHA{}
+	}
+	result := slices.Clone(undoableCommits[:index+1])
+	slices.Reverse(result)
+	return result
 }
@@ -188,6 +188,71 @@ func TestChanges(t *testing.T) {
 		must.Eq(t, wantProgram, haveProgram)
 	})
 
+	t.Run("local-only branch changed in another worktree", func(t *testing.T) {
+		t.Parallel()
+		before := gitdomain.BranchesSnapshot{
+			Branches: gitdomain.BranchInfos{
+				gitdomain.BranchInfo{
+					Local:      Some(gitdomain.BranchData{Name: "feature-branch", SHA: "111111"}),
+					SyncStatus: gitdomain.SyncStatusOtherWorktree,
+					RemoteName: None[gitdomain.RemoteBranchName](),
+					RemoteSHA:  None[gitdomain.SHA](),
+				},
+			},
+			Active: gitdomain.NewLocalBranchNameOption("main"),
+		}
+		after := gitdomain.BranchesSnapshot{
+			Branches: gitdomain.BranchInfos{
+				gitdomain.BranchInfo{
+					Local:      Some(gitdomain.BranchData{Name: "feature-branch", SHA: "222222"}),
+					SyncStatus: gitdomain.SyncStatusOtherWorktree,
+					RemoteName: None[gitdomain.RemoteBranchName](),
+					RemoteSHA:  None[gitdomain.SHA](),
+				},
+			},
+			Active: gitdomain.NewLocalBranchNameOption("main"),
+		}
+		haveChanges := undobranches.NewBranchSpans(before, after).Changes()
+		config := config.ValidatedConfig{
+			ValidatedConfigData: configdomain.ValidatedConfigData{
+				MainBranch: "main",
+			},
+			NormalConfig: config.NormalConfig{
+				Lineage: configdomain.NewLineageWith(configdomain.LineageData{
+					"feature-branch": "main",
+				}),
+				PerennialBranches: gitdomain.LocalBranchNames{},
+				PushHook:          false,
+			},
+		}
+		haveProgram := haveChanges.UndoProgram(undobranches.BranchChangesUndoProgramArgs{
+			BeginBranch:              before.Active.GetOrPanic(),
+			BranchInfos:              before.Branches,
+			Config:                   config,
+			EndBranch:                after.Active.GetOrPanic(),
+			FinalMessages:            stringslice.NewCollector(),
+			UndoablePerennialCommits: []gitdomain.SHA{},
+			Worktrees: gitdomain.Worktrees{
+				{
+					Branch: gitdomain.NewLocalBranchNameOption("feature-branch"),
+					Path:   "/path/to/worktree",
+					SHA:    "222222",
+				},
+			},
+		})
+		wantProgram := program.Program{
+			&opcodes.WorktreeEnter{Path: "/path/to/worktree"},
+			&opcodes.CheckoutIfNeeded{Branch: "feature-branch"},
+			&opcodes.BranchCurrentResetToSHAIfNeeded{
+				MustHaveSHA: "222222",
+				SetToSHA:    "111111",
+			},
+			&opcodes.WorktreeLeave{},
+			&opcodes.CheckoutIfExists{Branch: "main"},
+		}
+		must.Eq(t, wantProgram, haveProgram)
+	})
+
 	t.Run("local-only branch pushed to origin", func(t *testing.T) {
 		t.Parallel()
 		before := gitdomain.BranchesSnapshot{
@@ -745,6 +810,61 @@ func TestChanges(t *testing.T) {
 		must.Eq(t, wantProgram, haveProgram)
 	})
 
+	t.Run("omnibranch changed locally and remotely to same SHA through several undoable commits", func(t *testing.T) {
+		t.Parallel()
+		before := gitdomain.BranchesSnapshot{
+			Branches: gitdomain.BranchInfos{
+				gitdomain.BranchInfo{
+					Local:      Some(gitdomain.BranchData{Name: "main", SHA: "111111"}),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: Some(gitdomain.NewRemoteBranchName("origin/main")),
+					RemoteSHA:  Some(gitdomain.NewSHA("111111")),
+				},
+			},
+			Active: gitdomain.NewLocalBranchNameOption("main"),
+		}
+		after := gitdomain.BranchesSnapshot{
+			Branches: gitdomain.BranchInfos{
+				gitdomain.BranchInfo{
+					Local:      Some(gitdomain.BranchData{Name: "main", SHA: "333333"}),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: Some(gitdomain.NewRemoteBranchName("origin/main")),
+					RemoteSHA:  Some(gitdomain.NewSHA("333333")),
+				},
+			},
+			Active: gitdomain.NewLocalBranchNameOption("main"),
+		}
+		haveChanges := undobranches.NewBranchSpans(before, after).Changes()
+		config := config.ValidatedConfig{
+			ValidatedConfigData: configdomain.ValidatedConfigData{
+				MainBranch: "main",
+			},
+			NormalConfig: config.NormalConfig{
+				Lineage:           configdomain.NewLineage(),
+				UnknownBranchType: configdomain.UnknownBranchType(configdomain.BranchTypeFeatureBranch),
+				PushHook:          false,
+			},
+		}
+		haveProgram := haveChanges.UndoProgram(undobranches.BranchChangesUndoProgramArgs{
+			BeginBranch:              before.Active.GetOrPanic(),
+			BranchInfos:              before.Branches,
+			Config:                   config,
+			EndBranch:                after.Active.GetOrPanic(),
+			FinalMessages:            stringslice.NewCollector(),
+			UndoablePerennialCommits: []gitdomain.SHA{"222222", "333333"},
+		})
+		wantProgram := program.Program{
+			// revert the commits on the perennial branch, newest first
+			&opcodes.CheckoutIfNeeded{Branch: "main"},
+			&opcodes.CommitRevertIfNeeded{SHA: "333333"},
+			&opcodes.CommitRevertIfNeeded{SHA: "222222"},
+			&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: "main", TrackingBranch: "origin/main"},
+			// check out the initial branch
+			&opcodes.CheckoutIfExists{Branch: "main"},
+		}
+		must.Eq(t, wantProgram, haveProgram)
+	})
+
 	t.Run("omnibranch deleted locally", func(t *testing.T) {
 		t.Parallel()
 		before := gitdomain.BranchesSnapshot{
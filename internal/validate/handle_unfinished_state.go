@@ -54,6 +54,7 @@ func HandleUnfinishedState(args UnfinishedStateArgs) (configdomain.ProgramFlow,
 	if args.Connector.IsNone() {
 		normalConfig := args.UnvalidatedConfig.NormalConfig
 		args.Connector, err = forge.NewConnector(forge.NewConnectorArgs{
+			AzuredevopsToken:     normalConfig.AzuredevopsToken,
 			Backend:              args.Backend,
 			BitbucketAppPassword: normalConfig.BitbucketAppPassword,
 			BitbucketUsername:    normalConfig.BitbucketUsername,
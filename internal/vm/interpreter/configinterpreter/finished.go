@@ -8,6 +8,7 @@ import (
 	"github.com/git-town/git-town/v22/internal/git/gitdomain"
 	"github.com/git-town/git-town/v22/internal/gohacks"
 	"github.com/git-town/git-town/v22/internal/gohacks/stringslice"
+	"github.com/git-town/git-town/v22/internal/state/runhistory"
 	"github.com/git-town/git-town/v22/internal/state/runstate"
 	"github.com/git-town/git-town/v22/internal/subshell/subshelldomain"
 	"github.com/git-town/git-town/v22/internal/vm/program"
@@ -59,6 +60,7 @@ func Finished(args FinishedArgs) error {
 		EndBranchesSnapshot:      endBranchesSnapshot,
 		EndConfigSnapshot:        Some(configSnapshot),
 		EndStashSize:             None[gitdomain.StashSize](),
+		FinalUndoFilesProgram:    program.Program{},
 		FinalUndoProgram:         program.Program{},
 		BranchInfosLastRun:       None[gitdomain.BranchInfos](),
 		RunProgram:               program.Program{},
@@ -69,5 +71,8 @@ func Finished(args FinishedArgs) error {
 	}
 	print.Footer(args.Verbose, args.CommandsCounter.Immutable(), args.FinalMessages.Result())
 	runstatePath := runstate.NewRunstatePath(args.ConfigDir)
-	return runstate.Save(runState, runstatePath)
+	if err = runstate.Save(runState, runstatePath); err != nil {
+		return err
+	}
+	return runhistory.Append(runState, runhistory.NewRunhistoryPath(args.ConfigDir))
 }
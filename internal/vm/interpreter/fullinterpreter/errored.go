@@ -3,20 +3,23 @@ package fullinterpreter
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/git-town/git-town/v22/internal/cli/print"
 	"github.com/git-town/git-town/v22/internal/config/configdomain"
 	"github.com/git-town/git-town/v22/internal/config/gitconfig"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
 	"github.com/git-town/git-town/v22/internal/messages"
 	"github.com/git-town/git-town/v22/internal/state/runlog"
 	"github.com/git-town/git-town/v22/internal/state/runstate"
+	"github.com/git-town/git-town/v22/internal/vm/opcodes"
 	"github.com/git-town/git-town/v22/internal/vm/program"
 	"github.com/git-town/git-town/v22/internal/vm/shared"
 	. "github.com/git-town/git-town/v22/pkg/prelude"
 )
 
 // errored is called when the given opcode has resulted in the given error.
-func errored(failedOpcode shared.Opcode, runErr error, args ExecuteArgs) error {
+func errored(failedOpcode shared.Opcode, runErr error, worktree Option[gitdomain.WorktreePath], args ExecuteArgs) error {
 	endBranchesSnapshot, err := args.Git.BranchesSnapshot(args.Backend)
 	if err != nil {
 		return err
@@ -45,9 +48,13 @@ func errored(failedOpcode shared.Opcode, runErr error, args ExecuteArgs) error {
 	}
 	args.RunState.EndStashSize = Some(endStashSize)
 	if abortable, isAbortable := failedOpcode.(shared.Abortable); isAbortable {
-		args.RunState.AbortProgram.Add(abortable.Abort()...)
+		args.RunState.AbortProgram.AddProgram(enterWorktree(abortable.Abort(), worktree))
+		if worktree.IsSome() {
+			args.RunState.AbortProgram.Add(&opcodes.WorktreeLeave{})
+		}
 	}
-	if autoUndoable, isAutoUndoable := failedOpcode.(shared.AutoUndoable); isAutoUndoable {
+	shippingStack := isShippingStack(args.RunState)
+	if autoUndoable, isAutoUndoable := failedOpcode.(shared.AutoUndoable); isAutoUndoable && !shippingStack {
 		return autoUndo(autoUndoable, runErr, args)
 	}
 	var continueProgram program.Program
@@ -56,7 +63,7 @@ func errored(failedOpcode shared.Opcode, runErr error, args ExecuteArgs) error {
 	} else {
 		continueProgram = []shared.Opcode{failedOpcode}
 	}
-	args.RunState.RunProgram.Prepend(continueProgram...)
+	args.RunState.RunProgram.Prepend(enterWorktree(continueProgram, worktree)...)
 	currentBranchOpt, err := args.Git.CurrentBranch(args.Backend)
 	if err != nil {
 		return err
@@ -70,6 +77,9 @@ func errored(failedOpcode shared.Opcode, runErr error, args ExecuteArgs) error {
 	if args.RunState.Command == "propose" {
 		canSkip = true
 	}
+	if shippingStack {
+		canSkip = true
+	}
 	if args.RunState.Command == "sync" && !(repoStatus.RebaseInProgress && hasCurrentBranch && args.Config.ValidatedConfigData.IsMainBranch(currentBranch)) {
 		canSkip = true
 	}
@@ -95,3 +105,22 @@ func errored(failedOpcode shared.Opcode, runErr error, args ExecuteArgs) error {
 	args.Inputs.VerifyAllUsed()
 	return errors.New(message)
 }
+
+// isShippingStack indicates whether the given RunState ships several branches.
+// Such programs stop at the branch that failed to ship instead of undoing the branches that have already shipped.
+func isShippingStack(runState runstate.RunState) bool {
+	return runState.Command == "ship" && slices.ContainsFunc(runState.RunProgram, opcodes.IsEndOfBranchProgramOpcode)
+}
+
+// enterWorktree makes the given program run in the given worktree.
+// The interpreter always starts in the worktree in which Git Town was started,
+// so programs that resume an operation in another worktree must enter it again.
+func enterWorktree(prog program.Program, worktree Option[gitdomain.WorktreePath]) program.Program {
+	path, hasPath := worktree.Get()
+	if !hasPath {
+		return prog
+	}
+	result := program.Program{&opcodes.WorktreeEnter{Path: path}}
+	result.AddProgram(prog)
+	return result
+}
@@ -46,6 +46,12 @@ func Execute(args ExecuteArgs) error {
 	if err := runlog.Write(runlog.EventStart, args.InitialBranchesSnapshot.Branches, args.PendingCommand, runlogPath); err != nil {
 		return err
 	}
+	worktree := None[gitdomain.WorktreePath]()
+	backend, frontend, gitCommands := args.Backend, args.Frontend, args.Git
+	setWorktree := func(path Option[gitdomain.WorktreePath]) {
+		worktree = path
+		backend, frontend, gitCommands = shared.WorktreeRunners(args.Backend, args.Frontend, args.Git, path)
+	}
 	for {
 		nextStep, hasNextStep := args.RunState.RunProgram.Pop().Get()
 		if !hasNextStep {
@@ -73,20 +79,21 @@ func Execute(args ExecuteArgs) error {
 			panic(fmt.Errorf(messages.OpcodeNotRunnable, gohacks.TypeName(nextStep)))
 		}
 		err := runnable.Run(shared.RunArgs{
-			Backend:                         args.Backend,
+			Backend:                         backend,
 			BranchInfos:                     args.InitialBranchesSnapshot.Branches,
 			Config:                          NewMutable(&args.Config),
 			Connector:                       args.Connector,
 			FinalMessages:                   args.FinalMessages,
-			Frontend:                        args.Frontend,
-			Git:                             args.Git,
+			Frontend:                        frontend,
+			Git:                             gitCommands,
 			Inputs:                          args.Inputs,
 			PrependOpcodes:                  args.RunState.RunProgram.Prepend,
 			RegisterUndoablePerennialCommit: args.RunState.RegisterUndoablePerennialCommit,
+			SetWorktree:                     setWorktree,
 			UpdateInitialSnapshotLocalSHA:   args.InitialBranchesSnapshot.Branches.UpdateLocalSHA,
 		})
 		if err != nil {
-			return errored(nextStep, err, args)
+			return errored(nextStep, err, worktree, args)
 		}
 		if undoExternal, canUndoExternal := nextStep.(shared.ExternalEffects); canUndoExternal {
 			args.RunState.UndoAPIProgram = append(args.RunState.UndoAPIProgram, undoExternal.UndoExternalChanges()...)
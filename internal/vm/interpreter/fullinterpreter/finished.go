@@ -11,6 +11,7 @@ import (
 	"github.com/git-town/git-town/v22/internal/gohacks"
 	"github.com/git-town/git-town/v22/internal/gohacks/stringslice"
 	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/state/runhistory"
 	"github.com/git-town/git-town/v22/internal/state/runlog"
 	"github.com/git-town/git-town/v22/internal/state/runstate"
 	"github.com/git-town/git-town/v22/internal/subshell/subshelldomain"
@@ -62,6 +63,9 @@ func finished(args finishedArgs) error {
 		if err = runstate.Save(args.RunState, runstatePath); err != nil {
 			return fmt.Errorf(messages.RunstateSaveProblem, err)
 		}
+		if err = runhistory.Append(args.RunState, runhistory.NewRunhistoryPath(args.ConfigDir)); err != nil {
+			return fmt.Errorf(messages.RunhistorySaveProblem, err)
+		}
 	}
 	print.Footer(args.Verbose, args.CommandsCounter.Immutable(), args.FinalMessages.Result())
 	args.Inputs.VerifyAllUsed()
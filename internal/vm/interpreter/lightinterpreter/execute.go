@@ -18,31 +18,40 @@ import (
 	. "github.com/git-town/git-town/v22/pkg/prelude"
 )
 
-func Execute(args ExecuteArgs) {
+// Execute runs the given program and prints the problems it encounters without stopping.
+// It returns the encountered problems.
+func Execute(args ExecuteArgs) []error {
+	backend, frontend, gitCommands := args.Backend, args.Frontend, args.Git
+	setWorktree := func(path Option[gitdomain.WorktreePath]) {
+		backend, frontend, gitCommands = shared.WorktreeRunners(args.Backend, args.Frontend, args.Git, path)
+	}
+	var problems []error
 	for {
 		nextStep, hasNextStep := args.Prog.Pop().Get()
 		if !hasNextStep {
-			return
+			return problems
 		}
 		runnable, isRunnable := nextStep.(shared.Runnable)
 		if !isRunnable {
 			panic(fmt.Errorf(messages.OpcodeNotRunnable, gohacks.TypeName(nextStep)))
 		}
 		err := runnable.Run(shared.RunArgs{
-			Backend:                         args.Backend,
+			Backend:                         backend,
 			BranchInfos:                     args.BranchInfos,
 			Config:                          NewMutable(&args.Config),
 			Connector:                       args.Connector,
 			FinalMessages:                   args.FinalMessages,
-			Frontend:                        args.Frontend,
-			Git:                             args.Git,
+			Frontend:                        frontend,
+			Git:                             gitCommands,
 			Inputs:                          dialogcomponents.NewInputs(),
 			PrependOpcodes:                  args.Prog.Prepend,
 			RegisterUndoablePerennialCommit: nil,
+			SetWorktree:                     setWorktree,
 			UpdateInitialSnapshotLocalSHA:   nil,
 		})
 		if err != nil {
 			fmt.Println(colors.Red().Styled("NOTICE: " + err.Error()))
+			problems = append(problems, err)
 		}
 	}
 }
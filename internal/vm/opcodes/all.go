@@ -10,6 +10,7 @@ func All() []shared.Opcode {
 	return []shared.Opcode{
 		&BranchCreateAndCheckoutExistingParent{},
 		&BranchCreate{},
+		&BranchCurrentResetToBranch{},
 		&BranchCurrentResetToParent{},
 		&BranchCurrentResetToSHAIfNeeded{},
 		&BranchCurrentResetToSHA{},
@@ -19,6 +20,8 @@ func All() []shared.Opcode {
 		&BranchLocalDeleteContent{},
 		&BranchLocalDelete{},
 		&BranchLocalRename{},
+		&BranchOverrideRemove{},
+		&BranchOverrideSet{},
 		&BranchRemoteCreate{},
 		&BranchRemoteSetToSHAIfNeeded{},
 		&BranchRemoteSetToSHA{},
@@ -52,11 +55,16 @@ func All() []shared.Opcode {
 		&CommitRevert{},
 		&CommitWithMessage{},
 		&Commit{},
+		&ConfigFileRemove{},
+		&ConfigFileWrite{},
+		&ConfigRemoveFrontend{},
 		&ConfigRemove{},
+		&ConfigSetFrontend{},
 		&ConfigSet{},
 		&ConflictMergePhantomFinalize{},
 		&ConflictMergePhantomResolveAll{},
 		&ConflictResolve{},
+		&ConnectorProposalAutoMerge{},
 		&ConnectorProposalMerge{},
 		&ExecuteShellCommand{},
 		&ExitToShell{},
@@ -79,13 +87,16 @@ func All() []shared.Opcode {
 		&MergeSquashProgram{},
 		&MessageQueue{},
 		&ProgramEndOfBranch{},
+		&ProposalCreateViaAPI{},
 		&ProposalCreate{},
+		&ProposalMarkReady{},
 		&ProposalUpdateBody{},
 		&ProposalUpdateBreadcrumb{},
 		&ProposalUpdateSource{},
 		&ProposalUpdateTargetToGrandParent{},
 		&ProposalUpdateTarget{},
 		&PullCurrentBranch{},
+		&PushBranchesIfNeeded{},
 		&PushCurrentBranchForceIfNeeded{},
 		&PushCurrentBranchForceIgnoreError{},
 		&PushCurrentBranchForce{},
@@ -114,5 +125,9 @@ func All() []shared.Opcode {
 		&SyncFeatureBranchMerge{},
 		&SyncFeatureBranchRebase{},
 		&UndoLastCommit{},
+		&WorktreeAdd{},
+		&WorktreeEnter{},
+		&WorktreeLeave{},
+		&WorktreeRemove{},
 	} //exhaustruct:ignore
 }
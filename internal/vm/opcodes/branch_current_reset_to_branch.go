@@ -0,0 +1,16 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/vm/shared"
+)
+
+// BranchCurrentResetToBranch makes the current branch point to the same commit as the given branch,
+// discarding all commits that exist only in the current branch.
+type BranchCurrentResetToBranch struct {
+	Branch gitdomain.LocalBranchName
+}
+
+func (self *BranchCurrentResetToBranch) Run(args shared.RunArgs) error {
+	return args.Git.ResetCurrentBranchToBranch(args.Frontend, self.Branch)
+}
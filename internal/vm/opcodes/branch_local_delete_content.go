@@ -13,7 +13,7 @@ type BranchLocalDeleteContent struct {
 }
 
 func (self *BranchLocalDeleteContent) Run(args shared.RunArgs) error {
-	switch args.Config.Value.NormalConfig.SyncFeatureStrategy {
+	switch args.Config.Value.NormalConfig.SyncFeatureStrategyFor(self.BranchToDelete) {
 	case configdomain.SyncFeatureStrategyRebase:
 		opcodes := []shared.Opcode{}
 		descendents := args.Config.Value.NormalConfig.Lineage.Descendants(self.BranchToDelete, args.Config.Value.NormalConfig.Order)
@@ -0,0 +1,18 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/config/gitconfig"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/vm/shared"
+)
+
+// BranchOverrideRemove removes the given setting specific to the branch with the given name from the Git config.
+type BranchOverrideRemove struct {
+	Branch  gitdomain.LocalBranchName
+	Setting configdomain.Key
+}
+
+func (self *BranchOverrideRemove) Run(args shared.RunArgs) error {
+	return gitconfig.RemoveBranchOverride(args.Backend, self.Branch, self.Setting)
+}
@@ -0,0 +1,19 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/config/gitconfig"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/vm/shared"
+)
+
+// BranchOverrideSet stores the given setting specific to the branch with the given name in the Git config.
+type BranchOverrideSet struct {
+	Branch  gitdomain.LocalBranchName
+	Setting configdomain.Key
+	Value   string
+}
+
+func (self *BranchOverrideSet) Run(args shared.RunArgs) error {
+	return gitconfig.SetBranchOverride(args.Backend, self.Branch, self.Setting, self.Value)
+}
@@ -0,0 +1,19 @@
+package opcodes
+
+import (
+	"os"
+
+	"github.com/git-town/git-town/v22/internal/vm/shared"
+)
+
+// ConfigFileRemove deletes the configuration file at the given path.
+type ConfigFileRemove struct {
+	FilePath string
+}
+
+func (self *ConfigFileRemove) Run(args shared.RunArgs) error {
+	if args.Config.Value.NormalConfig.DryRun {
+		return nil
+	}
+	return os.Remove(self.FilePath)
+}
@@ -0,0 +1,22 @@
+package opcodes
+
+import (
+	"os"
+
+	"github.com/git-town/git-town/v22/internal/vm/shared"
+)
+
+// ConfigFileWrite stores the given content in the configuration file at the given path.
+// Mode provides the permissions of the file if it doesn't exist yet.
+type ConfigFileWrite struct {
+	Content  string
+	FilePath string
+	Mode     os.FileMode
+}
+
+func (self *ConfigFileWrite) Run(args shared.RunArgs) error {
+	if args.Config.Value.NormalConfig.DryRun {
+		return nil
+	}
+	return os.WriteFile(self.FilePath, []byte(self.Content), self.Mode)
+}
@@ -0,0 +1,18 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/config/gitconfig"
+	"github.com/git-town/git-town/v22/internal/vm/shared"
+)
+
+// ConfigRemoveFrontend is like ConfigRemove but runs the Git command in the frontend,
+// so that the user sees it and dry-runs don't execute it.
+type ConfigRemoveFrontend struct {
+	Key   configdomain.Key // the config key to remove
+	Scope configdomain.ConfigScope
+}
+
+func (self *ConfigRemoveFrontend) Run(args shared.RunArgs) error {
+	return gitconfig.RemoveConfigValue(args.Frontend, self.Scope, self.Key)
+}
@@ -0,0 +1,19 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v22/internal/config/configdomain"
+	"github.com/git-town/git-town/v22/internal/config/gitconfig"
+	"github.com/git-town/git-town/v22/internal/vm/shared"
+)
+
+// ConfigSetFrontend is like ConfigSet but runs the Git command in the frontend,
+// so that the user sees it and dry-runs don't execute it.
+type ConfigSetFrontend struct {
+	Key   configdomain.Key
+	Scope configdomain.ConfigScope
+	Value string
+}
+
+func (self *ConfigSetFrontend) Run(args shared.RunArgs) error {
+	return gitconfig.SetConfigValue(args.Frontend, self.Scope, self.Key, self.Value)
+}
@@ -0,0 +1,42 @@
+package opcodes
+
+import (
+	"errors"
+
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/vm/shared"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// ConnectorProposalAutoMerge lets the forge merge the proposal of the given branch
+// using the given merge method once it is ready, for example through a merge queue or merge train.
+type ConnectorProposalAutoMerge struct {
+	Branch        gitdomain.LocalBranchName
+	CommitMessage Option[gitdomain.CommitMessage]
+	MergeMethod   forgedomain.ProposalMergeMethod
+	Proposal      forgedomain.Proposal
+	mergeError    error
+}
+
+func (self *ConnectorProposalAutoMerge) AutomaticUndoError() error {
+	return self.mergeError
+}
+
+func (self *ConnectorProposalAutoMerge) Run(args shared.RunArgs) error {
+	connector, hasConnector := args.Connector.Get()
+	if !hasConnector {
+		return forgedomain.UnsupportedServiceError()
+	}
+	proposalAutoMerger, canAutoMergeProposals := connector.(forgedomain.ProposalAutoMerger)
+	if !canAutoMergeProposals {
+		return errors.New(messages.ShipAPIAutoMergeUnsupported)
+	}
+	self.mergeError = proposalAutoMerger.AutoMergeProposal(self.Proposal.Data.Data().Number, self.MergeMethod, self.CommitMessage)
+	if self.mergeError != nil {
+		return self.mergeError
+	}
+	args.FinalMessages.Addf(messages.ShipAPIAutoMergeEnabled, self.Branch)
+	return nil
+}
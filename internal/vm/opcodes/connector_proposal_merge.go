@@ -11,10 +11,12 @@ import (
 	. "github.com/git-town/git-town/v22/pkg/prelude"
 )
 
-// ConnectorProposalMerge squash merges the branch with the given name into the current branch.
+// ConnectorProposalMerge lets the forge merge the proposal of the branch with the given name
+// using the given merge method.
 type ConnectorProposalMerge struct {
 	Branch                    gitdomain.LocalBranchName
 	CommitMessage             Option[gitdomain.CommitMessage]
+	MergeMethod               forgedomain.ProposalMergeMethod
 	Proposal                  forgedomain.Proposal
 	enteredEmptyCommitMessage bool
 	mergeError                error
@@ -37,9 +39,10 @@ func (self *ConnectorProposalMerge) AutomaticUndoError() error {
 func (self *ConnectorProposalMerge) Run(args shared.RunArgs) error {
 	commitMessage, hasCommitMessage := self.CommitMessage.Get()
 	proposalData := self.Proposal.Data.Data()
-	if !hasCommitMessage {
+	// rebasing creates no commit that could receive the message
+	if !hasCommitMessage && self.MergeMethod != forgedomain.ProposalMergeMethodRebase {
 		// Allow the user to enter the commit message as if shipping without a connector
-		// then revert the commit since merging via the connector will perform the actual squash merge.
+		// then revert the commit since merging via the connector will perform the actual merge.
 		self.enteredEmptyCommitMessage = true
 		if err := args.Git.SquashMerge(args.Frontend, self.Branch); err != nil {
 			return err
@@ -68,7 +71,7 @@ func (self *ConnectorProposalMerge) Run(args shared.RunArgs) error {
 	if !canMergeProposals {
 		return errors.New(messages.ShipAPIConnectorUnsupported)
 	}
-	self.mergeError = proposalMerger.SquashMergeProposal(proposalData.Number, commitMessage)
+	self.mergeError = proposalMerger.MergeProposal(proposalData.Number, self.MergeMethod, commitMessage)
 	return self.mergeError
 }
 
@@ -26,6 +26,12 @@ func (self *MergeAlwaysProgram) AutomaticUndoError() error {
 	return errors.New(messages.ShipExitMergeError)
 }
 
+func (self *MergeAlwaysProgram) Continue() []shared.Opcode {
+	return []shared.Opcode{
+		&MergeContinue{},
+	}
+}
+
 func (self *MergeAlwaysProgram) Run(args shared.RunArgs) error {
 	// Reverting parent is intentionally not supported due to potential confusion
 	// caused by reverted merge commit. See
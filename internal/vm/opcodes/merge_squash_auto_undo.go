@@ -23,6 +23,11 @@ func (self *MergeSquashAutoUndo) AutomaticUndoError() error {
 	return errors.New(messages.ShipExitMergeError)
 }
 
+func (self *MergeSquashAutoUndo) Continue() []shared.Opcode {
+	// the user has resolved the conflicts, the following opcodes commit the squashed changes
+	return []shared.Opcode{}
+}
+
 func (self *MergeSquashAutoUndo) Run(args shared.RunArgs) error {
 	return args.Git.SquashMerge(args.Frontend, self.Branch)
 }
@@ -4,16 +4,21 @@ import (
 	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
 	"github.com/git-town/git-town/v22/internal/git/gitdomain"
 	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/proposallineage"
 	"github.com/git-town/git-town/v22/internal/vm/shared"
 	. "github.com/git-town/git-town/v22/pkg/prelude"
 )
 
 // ProposalCreate creates a new proposal for the current branch.
 type ProposalCreate struct {
+	Assignees     gitdomain.ProposalMetadataNames
 	Branch        gitdomain.LocalBranchName
+	Draft         bool
+	Labels        gitdomain.ProposalMetadataNames
 	MainBranch    gitdomain.LocalBranchName
 	ProposalBody  Option[gitdomain.ProposalBody]
 	ProposalTitle Option[gitdomain.ProposalTitle]
+	Reviewers     gitdomain.ProposalMetadataNames
 }
 
 func (self *ProposalCreate) Run(args shared.RunArgs) error {
@@ -43,17 +48,44 @@ func (self *ProposalCreate) Run(args shared.RunArgs) error {
 	}
 
 createProposal:
-	// TODO: create proposal with embedded lineage here. The lineage is loaded below.
-	err := connector.CreateProposal(forgedomain.CreateProposalArgs{
+	templateTitle, templateBody, err := renderProposalTemplate(args, self.Branch, parentBranch)
+	if err != nil {
+		return err
+	}
+	proposalBody := self.ProposalBody
+	if body, hasBody := templateBody.Get(); hasBody && proposalBody.IsNone() {
+		if args.Config.Value.NormalConfig.ProposalBreadcrumb.Enabled() {
+			lineageSection := proposallineage.RenderSection(args.Config.Value.NormalConfig.Lineage, self.Branch, args.Config.Value.NormalConfig.Order, args.Config.Value.NormalConfig.ProposalBreadcrumb, args.Config.Value.NormalConfig.ProposalBreadcrumbDirection, args.Connector)
+			if len(lineageSection) > 0 {
+				body = proposallineage.UpdateProposalBody(body, lineageSection)
+			}
+		}
+		proposalBody = Some(body)
+	}
+	createArgs := forgedomain.CreateProposalArgs{
+		Assignees:      self.Assignees,
 		Branch:         self.Branch,
+		Draft:          self.Draft,
 		FrontendRunner: args.Frontend,
+		Labels:         self.Labels,
 		MainBranch:     self.MainBranch,
 		ParentBranch:   parentBranch,
-		ProposalBody:   self.ProposalBody,
-		ProposalTitle:  self.ProposalTitle,
-	})
-	if err != nil {
-		return err
+		ProposalBody:   proposalBody,
+		ProposalTitle:  self.ProposalTitle.Or(templateTitle),
+		Reviewers:      self.Reviewers,
+	}
+	proposalCreator, canCreateProposals := connector.(forgedomain.ProposalCreator)
+	if (createArgs.Draft || createArgs.HasMetadata()) && canCreateProposals {
+		// the web UI of most forges cannot be pre-populated with drafts, assignees, labels, and reviewers,
+		// hence create such proposals via the API
+		if err := createProposalViaAPI(args, proposalCreator, createArgs); err != nil {
+			return err
+		}
+	} else {
+		// TODO: create proposal with embedded lineage here. The lineage is loaded below.
+		if err := connector.CreateProposal(createArgs); err != nil {
+			return err
+		}
 	}
 
 	if args.Config.Value.NormalConfig.ProposalBreadcrumb.Enabled() {
@@ -64,3 +96,17 @@ createProposal:
 	}
 	return nil
 }
+
+func createProposalViaAPI(args shared.RunArgs, proposalCreator forgedomain.ProposalCreator, createArgs forgedomain.CreateProposalArgs) error {
+	title, err := proposalTitleOrDefault(args, createArgs.Branch, createArgs.ParentBranch, createArgs.ProposalTitle)
+	if err != nil {
+		return err
+	}
+	createArgs.ProposalTitle = Some(title)
+	proposalData, err := proposalCreator.CreateProposalViaAPI(createArgs)
+	if err != nil {
+		return err
+	}
+	args.PrependOpcodes(&BrowserOpen{URL: proposalData.URL})
+	return nil
+}
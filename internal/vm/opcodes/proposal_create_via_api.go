@@ -0,0 +1,128 @@
+package opcodes
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/proposallineage"
+	"github.com/git-town/git-town/v22/internal/proposaltemplate"
+	"github.com/git-town/git-town/v22/internal/vm/shared"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// ProposalCreateViaAPI creates a proposal for the given branch through the API of the forge,
+// unless the branch already has a proposal into its parent branch.
+type ProposalCreateViaAPI struct {
+	Assignees     gitdomain.ProposalMetadataNames
+	Branch        gitdomain.LocalBranchName
+	Draft         bool
+	Labels        gitdomain.ProposalMetadataNames
+	MainBranch    gitdomain.LocalBranchName
+	ProposalBody  Option[gitdomain.ProposalBody]
+	ProposalTitle Option[gitdomain.ProposalTitle]
+	Reviewers     gitdomain.ProposalMetadataNames
+}
+
+func (self *ProposalCreateViaAPI) Run(args shared.RunArgs) error {
+	parentBranch, hasParentBranch := args.Config.Value.NormalConfig.Lineage.Parent(self.Branch).Get()
+	if !hasParentBranch {
+		args.FinalMessages.Addf(messages.ProposalNoParent, self.Branch)
+		return nil
+	}
+	connector, hasConnector := args.Connector.Get()
+	if !hasConnector {
+		return forgedomain.UnsupportedServiceError()
+	}
+	proposalCreator, canCreateProposals := connector.(forgedomain.ProposalCreator)
+	if !canCreateProposals {
+		return errors.New(messages.ProposalCreateUnsupported)
+	}
+	if proposalFinder, canFindProposals := connector.(forgedomain.ProposalFinder); canFindProposals {
+		existingProposal, err := proposalFinder.FindProposal(self.Branch, parentBranch)
+		if err != nil {
+			return err
+		}
+		if existingProposal.IsSome() {
+			return nil
+		}
+	}
+	templateTitle, templateBody, err := renderProposalTemplate(args, self.Branch, parentBranch)
+	if err != nil {
+		return err
+	}
+	title, err := proposalTitleOrDefault(args, self.Branch, parentBranch, self.ProposalTitle.Or(templateTitle))
+	if err != nil {
+		return err
+	}
+	_, err = proposalCreator.CreateProposalViaAPI(forgedomain.CreateProposalArgs{
+		Assignees:      self.Assignees,
+		Branch:         self.Branch,
+		Draft:          self.Draft,
+		FrontendRunner: args.Frontend,
+		Labels:         self.Labels,
+		MainBranch:     self.MainBranch,
+		ParentBranch:   parentBranch,
+		ProposalBody:   self.ProposalBody.Or(templateBody),
+		ProposalTitle:  Some(title),
+		Reviewers:      self.Reviewers,
+	})
+	return err
+}
+
+// proposalTitleOrDefault provides the given proposal title,
+// or the title that the web UI of most forges pre-populates: the message of the first commit in the branch.
+func proposalTitleOrDefault(args shared.RunArgs, branch, parent gitdomain.LocalBranchName, title Option[gitdomain.ProposalTitle]) (gitdomain.ProposalTitle, error) {
+	if title, hasTitle := title.Get(); hasTitle {
+		return title, nil
+	}
+	commits, err := args.Git.CommitsInFeatureBranch(args.Backend, branch, parent.BranchName())
+	if err != nil {
+		return "", err
+	}
+	if len(commits) == 0 {
+		return gitdomain.ProposalTitle(branch.String()), nil
+	}
+	return gitdomain.ProposalTitle(commits[0].Message.Parts().Title), nil
+}
+
+// renderProposalTemplate provides the title and body of the proposal for the given branch
+// rendered from the template file configured via "propose.template".
+func renderProposalTemplate(args shared.RunArgs, branch, parent gitdomain.LocalBranchName) (Option[gitdomain.ProposalTitle], Option[gitdomain.ProposalBody], error) {
+	config := args.Config.Value.NormalConfig
+	proposeTemplate, hasProposeTemplate := config.ProposeTemplate.Get()
+	if !hasProposeTemplate {
+		return None[gitdomain.ProposalTitle](), None[gitdomain.ProposalBody](), nil
+	}
+	rootDir := ""
+	if repoRootDir, hasRepoRootDir := args.Git.RootDirectory(args.Backend).Get(); hasRepoRootDir {
+		rootDir = repoRootDir.String()
+	}
+	text, err := os.ReadFile(proposeTemplate.Resolve(rootDir))
+	if err != nil {
+		return None[gitdomain.ProposalTitle](), None[gitdomain.ProposalBody](), fmt.Errorf(messages.ProposeTemplateCannotRead, proposeTemplate, err)
+	}
+	commits, err := args.Git.CommitsInBranch(args.Backend, branch, Some(parent))
+	if err != nil {
+		return None[gitdomain.ProposalTitle](), None[gitdomain.ProposalBody](), err
+	}
+	// CommitsInBranch provides only the commit titles
+	commitMessages := make(gitdomain.CommitMessages, len(commits))
+	for c, commit := range commits {
+		if commitMessages[c], err = args.Git.CommitMessage(args.Backend, commit.SHA); err != nil {
+			return None[gitdomain.ProposalTitle](), None[gitdomain.ProposalBody](), err
+		}
+	}
+	lineage := func() string {
+		tree := proposallineage.AddProposalsToTree(proposallineage.CalculateTree(branch, config.Lineage, config.Order), args.Connector)
+		return proposallineage.UpdateProposalBody("", proposallineage.RenderTree(tree, branch, config.ProposalBreadcrumbDirection)).String()
+	}
+	title, body, err := proposaltemplate.Render(string(text), proposaltemplate.NewData(branch, parent, commitMessages, lineage))
+	if err != nil {
+		return None[gitdomain.ProposalTitle](), None[gitdomain.ProposalBody](), fmt.Errorf(messages.ProposeTemplateInvalid, proposeTemplate, err)
+	}
+	return title, body, nil
+}
@@ -0,0 +1,49 @@
+package opcodes
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/git-town/git-town/v22/internal/forge/forgedomain"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/messages"
+	"github.com/git-town/git-town/v22/internal/vm/shared"
+)
+
+// ProposalMarkReady marks the draft proposal of the given branch as ready for review.
+type ProposalMarkReady struct {
+	Branch gitdomain.LocalBranchName
+}
+
+func (self *ProposalMarkReady) Run(args shared.RunArgs) error {
+	parentBranch, hasParentBranch := args.Config.Value.NormalConfig.Lineage.Parent(self.Branch).Get()
+	if !hasParentBranch {
+		return fmt.Errorf(messages.ProposalNoParent, self.Branch)
+	}
+	connector, hasConnector := args.Connector.Get()
+	if !hasConnector {
+		return forgedomain.UnsupportedServiceError()
+	}
+	proposalFinder, canFindProposals := connector.(forgedomain.ProposalFinder)
+	if !canFindProposals {
+		return errors.New(messages.ProposalMarkReadyUnsupported)
+	}
+	proposalReadyMarker, canMarkProposalsReady := connector.(forgedomain.ProposalReadyMarker)
+	if !canMarkProposalsReady {
+		return errors.New(messages.ProposalMarkReadyUnsupported)
+	}
+	proposalOpt, err := proposalFinder.FindProposal(self.Branch, parentBranch)
+	if err != nil {
+		return err
+	}
+	proposal, hasProposal := proposalOpt.Get()
+	if !hasProposal {
+		return fmt.Errorf(messages.ProposalNotFound, self.Branch, parentBranch)
+	}
+	proposalData := proposal.Data.Data()
+	if !proposalData.Draft {
+		args.FinalMessages.Addf(messages.ProposalNotDraft, "#"+proposalData.Number.String())
+		return nil
+	}
+	return proposalReadyMarker.MarkProposalReady(proposal.Data)
+}
@@ -21,6 +21,9 @@ func (self *ProposalUpdateTarget) AutomaticUndoError() error {
 }
 
 func (self *ProposalUpdateTarget) Run(args shared.RunArgs) error {
+	if args.Config.Value.NormalConfig.DryRun {
+		return nil
+	}
 	connector, hasConnector := args.Connector.Get()
 	if !hasConnector {
 		return forgedomain.UnsupportedServiceError()
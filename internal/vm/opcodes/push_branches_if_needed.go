@@ -0,0 +1,51 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/vm/shared"
+)
+
+// PushBranchesIfNeeded pushes the given branches that have unpushed commits to their tracking branches.
+// It pushes all branches that go to the same remote in the same way using a single "git push" invocation.
+type PushBranchesIfNeeded struct {
+	Branches []gitdomain.BranchPush
+}
+
+func (self *PushBranchesIfNeeded) Run(args shared.RunArgs) error {
+	branchesToPush := []gitdomain.BranchPush{}
+	remotes := gitdomain.Remotes{}
+	for _, branch := range self.Branches {
+		// the branch could not exist at this point if it was pruned at runtime due to being empty
+		if !args.Git.BranchExists(args.Backend, branch.Branch) {
+			continue
+		}
+		inSync, err := args.Git.BranchInSyncWithTracking(args.Backend, branch.Branch, branch.TrackingBranch)
+		if err != nil {
+			return err
+		}
+		if inSync {
+			continue
+		}
+		branchesToPush = append(branchesToPush, branch)
+		if remote := branch.TrackingBranch.Remote(); !remotes.HasRemote(remote) {
+			remotes = append(remotes, remote)
+		}
+	}
+	for _, remote := range remotes {
+		for _, mode := range gitdomain.PushModes() {
+			batch := []gitdomain.BranchPush{}
+			for _, branch := range branchesToPush {
+				if branch.TrackingBranch.Remote() == remote && branch.Mode == mode {
+					batch = append(batch, branch)
+				}
+			}
+			if len(batch) == 0 {
+				continue
+			}
+			if err := args.Git.PushBranches(args.Frontend, remote, batch, mode, args.Config.Value.NormalConfig.PushHook); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/vm/shared"
+)
+
+// WorktreeAdd creates a new worktree at the given path that has the given branch checked out.
+type WorktreeAdd struct {
+	Branch gitdomain.LocalBranchName
+	Path   gitdomain.WorktreePath
+}
+
+func (self *WorktreeAdd) Run(args shared.RunArgs) error {
+	return args.Git.WorktreeAdd(args.Frontend, self.Path, self.Branch)
+}
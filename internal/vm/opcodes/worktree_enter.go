@@ -0,0 +1,17 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/vm/shared"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// WorktreeEnter makes the subsequent opcodes run in the given worktree.
+type WorktreeEnter struct {
+	Path gitdomain.WorktreePath
+}
+
+func (self *WorktreeEnter) Run(args shared.RunArgs) error {
+	args.SetWorktree(Some(self.Path))
+	return nil
+}
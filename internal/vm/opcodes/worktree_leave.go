@@ -0,0 +1,15 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/vm/shared"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// WorktreeLeave makes the subsequent opcodes run in the worktree in which Git Town was started.
+type WorktreeLeave struct{}
+
+func (self *WorktreeLeave) Run(args shared.RunArgs) error {
+	args.SetWorktree(None[gitdomain.WorktreePath]())
+	return nil
+}
@@ -0,0 +1,15 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/vm/shared"
+)
+
+// WorktreeRemove removes the worktree at the given path.
+type WorktreeRemove struct {
+	Path gitdomain.WorktreePath
+}
+
+func (self *WorktreeRemove) Run(args shared.RunArgs) error {
+	return args.Git.WorktreeRemove(args.Frontend, self.Path)
+}
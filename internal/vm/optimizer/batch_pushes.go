@@ -0,0 +1,67 @@
+package optimizer
+
+import (
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/vm/opcodes"
+	"github.com/git-town/git-town/v22/internal/vm/program"
+	"github.com/git-town/git-town/v22/internal/vm/shared"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// BatchPushes returns the given program where all opcodes that push individual branches
+// are replaced with a single opcode that pushes all these branches at once.
+// The batched push happens after the program for the last branch,
+// so that skipping that branch doesn't skip pushing the other branches.
+// This is safe because syncing a branch doesn't depend on the tracking branches of other branches.
+func BatchPushes(prog program.Program) program.Program {
+	branchPushes := []gitdomain.BranchPush{}
+	lastPushIndex := -1
+	for o, opcode := range prog {
+		if branchPush, isBranchPush := branchPushInOpcode(opcode).Get(); isBranchPush {
+			branchPushes = append(branchPushes, branchPush)
+			lastPushIndex = o
+		}
+	}
+	if len(branchPushes) < 2 {
+		return prog
+	}
+	batchIndex := lastPushIndex
+	for o := lastPushIndex + 1; o < len(prog); o++ {
+		if opcodes.IsEndOfBranchProgramOpcode(prog[o]) {
+			batchIndex = o
+		}
+	}
+	result := make([]shared.Opcode, 0, len(prog)-len(branchPushes)+1)
+	for o, opcode := range prog {
+		if branchPushInOpcode(opcode).IsNone() {
+			result = append(result, opcode)
+		}
+		if o == batchIndex {
+			result = append(result, &opcodes.PushBranchesIfNeeded{Branches: branchPushes})
+		}
+	}
+	return result
+}
+
+// branchPushInOpcode provides the branch push that the given opcode performs, if it can be batched.
+func branchPushInOpcode(opcode shared.Opcode) Option[gitdomain.BranchPush] {
+	switch opcode := opcode.(type) {
+	case *opcodes.PushCurrentBranchIfNeeded:
+		return Some(gitdomain.BranchPush{
+			Branch:         opcode.CurrentBranch,
+			Mode:           gitdomain.PushModeFastForward,
+			TrackingBranch: opcode.TrackingBranch,
+		})
+	case *opcodes.PushCurrentBranchForceIfNeeded:
+		mode := gitdomain.PushModeForceWithLease
+		if opcode.ForceIfIncludes {
+			mode = gitdomain.PushModeForceIfIncludes
+		}
+		return Some(gitdomain.BranchPush{
+			Branch:         opcode.CurrentBranch,
+			Mode:           mode,
+			TrackingBranch: opcode.TrackingBranch,
+		})
+	}
+	return None[gitdomain.BranchPush]()
+}
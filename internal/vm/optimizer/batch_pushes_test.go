@@ -0,0 +1,94 @@
+package optimizer_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/vm/opcodes"
+	"github.com/git-town/git-town/v22/internal/vm/optimizer"
+	"github.com/git-town/git-town/v22/internal/vm/program"
+	"github.com/shoenig/test/must"
+)
+
+func TestBatchPushes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("multiple push opcodes", func(t *testing.T) {
+		t.Parallel()
+		give := program.Program{
+			&opcodes.CheckoutIfNeeded{Branch: "branch-1"},
+			&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: "branch-1", TrackingBranch: "origin/branch-1"},
+			&opcodes.ProgramEndOfBranch{},
+			&opcodes.CheckoutIfNeeded{Branch: "branch-2"},
+			&opcodes.PushCurrentBranchForceIfNeeded{CurrentBranch: "branch-2", ForceIfIncludes: true, TrackingBranch: "origin/branch-2"},
+			&opcodes.ProgramEndOfBranch{},
+			&opcodes.CheckoutIfNeeded{Branch: "branch-3"},
+			&opcodes.PushCurrentBranchForceIfNeeded{CurrentBranch: "branch-3", ForceIfIncludes: false, TrackingBranch: "origin/branch-3"},
+			&opcodes.ProgramEndOfBranch{},
+			&opcodes.PushTags{},
+		}
+		have := optimizer.BatchPushes(give)
+		want := program.Program{
+			&opcodes.CheckoutIfNeeded{Branch: "branch-1"},
+			&opcodes.ProgramEndOfBranch{},
+			&opcodes.CheckoutIfNeeded{Branch: "branch-2"},
+			&opcodes.ProgramEndOfBranch{},
+			&opcodes.CheckoutIfNeeded{Branch: "branch-3"},
+			&opcodes.ProgramEndOfBranch{},
+			&opcodes.PushBranchesIfNeeded{
+				Branches: []gitdomain.BranchPush{
+					{Branch: "branch-1", Mode: gitdomain.PushModeFastForward, TrackingBranch: "origin/branch-1"},
+					{Branch: "branch-2", Mode: gitdomain.PushModeForceIfIncludes, TrackingBranch: "origin/branch-2"},
+					{Branch: "branch-3", Mode: gitdomain.PushModeForceWithLease, TrackingBranch: "origin/branch-3"},
+				},
+			},
+			&opcodes.PushTags{},
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("multiple push opcodes without end of branch markers", func(t *testing.T) {
+		t.Parallel()
+		give := program.Program{
+			&opcodes.CheckoutIfNeeded{Branch: "branch-1"},
+			&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: "branch-1", TrackingBranch: "origin/branch-1"},
+			&opcodes.CheckoutIfNeeded{Branch: "branch-2"},
+			&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: "branch-2", TrackingBranch: "origin/branch-2"},
+			&opcodes.PushTags{},
+		}
+		have := optimizer.BatchPushes(give)
+		want := program.Program{
+			&opcodes.CheckoutIfNeeded{Branch: "branch-1"},
+			&opcodes.CheckoutIfNeeded{Branch: "branch-2"},
+			&opcodes.PushBranchesIfNeeded{
+				Branches: []gitdomain.BranchPush{
+					{Branch: "branch-1", Mode: gitdomain.PushModeFastForward, TrackingBranch: "origin/branch-1"},
+					{Branch: "branch-2", Mode: gitdomain.PushModeFastForward, TrackingBranch: "origin/branch-2"},
+				},
+			},
+			&opcodes.PushTags{},
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("no push opcodes", func(t *testing.T) {
+		t.Parallel()
+		give := program.Program{
+			&opcodes.MergeAbort{},
+			&opcodes.RebaseAbort{},
+		}
+		have := optimizer.BatchPushes(give)
+		must.Eq(t, give, have)
+	})
+
+	t.Run("single push opcode", func(t *testing.T) {
+		t.Parallel()
+		give := program.Program{
+			&opcodes.CheckoutIfNeeded{Branch: "branch-1"},
+			&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: "branch-1", TrackingBranch: "origin/branch-1"},
+			&opcodes.ProgramEndOfBranch{},
+		}
+		have := optimizer.BatchPushes(give)
+		must.Eq(t, give, have)
+	})
+}
@@ -13,6 +13,12 @@ func BranchesInOpcode(code Opcode) []gitdomain.BranchName {
 	for i := range valueOfSelf.NumField() {
 		field := valueOfSelf.Field(i)
 		fieldType := typeOfSelf.Field(i).Type
+		if fieldType == reflect.TypeFor[[]gitdomain.BranchPush]() {
+			branchPushes := field.Interface().([]gitdomain.BranchPush)
+			for _, branchPush := range branchPushes {
+				result = append(result, branchPush.Branch.BranchName(), branchPush.TrackingBranch.BranchName())
+			}
+		}
 		if fieldType == reflect.TypeFor[gitdomain.BranchName]() {
 			branchName := field.Interface().(gitdomain.BranchName)
 			result = append(result, branchName)
@@ -24,6 +24,24 @@ func TestBranchesInOpcode(t *testing.T) {
 		must.Eq(t, want, have)
 	})
 
+	t.Run("BranchPushes", func(t *testing.T) {
+		t.Parallel()
+		opcode := opcodes.PushBranchesIfNeeded{
+			Branches: []gitdomain.BranchPush{
+				{Branch: "branch-1", Mode: gitdomain.PushModeFastForward, TrackingBranch: "origin/branch-1"},
+				{Branch: "branch-2", Mode: gitdomain.PushModeForceIfIncludes, TrackingBranch: "origin/branch-2"},
+			},
+		}
+		have := shared.BranchesInOpcode(&opcode)
+		want := []gitdomain.BranchName{
+			"branch-1",
+			"origin/branch-1",
+			"branch-2",
+			"origin/branch-2",
+		}
+		must.Eq(t, want, have)
+	})
+
 	t.Run("LocalBranchName", func(t *testing.T) {
 		t.Parallel()
 		opcode := opcodes.LineageParentSet{
@@ -22,5 +22,6 @@ type RunArgs struct {
 	Inputs                          dialogcomponents.Inputs
 	PrependOpcodes                  func(...Opcode)
 	RegisterUndoablePerennialCommit func(gitdomain.SHA)
+	SetWorktree                     func(Option[gitdomain.WorktreePath])
 	UpdateInitialSnapshotLocalSHA   func(gitdomain.LocalBranchName, gitdomain.SHA) error
 }
@@ -0,0 +1,27 @@
+package shared
+
+import (
+	"github.com/git-town/git-town/v22/internal/git"
+	"github.com/git-town/git-town/v22/internal/git/gitdomain"
+	"github.com/git-town/git-town/v22/internal/gohacks/cache"
+	"github.com/git-town/git-town/v22/internal/subshell"
+	"github.com/git-town/git-town/v22/internal/subshell/subshelldomain"
+	. "github.com/git-town/git-town/v22/pkg/prelude"
+)
+
+// WorktreeRunners provides the runners and Git commands that execute opcodes in the given worktree.
+// If no worktree is given, provides the runners and Git commands for the worktree in which Git Town was started.
+func WorktreeRunners(backend subshelldomain.RunnerQuerier, frontend subshelldomain.Runner, gitCommands git.Commands, worktree Option[gitdomain.WorktreePath]) (subshelldomain.RunnerQuerier, subshelldomain.Runner, git.Commands) { //nolint:ireturn
+	path, hasPath := worktree.Get()
+	if !hasPath {
+		return backend, frontend, gitCommands
+	}
+	// the other worktree has a different current branch, so it needs its own cache for it
+	worktreeGit := git.Commands{
+		CurrentBranchCache: &cache.WithPrevious[gitdomain.LocalBranchName]{},
+		RemotesCache:       gitCommands.RemotesCache,
+	}
+	worktreeBackend := subshell.BackendInDir(backend, path.String())
+	worktreeFrontend := subshell.FrontendInDir(frontend, path.String(), worktreeBackend, worktreeGit.CurrentBranch)
+	return worktreeBackend, worktreeFrontend, worktreeGit
+}
@@ -2,17 +2,14 @@ module github.com/git-town/git-town/v22/tools/generate_json_schema
 
 go 1.26.1
 
-require (
-	github.com/git-town/git-town/v22 v22.4.0
-	github.com/invopop/jsonschema v0.13.0
-	github.com/shoenig/test v1.12.2
-)
+require github.com/git-town/git-town/v22 v22.4.0
 
 require (
 	github.com/BurntSushi/toml v1.5.0 // indirect
 	github.com/bahlo/generic-list-go v0.2.0 // indirect
 	github.com/buger/jsonparser v1.1.1 // indirect
 	github.com/google/go-cmp v0.7.0 // indirect
+	github.com/invopop/jsonschema v0.13.0 // indirect
 	github.com/mailru/easyjson v0.7.7 // indirect
 	github.com/wk8/go-ordered-map/v2 v2.1.8 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
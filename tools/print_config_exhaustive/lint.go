@@ -16,6 +16,7 @@ const (
 
 var whiteList = []string{
 	"Aliases",
+	"BranchOverrides",
 	"BranchTypeOverrides",
 	"DisplayDialogs",
 	"DryRun",